@@ -62,6 +62,9 @@ func NewRootCmd() *cobra.Command {
 			defer backupCancel()
 			infostore.StartBackupScheduler(backupCtx, backupCfg)
 
+			// Resume S3 sync jobs interrupted by the last shutdown
+			cr.ResumeS3SyncJobs()
+
 			// WaitGroup to manage both servers
 			wg := new(sync.WaitGroup)
 
@@ -383,133 +383,23 @@ func CreateCluster(connectionName string, rsType string, reqInfo cres.ClusterInf
 	   }
 	*/
 
-	//+++++++++++++++++++++ Set NetworkInfo's SystemId
-	netReqInfo := &reqInfo.Network
-	vpcSPLock.RLock(connectionName, netReqInfo.VpcIID.NameId)
-	defer vpcSPLock.RUnlock(connectionName, netReqInfo.VpcIID.NameId)
-	// (1) VpcIID
-	var vpcIIDInfo VPCIIDInfo
-	if netReqInfo.VpcIID.NameId != "" {
-		// get spiderIID
-		if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
-			var iidInfoList []*VPCIIDInfo
-			err = getAuthIIDInfoList(connectionName, &iidInfoList)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-			castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, netReqInfo.VpcIID.NameId)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-			vpcIIDInfo = *castedIIDInfo.(*VPCIIDInfo)
-		} else {
-			err = infostore.GetByConditions(&vpcIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, netReqInfo.VpcIID.NameId)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-		}
-		// set driverIID
-		netReqInfo.VpcIID = getDriverIID(cres.IID{NameId: vpcIIDInfo.NameId, SystemId: vpcIIDInfo.SystemId})
-	}
-
-	// (2) SubnetIIDs
-	for idx, subnetIID := range netReqInfo.SubnetIIDs {
-		var subnetIIdInfo SubnetIIDInfo
-		if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
-			// 1. get VPC IIDInfo
-			var iidInfoList []*VPCIIDInfo
-			err = getAuthIIDInfoList(connectionName, &iidInfoList)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-			castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, vpcIIDInfo.NameId)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-			vpcIIDInfo := *castedIIDInfo.(*VPCIIDInfo)
-
-			// 2. get Subnet IIDInfo
-			err = infostore.GetBy3Conditions(&subnetIIdInfo, CONNECTION_NAME_COLUMN, vpcIIDInfo.ConnectionName, NAME_ID_COLUMN, subnetIID.NameId, OWNER_VPC_NAME_COLUMN, vpcIIDInfo.NameId)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-		} else {
-			err = infostore.GetBy3Conditions(&subnetIIdInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, subnetIID.NameId, OWNER_VPC_NAME_COLUMN, vpcIIDInfo.NameId)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-		}
-		// set driverIID
-		netReqInfo.SubnetIIDs[idx] = getDriverIID(cres.IID{NameId: subnetIIdInfo.NameId, SystemId: subnetIIdInfo.SystemId})
-	}
-
-	// (3) SecurityGroupIIDs
-	for idx, sgIID := range netReqInfo.SecurityGroupIIDs {
+	//+++++++++++++++++++++ Set NetworkInfo's and NodeGroupInfo's SystemId
+	vpcSPLock.RLock(connectionName, reqInfo.Network.VpcIID.NameId)
+	defer vpcSPLock.RUnlock(connectionName, reqInfo.Network.VpcIID.NameId)
+	for _, sgIID := range reqInfo.Network.SecurityGroupIIDs {
 		sgSPLock.RLock(connectionName, sgIID.NameId)
 		defer sgSPLock.RUnlock(connectionName, sgIID.NameId)
-		var sgIIdInfo SGIIDInfo
-		if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
-			var iidInfoList []*SGIIDInfo
-			err := getAuthIIDInfoList(connectionName, &iidInfoList)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-			castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, sgIID.NameId)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-			sgIIdInfo = *castedIIDInfo.(*SGIIDInfo)
-		} else {
-			err = infostore.GetByConditions(&sgIIdInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, sgIID.NameId)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-		}
-		// set driverIID
-		netReqInfo.SecurityGroupIIDs[idx] = getDriverIID(cres.IID{NameId: sgIIdInfo.NameId, SystemId: sgIIdInfo.SystemId})
 	}
-
-	//+++++++++++++++++++++ Set NodeGroupInfo's SystemId
-	for idx, ngInfo := range reqInfo.NodeGroupList {
-		// (1) ImageIID
-		reqInfo.NodeGroupList[idx].ImageIID.SystemId = ngInfo.ImageIID.NameId
-
-		// (2) KeyPair
+	for _, ngInfo := range reqInfo.NodeGroupList {
 		keySPLock.RLock(connectionName, ngInfo.KeyPairIID.NameId)
 		defer keySPLock.RUnlock(connectionName, ngInfo.KeyPairIID.NameId)
+	}
 
-		var keyIIDInfo KeyIIDInfo
-		if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
-			var iidInfoList []*KeyIIDInfo
-			err := getAuthIIDInfoList(connectionName, &iidInfoList)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-			castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, ngInfo.KeyPairIID.NameId)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-			keyIIDInfo = *castedIIDInfo.(*KeyIIDInfo)
-		} else {
-			err := infostore.GetByConditions(&keyIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, ngInfo.KeyPairIID.NameId)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-		}
-		reqInfo.NodeGroupList[idx].KeyPairIID = getDriverIID(cres.IID{NameId: keyIIDInfo.NameId, SystemId: keyIIDInfo.SystemId})
+	vpcNameId := reqInfo.Network.VpcIID.NameId
+	err = setClusterReqInfoDriverIID(connectionName, &reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
 	}
 	//+++++++++++++++++++++++++++++++++++++++++++
 
@@ -638,7 +528,7 @@ func CreateCluster(connectionName string, rsType string, reqInfo cres.ClusterInf
 
 	// (5) insert spiderIID
 	iidInfo := ClusterIIDInfo{ConnectionName: connectionName, NameId: spiderIId.NameId, SystemId: spiderIId.SystemId,
-		OwnerVPCName: vpcIIDInfo.NameId}
+		OwnerVPCName: vpcNameId}
 	err = infostore.Insert(&iidInfo)
 	if err != nil {
 		cblog.Error(err)
@@ -1474,6 +1364,136 @@ func AddNodeGroup(connectionName string, rsType string, clusterName string, reqI
 	return &info, nil
 }
 
+// setClusterReqInfoDriverIID sets the DriverIIDs of the VPC, Subnets, SecurityGroups and KeyPairs in the reqInfo.
+// The caller must hold the read locks of the VPC, SecurityGroups and KeyPairs.
+func setClusterReqInfoDriverIID(connectionName string, reqInfo *cres.ClusterInfo) error {
+	var err error
+
+	//+++++++++++++++++++++ Set NetworkInfo's SystemId
+	netReqInfo := &reqInfo.Network
+	// (1) VpcIID
+	var vpcIIDInfo VPCIIDInfo
+	if netReqInfo.VpcIID.NameId != "" {
+		// get spiderIID
+		if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+			var iidInfoList []*VPCIIDInfo
+			err = getAuthIIDInfoList(connectionName, &iidInfoList)
+			if err != nil {
+				cblog.Error(err)
+				return err
+			}
+			castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, netReqInfo.VpcIID.NameId)
+			if err != nil {
+				cblog.Error(err)
+				return err
+			}
+			vpcIIDInfo = *castedIIDInfo.(*VPCIIDInfo)
+		} else {
+			err = infostore.GetByConditions(&vpcIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, netReqInfo.VpcIID.NameId)
+			if err != nil {
+				cblog.Error(err)
+				return err
+			}
+		}
+		// set driverIID
+		netReqInfo.VpcIID = getDriverIID(cres.IID{NameId: vpcIIDInfo.NameId, SystemId: vpcIIDInfo.SystemId})
+	}
+
+	// (2) SubnetIIDs
+	for idx, subnetIID := range netReqInfo.SubnetIIDs {
+		var subnetIIdInfo SubnetIIDInfo
+		if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+			// 1. get VPC IIDInfo
+			var iidInfoList []*VPCIIDInfo
+			err = getAuthIIDInfoList(connectionName, &iidInfoList)
+			if err != nil {
+				cblog.Error(err)
+				return err
+			}
+			castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, vpcIIDInfo.NameId)
+			if err != nil {
+				cblog.Error(err)
+				return err
+			}
+			vpcIIDInfo := *castedIIDInfo.(*VPCIIDInfo)
+
+			// 2. get Subnet IIDInfo
+			err = infostore.GetBy3Conditions(&subnetIIdInfo, CONNECTION_NAME_COLUMN, vpcIIDInfo.ConnectionName, NAME_ID_COLUMN, subnetIID.NameId, OWNER_VPC_NAME_COLUMN, vpcIIDInfo.NameId)
+			if err != nil {
+				cblog.Error(err)
+				return err
+			}
+		} else {
+			err = infostore.GetBy3Conditions(&subnetIIdInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, subnetIID.NameId, OWNER_VPC_NAME_COLUMN, vpcIIDInfo.NameId)
+			if err != nil {
+				cblog.Error(err)
+				return err
+			}
+		}
+		// set driverIID
+		netReqInfo.SubnetIIDs[idx] = getDriverIID(cres.IID{NameId: subnetIIdInfo.NameId, SystemId: subnetIIdInfo.SystemId})
+	}
+
+	// (3) SecurityGroupIIDs
+	for idx, sgIID := range netReqInfo.SecurityGroupIIDs {
+		var sgIIdInfo SGIIDInfo
+		if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+			var iidInfoList []*SGIIDInfo
+			err := getAuthIIDInfoList(connectionName, &iidInfoList)
+			if err != nil {
+				cblog.Error(err)
+				return err
+			}
+			castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, sgIID.NameId)
+			if err != nil {
+				cblog.Error(err)
+				return err
+			}
+			sgIIdInfo = *castedIIDInfo.(*SGIIDInfo)
+		} else {
+			err = infostore.GetByConditions(&sgIIdInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, sgIID.NameId)
+			if err != nil {
+				cblog.Error(err)
+				return err
+			}
+		}
+		// set driverIID
+		netReqInfo.SecurityGroupIIDs[idx] = getDriverIID(cres.IID{NameId: sgIIdInfo.NameId, SystemId: sgIIdInfo.SystemId})
+	}
+
+	//+++++++++++++++++++++ Set NodeGroupInfo's SystemId
+	for idx, ngInfo := range reqInfo.NodeGroupList {
+		// (1) ImageIID
+		reqInfo.NodeGroupList[idx].ImageIID.SystemId = ngInfo.ImageIID.NameId
+
+		// (2) KeyPair
+		var keyIIDInfo KeyIIDInfo
+		if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+			var iidInfoList []*KeyIIDInfo
+			err := getAuthIIDInfoList(connectionName, &iidInfoList)
+			if err != nil {
+				cblog.Error(err)
+				return err
+			}
+			castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, ngInfo.KeyPairIID.NameId)
+			if err != nil {
+				cblog.Error(err)
+				return err
+			}
+			keyIIDInfo = *castedIIDInfo.(*KeyIIDInfo)
+		} else {
+			err := infostore.GetByConditions(&keyIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, ngInfo.KeyPairIID.NameId)
+			if err != nil {
+				cblog.Error(err)
+				return err
+			}
+		}
+		reqInfo.NodeGroupList[idx].KeyPairIID = getDriverIID(cres.IID{NameId: keyIIDInfo.NameId, SystemId: keyIIDInfo.SystemId})
+	}
+
+	return nil
+}
+
 func translateRootDiskInfo(providerName string, reqInfo *cres.NodeGroupInfo) error {
 
 	// get Provider's Meta Info
@@ -12,8 +12,6 @@ import (
 	"fmt"
 	"os"
 	"strings"
-	"sync"
-	"time"
 
 	"encoding/json"
 
@@ -48,6 +46,12 @@ const (
 	RDBMS      string = string(cres.RDBMS)
 	PUBLICIP   string = string(cres.PUBLICIP)
 	NIC        string = string(cres.NIC)
+	VPCPEERING string = string(cres.VPCPEERING)
+	ROUTETABLE string = string(cres.ROUTETABLE)
+	NATGATEWAY string = string(cres.NATGATEWAY)
+
+	DISKSNAPSHOT  string = string(cres.DISKSNAPSHOT)
+	RDBMSSNAPSHOT string = string(cres.RDBMSSNAPSHOT)
 )
 
 func RSTypeString(rsType string) string {
@@ -62,11 +66,16 @@ var vmSPLock = splock.New()
 var nlbSPLock = splock.New()
 var diskSPLock = splock.New()
 var myImageSPLock = splock.New()
+var diskSnapshotSPLock = splock.New()
 var clusterSPLock = splock.New()
 var fsSPLock = splock.New()
 var rdbmsSPLock = splock.New()
+var rdbmsSnapshotSPLock = splock.New()
 var publicipSPLock = splock.New()
 var nicSPLock = splock.New()
+var vpcPeeringSPLock = splock.New()
+var routeTableSPLock = splock.New()
+var natGatewaySPLock = splock.New()
 
 // vpcSharedResourceSPLock protects VPC-level shared resources (e.g., GCP Service Networking Peering, Azure Private DNS Zone)
 // that are created/deleted per VPC but shared by multiple RDBMS instances.
@@ -1891,156 +1900,6 @@ type RemainedErrorInfo struct {
 	ErrorMsg string `json:"ErrorMsg" validate:"required" example:"delete error"` // Error message for the failed resource
 }
 
-// Destroy all Resources in a Connection
-func Destroy(connectionName string) (DestroyedInfo, error) {
-	// check empty and trim user inputs
-	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
-	if err != nil {
-		cblog.Println(err)
-		return DestroyedInfo{}, err
-	}
-
-	var destroyedInfo DestroyedInfo
-	destroyedInfo.IsAllDestroyed = true
-
-	// Define resource type groups
-	resourceTypeGroups := [][]string{
-		{CLUSTER, MYIMAGE, NLB, RDBMS},
-		{VM},
-		{DISK},
-		{KEY, SG},
-		{VPC},
-	}
-
-	for _, resourceTypes := range resourceTypeGroups {
-		var wg sync.WaitGroup
-		var mu sync.Mutex
-		var groupErr error
-
-		for _, resourceType := range resourceTypes {
-			wg.Add(1)
-			go func(resourceType string) {
-				defer wg.Done()
-
-				var finalDeletedResourceInfoList DeletedResourceInfoList
-				finalDeletedResourceInfoList.ResourceType = resourceType
-
-				for retry := 0; retry < 10; retry++ {
-					deletedResourceInfoList, err := deleteAllResourcesInResType(connectionName, resourceType)
-					mu.Lock()
-					if err != nil {
-						cblog.Println(err)
-						groupErr = err
-						mu.Unlock()
-						return
-					}
-					if deletedResourceInfoList == nil {
-						mu.Unlock()
-						return
-					}
-
-					// Append the deleted resource info list
-					finalDeletedResourceInfoList.DeletedIIDList = append(finalDeletedResourceInfoList.DeletedIIDList, deletedResourceInfoList.DeletedIIDList...)
-					finalDeletedResourceInfoList.IsAllDeleted = deletedResourceInfoList.IsAllDeleted
-					if !deletedResourceInfoList.IsAllDeleted {
-						finalDeletedResourceInfoList.RemainedErrorInfoList = deletedResourceInfoList.RemainedErrorInfoList
-					}
-
-					if deletedResourceInfoList.IsAllDeleted {
-						destroyedInfo.DestroyedList = append(destroyedInfo.DestroyedList, &finalDeletedResourceInfoList)
-						mu.Unlock()
-						return
-					}
-					mu.Unlock()
-					time.Sleep(3 * time.Second)
-				}
-
-				mu.Lock()
-				destroyedInfo.IsAllDestroyed = false
-				destroyedInfo.DestroyedList = append(destroyedInfo.DestroyedList, &finalDeletedResourceInfoList)
-				mu.Unlock()
-			}(resourceType)
-		}
-
-		wg.Wait()
-
-		if groupErr != nil {
-			return DestroyedInfo{}, groupErr
-		}
-	}
-
-	return destroyedInfo, nil
-}
-
-// deletes all resources of a specific resource type in a connection
-func deleteAllResourcesInResType(connectionName string, rsType string) (*DeletedResourceInfoList, error) {
-
-	nameList, err := ListResourceName(connectionName, rsType)
-	if err != nil {
-		cblog.Error(err)
-		return nil, err
-	}
-
-	if len(nameList) <= 0 {
-		return nil, nil
-	}
-
-	deletedResourceInfoList := &DeletedResourceInfoList{
-		ResourceType: rsType,
-		IsAllDeleted: true,
-	}
-
-	var wg sync.WaitGroup
-	var mu sync.Mutex
-
-	for _, nameId := range nameList {
-		wg.Add(1)
-		go func(nameId string) {
-			defer wg.Done()
-			var err error
-
-			switch rsType {
-			case VPC:
-				_, err = DeleteVPC(connectionName, VPC, nameId, "false")
-			case SG:
-				_, err = DeleteSecurity(connectionName, SG, nameId, "false")
-			case KEY:
-				_, err = DeleteKey(connectionName, KEY, nameId, "false")
-			case VM:
-				_, _, err = DeleteVM(connectionName, VM, nameId, "false")
-			case NLB:
-				_, err = DeleteNLB(connectionName, NLB, nameId, "false")
-			case DISK:
-				_, err = DeleteDisk(connectionName, DISK, nameId, "false")
-			case MYIMAGE:
-				_, err = DeleteMyImage(connectionName, MYIMAGE, nameId, "false")
-			case CLUSTER:
-				_, err = DeleteCluster(connectionName, CLUSTER, nameId, "false")
-			case RDBMS:
-				_, err = DeleteRDBMS(connectionName, RDBMS, nameId, "false")
-			default:
-				err = fmt.Errorf("%s is not supported Resource!!", rsType)
-			}
-
-			mu.Lock()
-			defer mu.Unlock()
-			if err != nil {
-				deletedResourceInfoList.IsAllDeleted = false
-				deletedResourceInfoList.RemainedErrorInfoList = append(deletedResourceInfoList.RemainedErrorInfoList, &RemainedErrorInfo{
-					Name:     nameId,
-					ErrorMsg: err.Error(),
-				})
-			} else {
-				deletedResourceInfoList.DeletedIIDList = append(deletedResourceInfoList.DeletedIIDList, &cres.IID{NameId: nameId})
-			}
-		}(nameId)
-	}
-
-	wg.Wait()
-
-	return deletedResourceInfoList, nil
-}
-
 // ListResourceName lists resource names by connectionName and rsType
 func ListResourceName(connectionName, rsType string) ([]string, error) {
 	var info interface{}
@@ -2074,6 +1933,30 @@ func ListResourceName(connectionName, rsType string) ([]string, error) {
 	case RDBMS:
 		v := RDBMSIIDInfo{}
 		info = &v
+	case FILESYSTEM:
+		v := FileSystemIIDInfo{}
+		info = &v
+	case NIC:
+		v := NICIIDInfo{}
+		info = &v
+	case PUBLICIP:
+		v := PublicIPIIDInfo{}
+		info = &v
+	case VPCPEERING:
+		v := VPCPeeringIIDInfo{}
+		info = &v
+	case ROUTETABLE:
+		v := RouteTableIIDInfo{}
+		info = &v
+	case NATGATEWAY:
+		v := NATGatewayIIDInfo{}
+		info = &v
+	case DISKSNAPSHOT:
+		v := DiskSnapshotIIDInfo{}
+		info = &v
+	case RDBMSSNAPSHOT:
+		v := RDBMSSnapshotIIDInfo{}
+		info = &v
 	default:
 		return nil, fmt.Errorf("%s is not a supported Resource!!", rsType)
 	}
@@ -2246,6 +2129,32 @@ func getAuthIIDInfoList(connectionName string, iidInfoList interface{}) error {
 			return fmt.Errorf("failed to list from MetaDB: %v", err)
 		}
 
+		for _, tmp := range tmpIIDInfoList {
+			for _, iid := range iidList {
+				if iid.SystemId == getDriverSystemId(cres.IID{NameId: tmp.NameId, SystemId: tmp.SystemId}) {
+					*v = append(*v, tmp)
+				}
+			}
+		}
+	case *[]*DiskSnapshotIIDInfo:
+		tmpIIDInfoList := []*DiskSnapshotIIDInfo{}
+		handler, err := cldConn.CreateDiskSnapshotHandler()
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		// Fetch granted ID list from CSP
+		iidList, err := handler.ListIID()
+		if err != nil {
+			cblog.Error(err)
+			return fmt.Errorf("failed to list IIDs from CSP: %v", err)
+		}
+		err = infostore.List(&tmpIIDInfoList)
+		if err != nil {
+			cblog.Error(err)
+			return fmt.Errorf("failed to list from MetaDB: %v", err)
+		}
+
 		for _, tmp := range tmpIIDInfoList {
 			for _, iid := range iidList {
 				if iid.SystemId == getDriverSystemId(cres.IID{NameId: tmp.NameId, SystemId: tmp.SystemId}) {
@@ -2290,6 +2199,32 @@ func getAuthIIDInfoList(connectionName string, iidInfoList interface{}) error {
 			return fmt.Errorf("failed to list from MetaDB: %v", err)
 		}
 
+		for _, tmp := range tmpIIDInfoList {
+			for _, iid := range iidList {
+				if iid.SystemId == getDriverSystemId(cres.IID{NameId: tmp.NameId, SystemId: tmp.SystemId}) {
+					*v = append(*v, tmp)
+				}
+			}
+		}
+	case *[]*VPCPeeringIIDInfo:
+		tmpIIDInfoList := []*VPCPeeringIIDInfo{}
+		handler, err := cldConn.CreateVPCPeeringHandler()
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		// Fetch granted ID list from CSP
+		iidList, err := handler.ListIID()
+		if err != nil {
+			cblog.Error(err)
+			return fmt.Errorf("failed to list IIDs from CSP: %v", err)
+		}
+		err = infostore.List(&tmpIIDInfoList)
+		if err != nil {
+			cblog.Error(err)
+			return fmt.Errorf("failed to list from MetaDB: %v", err)
+		}
+
 		for _, tmp := range tmpIIDInfoList {
 			for _, iid := range iidList {
 				if iid.SystemId == getDriverSystemId(cres.IID{NameId: tmp.NameId, SystemId: tmp.SystemId}) {
@@ -2353,6 +2288,12 @@ func isNameIdExists(iidInfoList interface{}, nameId string) (bool, error) {
 				return true, nil // NameId exists
 			}
 		}
+	case *[]*DiskSnapshotIIDInfo:
+		for _, iidInfo := range *v {
+			if iidInfo.NameId == nameId {
+				return true, nil // NameId exists
+			}
+		}
 	case *[]*ClusterIIDInfo:
 		for _, iidInfo := range *v {
 			if iidInfo.NameId == nameId {
@@ -2365,6 +2306,12 @@ func isNameIdExists(iidInfoList interface{}, nameId string) (bool, error) {
 				return true, nil // NameId exists
 			}
 		}
+	case *[]*VPCPeeringIIDInfo:
+		for _, iidInfo := range *v {
+			if iidInfo.NameId == nameId {
+				return true, nil // NameId exists
+			}
+		}
 	default:
 		return false, fmt.Errorf("unsupported type for iidInfoList")
 	}
@@ -2372,6 +2319,49 @@ func isNameIdExists(iidInfoList interface{}, nameId string) (bool, error) {
 	return false, nil // NameId does not exist
 }
 
+// Check if NameId exists in the connection, the same way as each resource's create API
+func hasResourceNameId(connectionName string, rsType string, nameId string) (bool, error) {
+	switch rsType {
+	case VPC:
+		return hasNameIdOf[VPCIIDInfo](connectionName, nameId)
+	case SG:
+		return hasNameIdOf[SGIIDInfo](connectionName, nameId)
+	case KEY:
+		return hasNameIdOf[KeyIIDInfo](connectionName, nameId)
+	case VM:
+		return hasNameIdOf[VMIIDInfo](connectionName, nameId)
+	case NLB:
+		return hasNameIdOf[NLBIIDInfo](connectionName, nameId)
+	case DISK:
+		return hasNameIdOf[DiskIIDInfo](connectionName, nameId)
+	case MYIMAGE:
+		return hasNameIdOf[MyImageIIDInfo](connectionName, nameId)
+	case DISKSNAPSHOT:
+		return hasNameIdOf[DiskSnapshotIIDInfo](connectionName, nameId)
+	case CLUSTER:
+		return hasNameIdOf[ClusterIIDInfo](connectionName, nameId)
+	case RDBMS:
+		return hasNameIdOf[RDBMSIIDInfo](connectionName, nameId)
+	case RDBMSSNAPSHOT:
+		// the snapshots are checked in the connection also in PERMISSION_BASED_CONTROL_MODE, as CreateRDBMSSnapshot
+		return infostore.HasByConditions(&RDBMSSnapshotIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, nameId)
+	default:
+		return false, fmt.Errorf("unsupported resource type: %s", rsType)
+	}
+}
+
+func hasNameIdOf[T any](connectionName string, nameId string) (bool, error) {
+	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+		var iidInfoList []*T
+		err := getAuthIIDInfoList(connectionName, &iidInfoList)
+		if err != nil {
+			return false, err
+		}
+		return isNameIdExists(&iidInfoList, nameId)
+	}
+	return infostore.HasByConditions(new(T), CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, nameId)
+}
+
 // Get IIDInfo by NameId from IIDInfo list
 func getAuthIIDInfo(iidInfoList interface{}, nameId string) (interface{}, error) {
 	if iidInfoList == nil {
@@ -2428,6 +2418,13 @@ func getAuthIIDInfo(iidInfoList interface{}, nameId string) (interface{}, error)
 			}
 		}
 		return nil, fmt.Errorf("MyImage '%s' does not exist", nameId)
+	case *[]*DiskSnapshotIIDInfo:
+		for _, iidInfo := range *v {
+			if iidInfo.NameId == nameId {
+				return iidInfo, nil // Return matching DiskSnapshotIIDInfo
+			}
+		}
+		return nil, fmt.Errorf("DiskSnapshot '%s' does not exist", nameId)
 	case *[]*ClusterIIDInfo:
 		for _, iidInfo := range *v {
 			if iidInfo.NameId == nameId {
@@ -2442,6 +2439,13 @@ func getAuthIIDInfo(iidInfoList interface{}, nameId string) (interface{}, error)
 			}
 		}
 		return nil, fmt.Errorf("RDBMS '%s' does not exist", nameId)
+	case *[]*VPCPeeringIIDInfo:
+		for _, iidInfo := range *v {
+			if iidInfo.NameId == nameId {
+				return iidInfo, nil // Return matching VPCPeeringIIDInfo
+			}
+		}
+		return nil, fmt.Errorf("VPCPeering '%s' does not exist", nameId)
 	default:
 		return nil, fmt.Errorf("unsupported type for iidInfoList")
 	}
@@ -2503,6 +2507,13 @@ func getAuthIIDInfoBySystemIdContain(iidInfoList interface{}, systemId string) (
 			}
 		}
 		return nil, fmt.Errorf("MyImage with SystemId containing '%s' not found", systemId)
+	case *[]*DiskSnapshotIIDInfo:
+		for _, iidInfo := range *v {
+			if strings.Contains(iidInfo.SystemId, systemId) {
+				return iidInfo, nil // Return matching DiskSnapshotIIDInfo
+			}
+		}
+		return nil, fmt.Errorf("DiskSnapshot with SystemId containing '%s' not found", systemId)
 	case *[]*ClusterIIDInfo:
 		for _, iidInfo := range *v {
 			if strings.Contains(iidInfo.SystemId, systemId) {
@@ -2531,6 +2542,26 @@ const (
 	QUOTA_INFO_HANDLER CapabilityType = "QuotaInfoHandler"
 
 	ZONE_BASED_CONTROL CapabilityType = "Zone-based Control"
+
+	VM_USER_DATA CapabilityType = "VM UserData"
+	SPOT_VM      CapabilityType = "Spot VM"
+
+	VM_SPEC_CHANGE CapabilityType = "VMSpec Change"
+
+	DISK_SNAPSHOT_HANDLER CapabilityType = "DiskSnapshotHandler"
+
+	SG_RULE_DESCRIPTION CapabilityType = "SecurityRule Description"
+	SG_RULE_IPV6_CIDR   CapabilityType = "SecurityRule IPv6 CIDR"
+	SG_RULE_SOURCE_SG   CapabilityType = "SecurityRule Source SecurityGroup"
+
+	NLB_MULTI_LISTENER    CapabilityType = "NLB Multiple Listeners"
+	NLB_HTTPS_CERTIFICATE CapabilityType = "NLB HTTPS Certificate"
+	NLB_HEALTH_CHECK_PATH CapabilityType = "NLB Health Check Path"
+	NLB_HTTP_PROTOCOL     CapabilityType = "NLB HTTP/HTTPS Protocol"
+
+	VPC_PEERING_HANDLER CapabilityType = "VPCPeeringHandler"
+	ROUTE_TABLE_HANDLER CapabilityType = "RouteTableHandler"
+	NAT_GATEWAY_HANDLER CapabilityType = "NATGatewayHandler"
 )
 
 // checkCapability checks if the given connection supports specified capability
@@ -2553,6 +2584,34 @@ func checkCapability(connectionName string, capability CapabilityType) error {
 		supported = drvCapabilityInfo.QuotaInfoHandler
 	case ZONE_BASED_CONTROL:
 		supported = drvCapabilityInfo.ZoneBasedControl
+	case VM_USER_DATA:
+		supported = drvCapabilityInfo.VM_USER_DATA
+	case SPOT_VM:
+		supported = drvCapabilityInfo.SPOT_VM
+	case VM_SPEC_CHANGE:
+		supported = drvCapabilityInfo.VM_SPEC_CHANGE
+	case DISK_SNAPSHOT_HANDLER:
+		supported = drvCapabilityInfo.DiskSnapshotHandler
+	case SG_RULE_DESCRIPTION:
+		supported = drvCapabilityInfo.SG_RULE_DESCRIPTION
+	case SG_RULE_IPV6_CIDR:
+		supported = drvCapabilityInfo.SG_RULE_IPV6_CIDR
+	case SG_RULE_SOURCE_SG:
+		supported = drvCapabilityInfo.SG_RULE_SOURCE_SG
+	case NLB_MULTI_LISTENER:
+		supported = drvCapabilityInfo.NLB_MULTI_LISTENER
+	case NLB_HTTPS_CERTIFICATE:
+		supported = drvCapabilityInfo.NLB_HTTPS_CERTIFICATE
+	case NLB_HEALTH_CHECK_PATH:
+		supported = drvCapabilityInfo.NLB_HEALTH_CHECK_PATH
+	case NLB_HTTP_PROTOCOL:
+		supported = drvCapabilityInfo.NLB_HTTP_PROTOCOL
+	case VPC_PEERING_HANDLER:
+		supported = drvCapabilityInfo.VPCPeeringHandler
+	case ROUTE_TABLE_HANDLER:
+		supported = drvCapabilityInfo.RouteTableHandler
+	case NAT_GATEWAY_HANDLER:
+		supported = drvCapabilityInfo.NATGatewayHandler
 	default:
 		return fmt.Errorf("unknown capability type: %s", capability)
 	}
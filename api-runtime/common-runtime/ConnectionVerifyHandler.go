@@ -0,0 +1,241 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Connection Verify Handler — checks a connection config with a cheap read to the CSP.
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
+)
+
+// default timeout of VerifyConnectionConfig()
+const DefaultConnectionVerifyTimeout = 30 * time.Second
+
+const (
+	ConnectionVerifyOK      = "OK"
+	ConnectionVerifyPartial = "PARTIAL" // auth is OK, but the region is not reachable
+	ConnectionVerifyFailed  = "FAILED"
+)
+
+// ConnectionVerifyInfo represents the result of a connection config verification.
+type ConnectionVerifyInfo struct {
+	ConnectionName  string              `json:"ConnectionName" validate:"required" example:"aws-seoul-config"`
+	ProviderName    string              `json:"ProviderName" validate:"required" example:"AWS"`
+	RegionName      string              `json:"RegionName" validate:"required" example:"ap-northeast-2"`
+	Status          string              `json:"Status" validate:"required" example:"OK"` // OK | PARTIAL | FAILED
+	AuthOK          bool                `json:"AuthOK" validate:"required" example:"true"`
+	RegionReachable bool                `json:"RegionReachable" validate:"required" example:"true"`
+	Error           string              `json:"Error,omitempty" example:""`
+	ElapsedMs       int64               `json:"ElapsedMs" validate:"required" example:"1523"`
+	CheckedAt       time.Time           `json:"CheckedAt" validate:"required"`
+	HandlerList     []HandlerVerifyInfo `json:"HandlerList" validate:"required"`
+	ProbeList       []ProbeVerifyInfo   `json:"ProbeList" validate:"required"`
+}
+
+// HandlerVerifyInfo represents whether a handler factory of the driver succeeds.
+type HandlerVerifyInfo struct {
+	Handler string `json:"Handler" validate:"required" example:"VPC"`
+	OK      bool   `json:"OK" validate:"required" example:"true"`
+	Error   string `json:"Error,omitempty" example:""`
+}
+
+// ProbeVerifyInfo represents the result of a cheap read call to the CSP.
+type ProbeVerifyInfo struct {
+	Probe     string `json:"Probe" validate:"required" example:"VPCHandler.ListIID"`
+	OK        bool   `json:"OK" validate:"required" example:"true"`
+	Error     string `json:"Error,omitempty" example:""`
+	ElapsedMs int64  `json:"ElapsedMs" validate:"required" example:"320"`
+}
+
+type handlerFactory struct {
+	name   string
+	create func(conn icon.CloudConnection) error
+}
+
+var handlerFactoryList = []handlerFactory{
+	{"RegionZone", func(conn icon.CloudConnection) error { _, err := conn.CreateRegionZoneHandler(); return err }},
+	{"Image", func(conn icon.CloudConnection) error { _, err := conn.CreateImageHandler(); return err }},
+	{"VMSpec", func(conn icon.CloudConnection) error { _, err := conn.CreateVMSpecHandler(); return err }},
+	{"VPC", func(conn icon.CloudConnection) error { _, err := conn.CreateVPCHandler(); return err }},
+	{"SecurityGroup", func(conn icon.CloudConnection) error { _, err := conn.CreateSecurityHandler(); return err }},
+	{"KeyPair", func(conn icon.CloudConnection) error { _, err := conn.CreateKeyPairHandler(); return err }},
+	{"VM", func(conn icon.CloudConnection) error { _, err := conn.CreateVMHandler(); return err }},
+	{"Disk", func(conn icon.CloudConnection) error { _, err := conn.CreateDiskHandler(); return err }},
+	{"DiskSnapshot", func(conn icon.CloudConnection) error { _, err := conn.CreateDiskSnapshotHandler(); return err }},
+	{"MyImage", func(conn icon.CloudConnection) error { _, err := conn.CreateMyImageHandler(); return err }},
+	{"NLB", func(conn icon.CloudConnection) error { _, err := conn.CreateNLBHandler(); return err }},
+	{"Cluster", func(conn icon.CloudConnection) error { _, err := conn.CreateClusterHandler(); return err }},
+	{"FileSystem", func(conn icon.CloudConnection) error { _, err := conn.CreateFileSystemHandler(); return err }},
+	{"RDBMS", func(conn icon.CloudConnection) error { _, err := conn.CreateRDBMSHandler(); return err }},
+	{"PublicIP", func(conn icon.CloudConnection) error { _, err := conn.CreatePublicIPHandler(); return err }},
+	{"NIC", func(conn icon.CloudConnection) error { _, err := conn.CreateNICHandler(); return err }},
+	{"VPCPeering", func(conn icon.CloudConnection) error { _, err := conn.CreateVPCPeeringHandler(); return err }},
+	{"RouteTable", func(conn icon.CloudConnection) error { _, err := conn.CreateRouteTableHandler(); return err }},
+	{"NATGateway", func(conn icon.CloudConnection) error { _, err := conn.CreateNATGatewayHandler(); return err }},
+	{"Monitoring", func(conn icon.CloudConnection) error { _, err := conn.CreateMonitoringHandler(); return err }},
+	{"Tag", func(conn icon.CloudConnection) error { _, err := conn.CreateTagHandler(); return err }},
+	{"PriceInfo", func(conn icon.CloudConnection) error { _, err := conn.CreatePriceInfoHandler(); return err }},
+	{"QuotaInfo", func(conn icon.CloudConnection) error { _, err := conn.CreateQuotaInfoHandler(); return err }},
+	{"AnyCall", func(conn icon.CloudConnection) error { _, err := conn.CreateAnyCallHandler(); return err }},
+}
+
+// ================ Connection Verify Handler
+
+// VerifyConnectionConfig connects to the CSP with the connection config and checks:
+//   - AuthOK: the credential is accepted by the CSP (RegionZoneHandler.ListOrgRegion)
+//   - RegionReachable: the region can be used (VPCHandler.ListIID)
+//   - HandlerList: which handler factories of the driver succeed
+//
+// The failures are reported in the result, not as an error.
+// An error is returned only for the invalid input. ex) the connection config does not exist.
+func VerifyConnectionConfig(connectionName string, timeout time.Duration) (*ConnectionVerifyInfo, error) {
+	cblog.Info("call VerifyConnectionConfig()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	providerName, err := ccm.GetProviderNameByConnectionName(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	regionName, _, err := ccm.GetRegionNameByConnectionName(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if timeout <= 0 {
+		timeout = DefaultConnectionVerifyTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	start := time.Now()
+	info := &ConnectionVerifyInfo{
+		ConnectionName: connectionName,
+		ProviderName:   providerName,
+		RegionName:     regionName,
+		Status:         ConnectionVerifyFailed,
+		CheckedAt:      start,
+		HandlerList:    []HandlerVerifyInfo{},
+		ProbeList:      []ProbeVerifyInfo{},
+	}
+	defer func() {
+		info.ElapsedMs = time.Since(start).Milliseconds()
+	}()
+
+	// (1) ConnectCloud, not with the cached connection
+	ccm.InvalidateCloudConnectionCache(connectionName)
+	var cldConn icon.CloudConnection
+	err = runWithContext(ctx, func() error {
+		var err error
+		cldConn, err = ccm.GetCloudConnection(connectionName)
+		return err
+	})
+	if err != nil {
+		cblog.Error(err)
+		info.Error = fmt.Sprintf("failed to connect: %v", err)
+		return info, nil
+	}
+
+	// (2) cheap reads
+	authProbe := runProbe(ctx, "RegionZoneHandler.ListOrgRegion", func() error {
+		handler, err := cldConn.CreateRegionZoneHandler()
+		if err != nil {
+			return err
+		}
+		_, err = handler.ListOrgRegion()
+		return err
+	})
+	regionProbe := runProbe(ctx, "VPCHandler.ListIID", func() error {
+		handler, err := cldConn.CreateVPCHandler()
+		if err != nil {
+			return err
+		}
+		_, err = handler.ListIID()
+		return err
+	})
+	info.ProbeList = append(info.ProbeList, authProbe, regionProbe)
+
+	// (3) handler factories
+	for _, factory := range handlerFactoryList {
+		create := factory.create
+		err := runWithContext(ctx, func() error { return create(cldConn) })
+		handlerInfo := HandlerVerifyInfo{Handler: factory.name, OK: err == nil}
+		if err != nil {
+			handlerInfo.Error = err.Error()
+		}
+		info.HandlerList = append(info.HandlerList, handlerInfo)
+	}
+
+	// a regional read is also authenticated
+	info.RegionReachable = regionProbe.OK
+	info.AuthOK = authProbe.OK || regionProbe.OK
+
+	switch {
+	case info.AuthOK && info.RegionReachable:
+		info.Status = ConnectionVerifyOK
+	case info.AuthOK:
+		info.Status = ConnectionVerifyPartial
+		info.Error = regionProbe.Error
+	default:
+		info.Error = authProbe.Error
+		if info.Error == "" {
+			info.Error = regionProbe.Error
+		}
+	}
+
+	return info, nil
+}
+
+func runProbe(ctx context.Context, probeName string, probe func() error) ProbeVerifyInfo {
+	start := time.Now()
+	err := runWithContext(ctx, probe)
+	probeInfo := ProbeVerifyInfo{Probe: probeName, OK: err == nil, ElapsedMs: time.Since(start).Milliseconds()}
+	if err != nil {
+		cblog.Error(err)
+		probeInfo.Error = err.Error()
+	}
+	return probeInfo
+}
+
+// runWithContext runs fn until ctx is done.
+// The drivers have no context, so fn keeps running in the background after the timeout.
+func runWithContext(ctx context.Context, fn func() error) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("timeout: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- fmt.Errorf("panic: %v", r)
+			}
+		}()
+		done <- fn()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("timeout: %v", ctx.Err())
+	}
+}
@@ -0,0 +1,715 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Destroy Manager — deletes the resources of a connection in the order of the resource dependency graph.
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// resource types to destroy, Subnets are deleted with the VPC.
+var destroyResourceTypes = []string{CLUSTER, NLB, RDBMS, RDBMSSNAPSHOT, FILESYSTEM, MYIMAGE, VM, ROUTETABLE, NATGATEWAY, PUBLICIP, NIC, DISKSNAPSHOT, DISK, KEY, VPCPEERING, SG, VPC}
+
+// destroyTypeOrderList: {A, B} means that a resource of type A must be deleted before the resources of type B it uses.
+// The exact dependencies are resolved from the IID tables and the resource info.
+// A resource whose info can not be retrieved is not deleted, and it does not block the other resources.
+var destroyTypeOrderList = [][2]string{
+	{CLUSTER, VPC}, {CLUSTER, SG}, {CLUSTER, KEY},
+	{NLB, VM}, {NLB, VPC},
+	{RDBMS, VPC}, {RDBMS, SG},
+	{FILESYSTEM, VPC},
+	{VM, VPC}, {VM, SG}, {VM, KEY}, {VM, DISK}, {VM, NIC}, {VM, PUBLICIP},
+	{NIC, PUBLICIP}, {NIC, VPC}, {NIC, SG},
+	{ROUTETABLE, NATGATEWAY}, {ROUTETABLE, NIC}, {ROUTETABLE, VPCPEERING}, {ROUTETABLE, VPC},
+	{NATGATEWAY, PUBLICIP}, {NATGATEWAY, VPC},
+	{VPCPEERING, VPC},
+	{SG, VPC},
+}
+
+// same as the retry of the former DestroyResource(): a CSP takes time to release a deleted resource from the dependent ones.
+const destroyMaxTry = 10
+const destroyRetryInterval = 3 * time.Second
+
+// DestroyFilter selects the resources to destroy. An empty filter selects all resources in the connection.
+type DestroyFilter struct {
+	NamePrefix string `json:"NamePrefix,omitempty" example:"env1-"` // NameId prefix of the resources
+	Tag        string `json:"Tag,omitempty" example:"env=env1"`     // 'key=value' or 'key'(any value)
+}
+
+// DestroyPlanInfo represents the resources to be deleted by Destroy in order.
+type DestroyPlanInfo struct {
+	ConnectionName string                  `json:"ConnectionName" validate:"required" example:"aws-seoul-config"`
+	Filter         DestroyFilter           `json:"Filter" validate:"required"`
+	StepList       []*DestroyStepInfo      `json:"StepList" validate:"required"`    // the resources of a step are deleted after the previous steps, in parallel
+	BlockedList    []*DestroyBlockedInfo   `json:"BlockedList" validate:"required"` // the selected resources used by the resources not selected, not deleted
+	WarningList    []string                `json:"WarningList" validate:"required"`
+	nodeMap        map[string]*destroyNode // key: "<rsType>:<NameId>"
+}
+
+// DestroyStepInfo represents the resources deleted in a step of Destroy.
+type DestroyStepInfo struct {
+	Step         int                    `json:"Step" validate:"required" example:"1"`
+	ResourceList []*DestroyResourceInfo `json:"ResourceList" validate:"required"`
+}
+
+// DestroyResourceInfo represents a resource to be deleted by Destroy.
+type DestroyResourceInfo struct {
+	ResourceType string   `json:"ResourceType" validate:"required" example:"SecurityGroup"`
+	NameId       string   `json:"NameId" validate:"required" example:"sg-01"`
+	DeleteAfter  []string `json:"DeleteAfter" validate:"required" example:"VM:vm-01"` // resources to be deleted before this resource
+}
+
+// DestroyBlockedInfo represents a selected resource used by the resources not selected.
+type DestroyBlockedInfo struct {
+	ResourceType string   `json:"ResourceType" validate:"required" example:"VPC"`
+	NameId       string   `json:"NameId" validate:"required" example:"vpc-01"`
+	UsedBy       []string `json:"UsedBy" validate:"required" example:"VM:vm-99"`
+	Error        string   `json:"Error,omitempty" example:""` // the error if the dependencies of the resource can not be resolved
+}
+
+type destroyNode struct {
+	rsType   string
+	nameId   string
+	tagList  []cres.KeyValue
+	tagKnown bool
+
+	// error of the resource info retrieval, the dependencies are unknown
+	lookupErr error
+
+	// exact dependencies, "<rsType>" => NameIds
+	uses   map[string][]string // resources used by this node
+	usedBy map[string][]string // resources using this node
+
+	before   []*destroyNode // to be deleted before this node
+	after    []*destroyNode // to be deleted after this node
+	selected bool
+}
+
+func destroyNodeKey(rsType string, nameId string) string {
+	return rsType + ":" + nameId
+}
+
+func (node *destroyNode) key() string {
+	return destroyNodeKey(node.rsType, node.nameId)
+}
+
+// PreviewDestroy returns the resources to be deleted by Destroy in order, without deleting them.
+func PreviewDestroy(connectionName string, filter DestroyFilter) (*DestroyPlanInfo, error) {
+	cblog.Info("call PreviewDestroy()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return makeDestroyPlan(connectionName, filter)
+}
+
+// Destroy deletes the resources in a Connection in the order of the resource dependency graph.
+// A resource is not deleted if a resource to be deleted before it remains.
+func Destroy(connectionName string, filter DestroyFilter) (DestroyedInfo, error) {
+	cblog.Info("call Destroy()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return DestroyedInfo{}, err
+	}
+
+	plan, err := makeDestroyPlan(connectionName, filter)
+	if err != nil {
+		cblog.Error(err)
+		return DestroyedInfo{}, err
+	}
+
+	destroyedInfo := DestroyedInfo{IsAllDestroyed: true, DestroyedList: []*DeletedResourceInfoList{}}
+	resultMap := map[string]*DeletedResourceInfoList{}
+	getResult := func(rsType string) *DeletedResourceInfoList {
+		result, ok := resultMap[rsType]
+		if !ok {
+			result = &DeletedResourceInfoList{ResourceType: rsType, IsAllDeleted: true,
+				DeletedIIDList: []*cres.IID{}, RemainedErrorInfoList: []*RemainedErrorInfo{}}
+			resultMap[rsType] = result
+			destroyedInfo.DestroyedList = append(destroyedInfo.DestroyedList, result)
+		}
+		return result
+	}
+	remained := func(rsType string, nameId string, errMsg string) {
+		result := getResult(rsType)
+		result.IsAllDeleted = false
+		result.RemainedErrorInfoList = append(result.RemainedErrorInfoList, &RemainedErrorInfo{Name: nameId, ErrorMsg: errMsg})
+		destroyedInfo.IsAllDestroyed = false
+	}
+
+	for _, blocked := range plan.BlockedList {
+		if blocked.Error != "" {
+			remained(blocked.ResourceType, blocked.NameId, "failed to resolve the dependencies: "+blocked.Error)
+			continue
+		}
+		remained(blocked.ResourceType, blocked.NameId, "used by the resources not to be deleted: "+strings.Join(blocked.UsedBy, ", "))
+	}
+
+	failed := map[string]bool{}
+	for _, step := range plan.StepList {
+		// skip if a resource to be deleted before remains
+		var nodeList []*destroyNode
+		for _, rsInfo := range step.ResourceList {
+			node := plan.nodeMap[destroyNodeKey(rsInfo.ResourceType, rsInfo.NameId)]
+
+			var remainedBefore []string
+			for _, before := range node.before {
+				if before.selected && failed[before.key()] {
+					remainedBefore = append(remainedBefore, before.key())
+				}
+			}
+			if len(remainedBefore) > 0 {
+				failed[node.key()] = true
+				remained(node.rsType, node.nameId, "not deleted because the resources to be deleted before remain: "+strings.Join(remainedBefore, ", "))
+				continue
+			}
+			nodeList = append(nodeList, node)
+		}
+
+		// delete the resources of a step with the request rate of the provider, and retry the failed ones
+		for try := 1; len(nodeList) > 0; try++ {
+			errList := runLongRunningFanOut(connectionName, len(nodeList), func(idx int) error {
+				return deleteResource(connectionName, nodeList[idx].rsType, nodeList[idx].nameId)
+			})
+
+			var retryList []*destroyNode
+			for idx, node := range nodeList {
+				err := errList[idx]
+				if err == nil {
+					result := getResult(node.rsType)
+					result.DeletedIIDList = append(result.DeletedIIDList, &cres.IID{NameId: node.nameId})
+					continue
+				}
+				cblog.Error(err)
+				if try < destroyMaxTry {
+					retryList = append(retryList, node)
+				} else {
+					failed[node.key()] = true
+					remained(node.rsType, node.nameId, err.Error())
+				}
+			}
+
+			nodeList = retryList
+			if len(nodeList) > 0 {
+				time.Sleep(destroyRetryInterval)
+			}
+		}
+	}
+
+	return destroyedInfo, nil
+}
+
+// deleteResource deletes a resource with its Delete API, not forced.
+func deleteResource(connectionName string, rsType string, nameId string) error {
+	var err error
+	switch rsType {
+	case VPC:
+		_, err = DeleteVPC(connectionName, VPC, nameId, "false")
+	case SG:
+		_, err = DeleteSecurity(connectionName, SG, nameId, "false")
+	case KEY:
+		_, err = DeleteKey(connectionName, KEY, nameId, "false")
+	case VM:
+		_, _, err = DeleteVM(connectionName, VM, nameId, "false")
+	case NLB:
+		_, err = DeleteNLB(connectionName, NLB, nameId, "false")
+	case DISK:
+		_, err = DeleteDisk(connectionName, DISK, nameId, "false")
+	case DISKSNAPSHOT:
+		_, err = DeleteDiskSnapshot(connectionName, DISKSNAPSHOT, "", nameId, "false")
+	case MYIMAGE:
+		_, err = DeleteMyImage(connectionName, MYIMAGE, nameId, "false")
+	case CLUSTER:
+		_, err = DeleteCluster(connectionName, CLUSTER, nameId, "false")
+	case RDBMS:
+		_, err = DeleteRDBMS(connectionName, RDBMS, nameId, "false")
+	case RDBMSSNAPSHOT:
+		_, err = DeleteRDBMSSnapshot(connectionName, RDBMS, "", nameId)
+	case FILESYSTEM:
+		_, err = DeleteFileSystem(connectionName, nameId)
+	case NIC:
+		_, err = DeleteNIC(connectionName, NIC, nameId, "false")
+	case PUBLICIP:
+		_, err = DeletePublicIP(connectionName, PUBLICIP, nameId, "false")
+	case VPCPEERING:
+		_, err = DeleteVPCPeering(connectionName, VPCPEERING, nameId, "false")
+	case ROUTETABLE:
+		_, err = DeleteRouteTable(connectionName, ROUTETABLE, nameId, "false")
+	case NATGATEWAY:
+		_, err = DeleteNATGateway(connectionName, NATGATEWAY, nameId, "false")
+	default:
+		err = fmt.Errorf("%s is not supported Resource!!", rsType)
+	}
+	return err
+}
+
+// makeDestroyPlan builds the resource dependency graph of the connection and
+// sorts the selected resources in topological order.
+func makeDestroyPlan(connectionName string, filter DestroyFilter) (*DestroyPlanInfo, error) {
+	filter.NamePrefix = strings.TrimSpace(filter.NamePrefix)
+	filter.Tag = strings.TrimSpace(filter.Tag)
+
+	plan := &DestroyPlanInfo{
+		ConnectionName: connectionName,
+		Filter:         filter,
+		StepList:       []*DestroyStepInfo{},
+		BlockedList:    []*DestroyBlockedInfo{},
+		WarningList:    []string{},
+		nodeMap:        map[string]*destroyNode{},
+	}
+
+	// (1) nodes from the IID tables
+	nodeListMap := map[string][]*destroyNode{}
+	for _, rsType := range destroyResourceTypes {
+		nameList, err := ListResourceName(connectionName, rsType)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		sort.Strings(nameList)
+		for _, nameId := range nameList {
+			node := &destroyNode{rsType: rsType, nameId: nameId, uses: map[string][]string{}, usedBy: map[string][]string{}}
+			plan.nodeMap[node.key()] = node
+			nodeListMap[rsType] = append(nodeListMap[rsType], node)
+		}
+	}
+
+	// (2) exact dependencies
+	if err := setOwnerVPCDependency(connectionName, nodeListMap); err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	setClusterDependency(plan, connectionName, nodeListMap[CLUSTER])
+	setNLBDependency(plan, connectionName, nodeListMap[NLB])
+	setRDBMSDependency(plan, connectionName, nodeListMap[RDBMS])
+	setVMUsingDependency(plan, connectionName, nodeListMap[VM])
+	setNICDependency(plan, connectionName, nodeListMap[NIC])
+	setPublicIPDependency(plan, connectionName, nodeListMap[PUBLICIP])
+	setVPCPeeringDependency(plan, connectionName, nodeListMap[VPCPEERING])
+	setRouteTableDependency(plan, connectionName, nodeListMap[ROUTETABLE])
+	setNATGatewayDependency(plan, connectionName, nodeListMap[NATGATEWAY])
+
+	// (3) select the resources with the filter
+	for _, rsType := range destroyResourceTypes {
+		for _, node := range nodeListMap[rsType] {
+			node.selected = matchDestroyFilter(plan, connectionName, node, filter)
+		}
+	}
+
+	if err := arrangeDestroyPlan(plan, nodeListMap); err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	return plan, nil
+}
+
+// arrangeDestroyPlan links the nodes with the dependencies, blocks the selected resources
+// which can not be deleted, and sorts the selected resources in topological order.
+func arrangeDestroyPlan(plan *DestroyPlanInfo, nodeListMap map[string][]*destroyNode) error {
+	// (1) edges
+	for _, order := range destroyTypeOrderList {
+		for _, beforeNode := range nodeListMap[order[0]] {
+			for _, afterNode := range nodeListMap[order[1]] {
+				if isDependent(beforeNode, afterNode) {
+					beforeNode.after = append(beforeNode.after, afterNode)
+					afterNode.before = append(afterNode.before, beforeNode)
+				}
+			}
+		}
+	}
+
+	// (2) block the selected resources with unknown dependencies,
+	//     and the selected resources used by the resources not selected or blocked
+	blocked := map[string]bool{}
+	for _, node := range plan.nodeMap {
+		if node.selected && node.lookupErr != nil {
+			blocked[node.key()] = true
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, node := range plan.nodeMap {
+			if !node.selected || blocked[node.key()] {
+				continue
+			}
+			for _, before := range node.before {
+				if !before.selected || blocked[before.key()] {
+					blocked[node.key()] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	for _, rsType := range destroyResourceTypes {
+		for _, node := range nodeListMap[rsType] {
+			if !blocked[node.key()] {
+				continue
+			}
+			if node.lookupErr != nil {
+				plan.BlockedList = append(plan.BlockedList, &DestroyBlockedInfo{ResourceType: node.rsType, NameId: node.nameId,
+					UsedBy: []string{}, Error: node.lookupErr.Error()})
+				continue
+			}
+			usedBy := []string{}
+			for _, before := range node.before {
+				if !before.selected || blocked[before.key()] {
+					usedBy = append(usedBy, before.key())
+				}
+			}
+			plan.BlockedList = append(plan.BlockedList, &DestroyBlockedInfo{ResourceType: node.rsType, NameId: node.nameId, UsedBy: usedBy})
+		}
+	}
+
+	// (3) topological sort of the selected resources
+	inDegree := map[string]int{}
+	var readyList []*destroyNode
+	remainCount := 0
+	for _, rsType := range destroyResourceTypes {
+		for _, node := range nodeListMap[rsType] {
+			if !node.selected || blocked[node.key()] {
+				continue
+			}
+			remainCount++
+			for _, before := range node.before {
+				if before.selected {
+					inDegree[node.key()]++
+				}
+			}
+			if inDegree[node.key()] == 0 {
+				readyList = append(readyList, node)
+			}
+		}
+	}
+
+	for len(readyList) > 0 {
+		step := &DestroyStepInfo{Step: len(plan.StepList) + 1, ResourceList: []*DestroyResourceInfo{}}
+		var nextList []*destroyNode
+		for _, node := range readyList {
+			deleteAfter := []string{}
+			for _, before := range node.before {
+				if before.selected {
+					deleteAfter = append(deleteAfter, before.key())
+				}
+			}
+			step.ResourceList = append(step.ResourceList, &DestroyResourceInfo{ResourceType: node.rsType, NameId: node.nameId, DeleteAfter: deleteAfter})
+			remainCount--
+
+			for _, after := range node.after {
+				if !after.selected || blocked[after.key()] {
+					continue
+				}
+				inDegree[after.key()]--
+				if inDegree[after.key()] == 0 {
+					nextList = append(nextList, after)
+				}
+			}
+		}
+		plan.StepList = append(plan.StepList, step)
+		readyList = nextList
+	}
+
+	// the type order has no cycle, this is a guard for the future changes
+	if remainCount > 0 {
+		return fmt.Errorf("failed to sort the resources of connection '%s': dependency cycle", plan.ConnectionName)
+	}
+
+	return nil
+}
+
+// isDependent returns true if beforeNode must be deleted before afterNode.
+func isDependent(beforeNode *destroyNode, afterNode *destroyNode) bool {
+	if containsString(beforeNode.uses[afterNode.rsType], afterNode.nameId) {
+		return true
+	}
+	return containsString(afterNode.usedBy[beforeNode.rsType], beforeNode.nameId)
+}
+
+func containsString(list []string, str string) bool {
+	for _, one := range list {
+		if one == str {
+			return true
+		}
+	}
+	return false
+}
+
+// setOwnerVPCDependency sets the owner VPC of the VPC dependent resources from the IID tables.
+func setOwnerVPCDependency(connectionName string, nodeListMap map[string][]*destroyNode) error {
+	ownerVPCMap := map[string]string{} // "<rsType>:<NameId>" => owner VPC NameId
+
+	var sgList []*SGIIDInfo
+	if err := infostore.ListByCondition(&sgList, CONNECTION_NAME_COLUMN, connectionName); err != nil {
+		return err
+	}
+	for _, one := range sgList {
+		ownerVPCMap[destroyNodeKey(SG, one.NameId)] = one.OwnerVPCName
+	}
+
+	var nlbList []*NLBIIDInfo
+	if err := infostore.ListByCondition(&nlbList, CONNECTION_NAME_COLUMN, connectionName); err != nil {
+		return err
+	}
+	for _, one := range nlbList {
+		ownerVPCMap[destroyNodeKey(NLB, one.NameId)] = one.OwnerVPCName
+	}
+
+	var clusterList []*ClusterIIDInfo
+	if err := infostore.ListByCondition(&clusterList, CONNECTION_NAME_COLUMN, connectionName); err != nil {
+		return err
+	}
+	for _, one := range clusterList {
+		ownerVPCMap[destroyNodeKey(CLUSTER, one.NameId)] = one.OwnerVPCName
+	}
+
+	var rdbmsList []*RDBMSIIDInfo
+	if err := infostore.ListByCondition(&rdbmsList, CONNECTION_NAME_COLUMN, connectionName); err != nil {
+		return err
+	}
+	for _, one := range rdbmsList {
+		ownerVPCMap[destroyNodeKey(RDBMS, one.NameId)] = one.OwnerVPCName
+	}
+
+	var routeTableList []*RouteTableIIDInfo
+	if err := infostore.ListByCondition(&routeTableList, CONNECTION_NAME_COLUMN, connectionName); err != nil {
+		return err
+	}
+	for _, one := range routeTableList {
+		ownerVPCMap[destroyNodeKey(ROUTETABLE, one.NameId)] = one.OwnerVPCName
+	}
+
+	var natGatewayList []*NATGatewayIIDInfo
+	if err := infostore.ListByCondition(&natGatewayList, CONNECTION_NAME_COLUMN, connectionName); err != nil {
+		return err
+	}
+	for _, one := range natGatewayList {
+		ownerVPCMap[destroyNodeKey(NATGATEWAY, one.NameId)] = one.OwnerVPCName
+	}
+
+	var fsList []*FileSystemIIDInfo
+	if err := infostore.ListByCondition(&fsList, CONNECTION_NAME_COLUMN, connectionName); err != nil {
+		return err
+	}
+	for _, one := range fsList {
+		ownerVPCMap[destroyNodeKey(FILESYSTEM, one.NameId)] = one.OwnerVPCName
+	}
+
+	for _, rsType := range []string{SG, NLB, CLUSTER, RDBMS, FILESYSTEM, ROUTETABLE, NATGATEWAY} {
+		for _, node := range nodeListMap[rsType] {
+			if vpcName, ok := ownerVPCMap[node.key()]; ok && vpcName != "" {
+				node.uses[VPC] = []string{vpcName}
+			}
+		}
+	}
+	return nil
+}
+
+// lookupFailed records the failure of the resource info retrieval of a node.
+func (plan *DestroyPlanInfo) lookupFailed(node *destroyNode, err error) {
+	node.lookupErr = err
+	plan.WarningList = append(plan.WarningList, fmt.Sprintf("failed to get %s: %v", node.key(), err))
+}
+
+// setClusterDependency sets the SecurityGroups and KeyPairs used by the Clusters.
+func setClusterDependency(plan *DestroyPlanInfo, connectionName string, clusterNodeList []*destroyNode) {
+	for _, node := range clusterNodeList {
+		clusterInfo, err := GetCluster(connectionName, CLUSTER, node.nameId, "")
+		if err != nil {
+			plan.lookupFailed(node, err)
+			continue
+		}
+		node.uses[SG] = []string{}
+		for _, sgIID := range clusterInfo.Network.SecurityGroupIIDs {
+			node.uses[SG] = append(node.uses[SG], sgIID.NameId)
+		}
+		node.uses[KEY] = []string{}
+		for _, nodeGroup := range clusterInfo.NodeGroupList {
+			node.uses[KEY] = append(node.uses[KEY], nodeGroup.KeyPairIID.NameId)
+		}
+		node.tagList, node.tagKnown = clusterInfo.TagList, true
+	}
+}
+
+// setNLBDependency sets the VMs of the VMGroups of the NLBs.
+func setNLBDependency(plan *DestroyPlanInfo, connectionName string, nlbNodeList []*destroyNode) {
+	for _, node := range nlbNodeList {
+		nlbInfo, err := GetNLB(connectionName, NLB, node.nameId)
+		if err != nil {
+			plan.lookupFailed(node, err)
+			continue
+		}
+		node.uses[VM] = []string{}
+		if nlbInfo.VMGroup.VMs != nil {
+			for _, vmIID := range *nlbInfo.VMGroup.VMs {
+				node.uses[VM] = append(node.uses[VM], vmIID.NameId)
+			}
+		}
+		node.tagList, node.tagKnown = nlbInfo.TagList, true
+	}
+}
+
+// setRDBMSDependency sets the SecurityGroups used by the RDBMSs.
+func setRDBMSDependency(plan *DestroyPlanInfo, connectionName string, rdbmsNodeList []*destroyNode) {
+	for _, node := range rdbmsNodeList {
+		rdbmsInfo, err := GetRDBMS(connectionName, RDBMS, node.nameId)
+		if err != nil {
+			plan.lookupFailed(node, err)
+			continue
+		}
+		node.uses[SG] = []string{}
+		for _, sgIID := range rdbmsInfo.SecurityGroupIIDs {
+			node.uses[SG] = append(node.uses[SG], sgIID.NameId)
+		}
+		node.tagList, node.tagKnown = rdbmsInfo.TagList, true
+	}
+}
+
+// setVMUsingDependency sets the VPC, SecurityGroups, KeyPair and Disks used by the VMs.
+func setVMUsingDependency(plan *DestroyPlanInfo, connectionName string, vmNodeList []*destroyNode) {
+	for _, node := range vmNodeList {
+		vmInfo, err := GetVM(connectionName, VM, node.nameId)
+		if err != nil {
+			plan.lookupFailed(node, err)
+			continue
+		}
+		node.uses[VPC] = []string{vmInfo.VpcIID.NameId}
+		node.uses[SG] = []string{}
+		for _, sgIID := range vmInfo.SecurityGroupIIds {
+			node.uses[SG] = append(node.uses[SG], sgIID.NameId)
+		}
+		node.uses[KEY] = []string{vmInfo.KeyPairIId.NameId}
+		node.uses[DISK] = []string{}
+		for _, diskIID := range vmInfo.DataDiskIIDs {
+			node.uses[DISK] = append(node.uses[DISK], diskIID.NameId)
+		}
+		node.tagList, node.tagKnown = vmInfo.TagList, true
+	}
+}
+
+// setNICDependency sets the VPC and SecurityGroups used by the NICs, and the VM using the NIC.
+func setNICDependency(plan *DestroyPlanInfo, connectionName string, nicNodeList []*destroyNode) {
+	for _, node := range nicNodeList {
+		nicInfo, err := GetNIC(connectionName, NIC, node.nameId)
+		if err != nil {
+			plan.lookupFailed(node, err)
+			continue
+		}
+		node.uses[VPC] = []string{nicInfo.VpcIID.NameId}
+		node.uses[SG] = []string{}
+		for _, sgIID := range nicInfo.SecurityGroupIIDs {
+			node.uses[SG] = append(node.uses[SG], sgIID.NameId)
+		}
+		node.usedBy[VM] = []string{nicInfo.OwnerVM.NameId}
+		node.tagList, node.tagKnown = nicInfo.TagList, true
+	}
+}
+
+// setPublicIPDependency sets the VM and NIC using the PublicIPs.
+func setPublicIPDependency(plan *DestroyPlanInfo, connectionName string, publicIPNodeList []*destroyNode) {
+	for _, node := range publicIPNodeList {
+		publicIPInfo, err := GetPublicIP(connectionName, PUBLICIP, node.nameId)
+		if err != nil {
+			plan.lookupFailed(node, err)
+			continue
+		}
+		node.usedBy[VM] = []string{publicIPInfo.OwnedVM.NameId}
+		node.usedBy[NIC] = []string{publicIPInfo.OwnedNIC.NameId}
+		node.tagList, node.tagKnown = publicIPInfo.TagList, true
+	}
+}
+
+// setVPCPeeringDependency sets the requester VPC and the accepter VPC of the VPC peerings.
+func setVPCPeeringDependency(plan *DestroyPlanInfo, connectionName string, peeringNodeList []*destroyNode) {
+	for _, node := range peeringNodeList {
+		peeringInfo, err := GetVPCPeering(connectionName, VPCPEERING, node.nameId)
+		if err != nil {
+			plan.lookupFailed(node, err)
+			continue
+		}
+		node.uses[VPC] = []string{peeringInfo.RequesterVpcIID.NameId, peeringInfo.AccepterVpcIID.NameId}
+		node.tagList, node.tagKnown = peeringInfo.TagList, true
+	}
+}
+
+// setRouteTableDependency sets the NAT Gateways, NICs and VPC peerings used as the route targets.
+func setRouteTableDependency(plan *DestroyPlanInfo, connectionName string, routeTableNodeList []*destroyNode) {
+	for _, node := range routeTableNodeList {
+		routeTableInfo, err := GetRouteTable(connectionName, ROUTETABLE, node.nameId)
+		if err != nil {
+			plan.lookupFailed(node, err)
+			continue
+		}
+		node.uses[NATGATEWAY] = []string{}
+		node.uses[NIC] = []string{}
+		node.uses[VPCPEERING] = []string{}
+		for _, route := range routeTableInfo.RouteList {
+			switch route.TargetType {
+			case cres.RouteTargetNATGateway:
+				node.uses[NATGATEWAY] = append(node.uses[NATGATEWAY], route.TargetIID.NameId)
+			case cres.RouteTargetNIC:
+				node.uses[NIC] = append(node.uses[NIC], route.TargetIID.NameId)
+			case cres.RouteTargetVPCPeering:
+				node.uses[VPCPEERING] = append(node.uses[VPCPEERING], route.TargetIID.NameId)
+			}
+		}
+		node.tagList, node.tagKnown = routeTableInfo.TagList, true
+	}
+}
+
+// setNATGatewayDependency sets the PublicIP used by the NAT Gateways.
+func setNATGatewayDependency(plan *DestroyPlanInfo, connectionName string, natGatewayNodeList []*destroyNode) {
+	for _, node := range natGatewayNodeList {
+		natGatewayInfo, err := GetNATGateway(connectionName, NATGATEWAY, node.nameId)
+		if err != nil {
+			plan.lookupFailed(node, err)
+			continue
+		}
+		node.uses[PUBLICIP] = []string{}
+		if natGatewayInfo.PublicIPIID.NameId != "" {
+			node.uses[PUBLICIP] = append(node.uses[PUBLICIP], natGatewayInfo.PublicIPIID.NameId)
+		}
+		node.tagList, node.tagKnown = natGatewayInfo.TagList, true
+	}
+}
+
+// matchDestroyFilter returns true if the resource is selected by the filter.
+// A resource is not selected if its tags are required but can not be retrieved.
+func matchDestroyFilter(plan *DestroyPlanInfo, connectionName string, node *destroyNode, filter DestroyFilter) bool {
+	if !strings.HasPrefix(node.nameId, filter.NamePrefix) {
+		return false
+	}
+	if filter.Tag == "" {
+		return true
+	}
+
+	if !node.tagKnown {
+		tagList, err := ListTag(connectionName, cres.RSType(node.rsType), node.nameId)
+		if err != nil {
+			plan.WarningList = append(plan.WarningList, fmt.Sprintf("%s is not selected, failed to get the tags: %v", node.key(), err))
+			return false
+		}
+		node.tagList, node.tagKnown = tagList, true
+	}
+
+	return matchTagFilter(node.tagList, filter.Tag)
+}
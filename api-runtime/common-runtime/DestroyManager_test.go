@@ -0,0 +1,182 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"errors"
+	"testing"
+)
+
+type destroyTestGraph struct {
+	plan        *DestroyPlanInfo
+	nodeListMap map[string][]*destroyNode
+}
+
+func newDestroyTestGraph() *destroyTestGraph {
+	return &destroyTestGraph{
+		plan: &DestroyPlanInfo{
+			ConnectionName: "mock-config01",
+			StepList:       []*DestroyStepInfo{},
+			BlockedList:    []*DestroyBlockedInfo{},
+			WarningList:    []string{},
+			nodeMap:        map[string]*destroyNode{},
+		},
+		nodeListMap: map[string][]*destroyNode{},
+	}
+}
+
+func (graph *destroyTestGraph) add(rsType string, nameId string, selected bool) *destroyNode {
+	node := &destroyNode{rsType: rsType, nameId: nameId, selected: selected,
+		uses: map[string][]string{}, usedBy: map[string][]string{}}
+	graph.plan.nodeMap[node.key()] = node
+	graph.nodeListMap[rsType] = append(graph.nodeListMap[rsType], node)
+	return node
+}
+
+func (graph *destroyTestGraph) arrange(t *testing.T) {
+	if err := arrangeDestroyPlan(graph.plan, graph.nodeListMap); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (graph *destroyTestGraph) stepOf(key string) int {
+	for _, step := range graph.plan.StepList {
+		for _, rsInfo := range step.ResourceList {
+			if destroyNodeKey(rsInfo.ResourceType, rsInfo.NameId) == key {
+				return step.Step
+			}
+		}
+	}
+	return 0
+}
+
+func (graph *destroyTestGraph) blocked(key string) *DestroyBlockedInfo {
+	for _, blocked := range graph.plan.BlockedList {
+		if destroyNodeKey(blocked.ResourceType, blocked.NameId) == key {
+			return blocked
+		}
+	}
+	return nil
+}
+
+// The resources not selected block only the selected resources they use.
+func TestDestroyPartialSelection(t *testing.T) {
+	graph := newDestroyTestGraph()
+
+	graph.add(VPC, "env1-vpc", true)
+	graph.add(SG, "env1-sg", true).uses[VPC] = []string{"env1-vpc"}
+	graph.add(KEY, "env1-key", true)
+	vm := graph.add(VM, "env1-vm", true)
+	vm.uses[VPC] = []string{"env1-vpc"}
+	vm.uses[SG] = []string{"env1-sg"}
+	vm.uses[KEY] = []string{"env1-key"}
+
+	// not selected, in another VPC
+	graph.add(VPC, "env2-vpc", false)
+	graph.add(SG, "env2-sg", false).uses[VPC] = []string{"env2-vpc"}
+	nlb := graph.add(NLB, "env2-nlb", false)
+	nlb.uses[VPC] = []string{"env2-vpc"}
+	nlb.uses[VM] = []string{"env2-vm"}
+	graph.add(VM, "env2-vm", false)
+	cluster := graph.add(CLUSTER, "env2-cluster", false)
+	cluster.uses[VPC] = []string{"env2-vpc"}
+	cluster.uses[SG] = []string{"env2-sg"}
+	cluster.uses[KEY] = []string{}
+	rdbms := graph.add(RDBMS, "env2-rdbms", false)
+	rdbms.uses[VPC] = []string{"env2-vpc"}
+	rdbms.uses[SG] = []string{"env2-sg"}
+
+	graph.arrange(t)
+
+	if len(graph.plan.BlockedList) != 0 {
+		t.Fatalf("expected no blocked resources, got %d: %s", len(graph.plan.BlockedList), graph.plan.BlockedList[0].NameId)
+	}
+	vmStep := graph.stepOf(destroyNodeKey(VM, "env1-vm"))
+	if vmStep != 1 {
+		t.Fatalf("expected the VM in step 1, got %d", vmStep)
+	}
+	for _, key := range []string{destroyNodeKey(SG, "env1-sg"), destroyNodeKey(KEY, "env1-key")} {
+		if graph.stepOf(key) <= vmStep {
+			t.Errorf("expected %s after the VM", key)
+		}
+	}
+	if graph.stepOf(destroyNodeKey(VPC, "env1-vpc")) <= graph.stepOf(destroyNodeKey(SG, "env1-sg")) {
+		t.Errorf("expected the VPC after the SecurityGroup")
+	}
+	if graph.stepOf(destroyNodeKey(VM, "env2-vm")) != 0 {
+		t.Errorf("expected the VM not selected not to be deleted")
+	}
+}
+
+// A selected resource used by a resource not selected is blocked with the resources it uses.
+func TestDestroyBlockedByUnselected(t *testing.T) {
+	graph := newDestroyTestGraph()
+
+	graph.add(VPC, "vpc-01", true)
+	graph.add(SG, "sg-01", true).uses[VPC] = []string{"vpc-01"}
+	graph.add(SG, "sg-02", true).uses[VPC] = []string{"vpc-01"}
+	graph.add(VM, "vm-01", true).uses[SG] = []string{"sg-01"}
+	nlb := graph.add(NLB, "nlb-01", false)
+	nlb.uses[VM] = []string{"vm-01"}
+	rdbms := graph.add(RDBMS, "rdbms-01", false)
+	rdbms.uses[SG] = []string{"sg-02"}
+
+	graph.arrange(t)
+
+	for key, usedBy := range map[string]string{
+		destroyNodeKey(VM, "vm-01"): destroyNodeKey(NLB, "nlb-01"),
+		destroyNodeKey(SG, "sg-02"): destroyNodeKey(RDBMS, "rdbms-01"),
+	} {
+		blocked := graph.blocked(key)
+		if blocked == nil {
+			t.Fatalf("expected %s to be blocked", key)
+		}
+		if len(blocked.UsedBy) != 1 || blocked.UsedBy[0] != usedBy {
+			t.Errorf("expected %s to be used by %s, got %v", key, usedBy, blocked.UsedBy)
+		}
+	}
+	// sg-01 is used by the blocked vm-01, and vpc-01 by the blocked SecurityGroups
+	for _, key := range []string{destroyNodeKey(SG, "sg-01"), destroyNodeKey(VPC, "vpc-01")} {
+		if graph.blocked(key) == nil {
+			t.Errorf("expected %s to be blocked", key)
+		}
+	}
+	if len(graph.plan.StepList) != 0 {
+		t.Errorf("expected no steps, got %d", len(graph.plan.StepList))
+	}
+}
+
+// A resource whose info can not be retrieved fails alone.
+func TestDestroyLookupFailure(t *testing.T) {
+	graph := newDestroyTestGraph()
+
+	graph.add(VPC, "vpc-01", true)
+	graph.add(SG, "sg-01", true).uses[VPC] = []string{"vpc-01"}
+	graph.add(KEY, "key-01", true)
+	graph.add(VM, "vm-01", true).lookupErr = errors.New("vm-01 does not exist")
+	vm := graph.add(VM, "vm-02", true)
+	vm.uses[SG] = []string{"sg-01"}
+	vm.uses[KEY] = []string{"key-01"}
+	graph.add(NLB, "nlb-01", false).lookupErr = errors.New("timeout")
+
+	graph.arrange(t)
+
+	blocked := graph.blocked(destroyNodeKey(VM, "vm-01"))
+	if blocked == nil || blocked.Error == "" {
+		t.Fatalf("expected vm-01 to be blocked with the lookup error")
+	}
+	if len(graph.plan.BlockedList) != 1 {
+		t.Fatalf("expected only vm-01 to be blocked, got %d blocked", len(graph.plan.BlockedList))
+	}
+	for _, key := range []string{destroyNodeKey(VM, "vm-02"), destroyNodeKey(SG, "sg-01"), destroyNodeKey(KEY, "key-01"), destroyNodeKey(VPC, "vpc-01")} {
+		if graph.stepOf(key) == 0 {
+			t.Errorf("expected %s to be deleted", key)
+		}
+	}
+}
@@ -250,6 +250,20 @@ func CreateDisk(connectionName string, rsType string, reqInfo cres.DiskInfo, IDT
 		return nil, err
 	}
 
+	// get Source Snapshot's IID to create the Disk from the Snapshot
+	sourceSnapshotName := reqInfo.SourceSnapshotIID.NameId
+	if sourceSnapshotName != "" {
+		diskSnapshotSPLock.RLock(connectionName, sourceSnapshotName)
+		defer diskSnapshotSPLock.RUnlock(connectionName, sourceSnapshotName)
+
+		snapshotIIdInfo, err := getDiskSnapshotIIDInfo(connectionName, "", sourceSnapshotName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		reqInfo.SourceSnapshotIID = getDriverIID(cres.IID{NameId: snapshotIIdInfo.NameId, SystemId: snapshotIIdInfo.SystemId})
+	}
+
 	spUUID := ""
 	if GetID_MGMT(IDTransformMode) == "ON" { // Use IID Management
 		// (2) generate SP-XID and create reqIID, driverIID
@@ -305,6 +319,9 @@ func CreateDisk(connectionName string, rsType string, reqInfo cres.DiskInfo, IDT
 	// (6) create userIID: {reqNameID, driverSystemID}
 	//     ex) userIID {"seoul-service", "i-0bc7123b7e5cbf79d"}
 	info.IId = getUserIID(cres.IID{NameId: spiderIId.NameId, SystemId: spiderIId.SystemId})
+	if sourceSnapshotName != "" {
+		info.SourceSnapshotIID.NameId = sourceSnapshotName
+	}
 
 	return &info, nil
 }
@@ -406,6 +423,7 @@ func ListDisk(connectionName string, rsType string) ([]*cres.DiskInfo, error) {
 			}
 			info.OwnerVM.NameId = vmIIdInfo.NameId
 		}
+		setSourceSnapshotNameId(connectionName, &info)
 
 		infoList2 = append(infoList2, &info)
 	}
@@ -506,6 +524,7 @@ func GetDisk(connectionName string, rsType string, nameID string) (*cres.DiskInf
 		}
 		info.OwnerVM.NameId = vmIIdInfo.NameId
 	}
+	setSourceSnapshotNameId(connectionName, &info)
 
 	return &info, nil
 }
@@ -0,0 +1,467 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"fmt"
+	"os"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// ====================================================================
+// type for GORM
+
+type DiskSnapshotIIDInfo struct {
+	ConnectionName string `gorm:"primaryKey"` // ex) "aws-seoul-config"
+	ZoneId         string // Zone of the source Disk, ex) "ap-northeast-2a"
+	NameId         string `gorm:"primaryKey"` // ex) "disk-01-snapshot"
+	SystemId       string // ID in CSP, ex) "snap-0bc7123b7e5cbf79d"
+	SourceDiskName string // ex) "disk-01" - NOT primaryKey, kept after the source Disk is deleted
+}
+
+func (DiskSnapshotIIDInfo) TableName() string {
+	return "disk_snapshot_iid_infos"
+}
+
+//====================================================================
+
+func init() {
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	db.AutoMigrate(&DiskSnapshotIIDInfo{})
+	infostore.Close(db)
+}
+
+// ErrNotSnapshotOfDisk is returned when a snapshot is accessed under a Disk
+// which is not the source Disk of the snapshot.
+var ErrNotSnapshotOfDisk = fmt.Errorf("not a snapshot of the Disk")
+
+//================ DiskSnapshot Handler
+
+// (1) check exist(NameID)
+// (2) get the source Disk's IID
+// (3) generate SP-XID and create reqIID, driverIID
+// (4) create Resource
+// (5) create spiderIID: {reqNameID, "driverNameID:driverSystemID"}
+// (6) insert spiderIID
+// (7) create userIID
+func CreateDiskSnapshot(connectionName string, rsType string, diskName string, reqInfo cres.DiskSnapshotInfo, IDTransformMode string) (*cres.DiskSnapshotInfo, error) {
+	cblog.Info("call CreateDiskSnapshot()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if err := checkCapability(connectionName, DISK_SNAPSHOT_HANDLER); err != nil {
+		return nil, err
+	}
+
+	diskName, err = EmptyCheckAndTrim("diskName", diskName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	reqInfo.IId.NameId, err = EmptyCheckAndTrim("snapshotName", reqInfo.IId.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	diskSnapshotSPLock.Lock(connectionName, reqInfo.IId.NameId)
+	defer diskSnapshotSPLock.Unlock(connectionName, reqInfo.IId.NameId)
+
+	// (1) check exist(NameID)
+	bool_ret := false
+	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+		bool_ret, err = infostore.HasByCondition(&DiskSnapshotIIDInfo{}, NAME_ID_COLUMN, reqInfo.IId.NameId)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	} else {
+		bool_ret, err = infostore.HasByConditions(&DiskSnapshotIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN,
+			reqInfo.IId.NameId)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
+	if bool_ret {
+		err := fmt.Errorf("%s '%s' already exists in connection '%s'", RSTypeString(DISKSNAPSHOT), reqInfo.IId.NameId, connectionName)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) get the source Disk's IID
+	diskSPLock.RLock(connectionName, diskName)
+	defer diskSPLock.RUnlock(connectionName, diskName)
+
+	var diskIIdInfo DiskIIDInfo
+	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+		var iidInfoList []*DiskIIDInfo
+		err = getAuthIIDInfoList(connectionName, &iidInfoList)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, diskName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		diskIIdInfo = *castedIIDInfo.(*DiskIIDInfo)
+	} else {
+		err = infostore.GetByConditions(&diskIIdInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, diskName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
+	spUUID := ""
+	if GetID_MGMT(IDTransformMode) == "ON" { // Use IID Management
+		// (3) generate SP-XID and create reqIID, driverIID
+		spUUID, err = iidm.New(connectionName, rsType, reqInfo.IId.NameId)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	} else { // No Use IID Management
+		spUUID = reqInfo.IId.NameId
+	}
+
+	// reqIID
+	reqIId := cres.IID{NameId: reqInfo.IId.NameId, SystemId: spUUID}
+	// driverIID
+	driverIId := cres.IID{NameId: spUUID, SystemId: ""}
+	reqInfo.IId = driverIId
+	reqInfo.SourceDisk = getDriverIID(cres.IID{NameId: diskIIdInfo.NameId, SystemId: diskIIdInfo.SystemId})
+
+	cldConn, err := ccm.GetZoneLevelCloudConnection(connectionName, diskIIdInfo.ZoneId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateDiskSnapshotHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (4) create Resource
+	info, err := handler.CreateSnapshot(reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (5) create spiderIID: {reqNameID, "driverNameID:driverSystemID"}
+	//     ex) spiderIID {"disk-01-backup", "disk-01-backup-9m4e2mr0ui3e8a215n4g:snap-0bc7123b7e5cbf79d"}
+	spiderIId := cres.IID{NameId: reqIId.NameId, SystemId: info.IId.NameId + ":" + info.IId.SystemId}
+
+	// (6) insert spiderIID
+	iidInfo := DiskSnapshotIIDInfo{ConnectionName: connectionName, ZoneId: diskIIdInfo.ZoneId, NameId: spiderIId.NameId, SystemId: spiderIId.SystemId,
+		SourceDiskName: diskIIdInfo.NameId}
+	err = infostore.Insert(&iidInfo)
+	if err != nil {
+		cblog.Error(err)
+		// rollback
+		_, err2 := handler.DeleteSnapshot(info.IId)
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
+		}
+		return nil, err
+	}
+
+	// (7) create userIID: {reqNameID, driverSystemID}
+	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	info.SourceDisk = getUserIID(cres.IID{NameId: diskIIdInfo.NameId, SystemId: diskIIdInfo.SystemId})
+
+	return &info, nil
+}
+
+// (1) get IID:list
+// (2) get DiskSnapshotInfo:list
+// (3) set userIID, and ...
+// If diskName is not empty, only the snapshots of the Disk are listed.
+func ListDiskSnapshot(connectionName string, rsType string, diskName string) ([]*cres.DiskSnapshotInfo, error) {
+	cblog.Info("call ListDiskSnapshot()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if err := checkCapability(connectionName, DISK_SNAPSHOT_HANDLER); err != nil {
+		return nil, err
+	}
+
+	// (1) get IID:list
+	var iidInfoList []*DiskSnapshotIIDInfo
+	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+		err = getAuthIIDInfoList(connectionName, &iidInfoList)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	} else {
+		err = infostore.ListByCondition(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
+	// (2) Get DiskSnapshotInfo-list with IID-list
+	infoList := []*cres.DiskSnapshotInfo{}
+	for _, iidInfo := range iidInfoList {
+		// the source Disk recorded at creation, valid after the Disk is deleted
+		if diskName != "" && iidInfo.SourceDiskName != diskName {
+			continue
+		}
+
+		diskSnapshotSPLock.RLock(connectionName, iidInfo.NameId)
+
+		cldConn, err := ccm.GetZoneLevelCloudConnection(connectionName, iidInfo.ZoneId)
+		if err != nil {
+			diskSnapshotSPLock.RUnlock(connectionName, iidInfo.NameId)
+			cblog.Error(err)
+			return nil, err
+		}
+
+		handler, err := cldConn.CreateDiskSnapshotHandler()
+		if err != nil {
+			diskSnapshotSPLock.RUnlock(connectionName, iidInfo.NameId)
+			cblog.Error(err)
+			return nil, err
+		}
+
+		// get resource(SystemId)
+		info, err := handler.GetSnapshot(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+		if err != nil {
+			diskSnapshotSPLock.RUnlock(connectionName, iidInfo.NameId)
+			if checkNotFoundError(err) {
+				cblog.Error(err)
+				info = cres.DiskSnapshotInfo{IId: cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}}
+				infoList = append(infoList, &info)
+				continue
+			}
+			cblog.Error(err)
+			return nil, err
+		}
+		diskSnapshotSPLock.RUnlock(connectionName, iidInfo.NameId)
+
+		// (3) set userIID, and ...
+		info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+		setSourceDiskNameId(iidInfo, &info)
+
+		infoList = append(infoList, &info)
+	}
+
+	return infoList, nil
+}
+
+// (1) get IID(NameId)
+// (2) get resource(SystemId)
+// (3) set ResourceInfo(IID.NameId)
+// If diskName is not empty, the snapshot must be a snapshot of the Disk.
+func GetDiskSnapshot(connectionName string, rsType string, diskName string, nameID string) (*cres.DiskSnapshotInfo, error) {
+	cblog.Info("call GetDiskSnapshot()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	nameID, err = EmptyCheckAndTrim("nameID", nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if err := checkCapability(connectionName, DISK_SNAPSHOT_HANDLER); err != nil {
+		return nil, err
+	}
+
+	diskSnapshotSPLock.RLock(connectionName, nameID)
+	defer diskSnapshotSPLock.RUnlock(connectionName, nameID)
+
+	// (1) get IID(NameId)
+	iidInfo, err := getDiskSnapshotIIDInfo(connectionName, diskName, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	cldConn, err := ccm.GetZoneLevelCloudConnection(connectionName, iidInfo.ZoneId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateDiskSnapshotHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) get resource(SystemId)
+	info, err := handler.GetSnapshot(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) set ResourceInfo(IID.NameId)
+	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	setSourceDiskNameId(iidInfo, &info)
+
+	return &info, nil
+}
+
+// If diskName is not empty, the snapshot must be a snapshot of the Disk.
+func DeleteDiskSnapshot(connectionName string, rsType string, diskName string, nameID string, force string) (bool, error) {
+	cblog.Info("call DeleteDiskSnapshot()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	nameID, err = EmptyCheckAndTrim("nameID", nameID)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	if err := checkCapability(connectionName, DISK_SNAPSHOT_HANDLER); err != nil {
+		return false, err
+	}
+
+	diskSnapshotSPLock.Lock(connectionName, nameID)
+	defer diskSnapshotSPLock.Unlock(connectionName, nameID)
+
+	// (1) get spiderIID for creating driverIID
+	iidInfo, err := getDiskSnapshotIIDInfo(connectionName, diskName, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	cldConn, err := ccm.GetZoneLevelCloudConnection(connectionName, iidInfo.ZoneId)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	handler, err := cldConn.CreateDiskSnapshotHandler()
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	// (2) delete Resource(SystemId)
+	driverIId := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	result := false
+	result, err = handler.DeleteSnapshot(driverIId)
+	if err != nil {
+		cblog.Error(err)
+		if checkNotFoundError(err) {
+			// if not found in CSP, continue
+			force = "true"
+		} else if force != "true" {
+			return false, err
+		}
+	}
+	if force != "true" {
+		if !result {
+			return result, nil
+		}
+	}
+
+	// (3) delete IID
+	_, err = infostore.DeleteByConditions(&DiskSnapshotIIDInfo{}, CONNECTION_NAME_COLUMN, iidInfo.ConnectionName, NAME_ID_COLUMN, iidInfo.NameId)
+	if err != nil {
+		cblog.Error(err)
+		if force != "true" {
+			return false, err
+		}
+	}
+
+	return result, nil
+}
+
+// getDiskSnapshotIIDInfo returns the snapshot's IIDInfo. If diskName is not empty,
+// the snapshot must be a snapshot of the Disk.
+func getDiskSnapshotIIDInfo(connectionName string, diskName string, nameID string) (*DiskSnapshotIIDInfo, error) {
+	var iidInfo DiskSnapshotIIDInfo
+	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+		var iidInfoList []*DiskSnapshotIIDInfo
+		err := getAuthIIDInfoList(connectionName, &iidInfoList)
+		if err != nil {
+			return nil, err
+		}
+		castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, nameID)
+		if err != nil {
+			return nil, err
+		}
+		iidInfo = *castedIIDInfo.(*DiskSnapshotIIDInfo)
+	} else {
+		err := infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, nameID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if diskName != "" && iidInfo.SourceDiskName != diskName {
+		return nil, fmt.Errorf("%s '%s' of Disk '%s': %w", RSTypeString(DISKSNAPSHOT), nameID, diskName, ErrNotSnapshotOfDisk)
+	}
+	return &iidInfo, nil
+}
+
+// set the source Disk's NameId with the Disk recorded at the snapshot creation,
+// the source Disk can be deleted after the snapshot is created
+func setSourceDiskNameId(iidInfo *DiskSnapshotIIDInfo, info *cres.DiskSnapshotInfo) {
+	info.SourceDisk.NameId = iidInfo.SourceDiskName
+}
+
+// set the source Snapshot's NameId of a Disk with the Snapshot's SystemId
+func setSourceSnapshotNameId(connectionName string, info *cres.DiskInfo) {
+	if info.SourceSnapshotIID.SystemId == "" {
+		return
+	}
+
+	var snapshotIIdInfo DiskSnapshotIIDInfo
+	err := infostore.GetByContain(&snapshotIIdInfo, CONNECTION_NAME_COLUMN, connectionName, SYSTEM_ID_COLUMN, info.SourceSnapshotIID.SystemId)
+	if err != nil {
+		// the source Snapshot can be deleted after the Disk is created
+		cblog.Info(err)
+		return
+	}
+	info.SourceSnapshotIID.NameId = snapshotIIdInfo.NameId
+}
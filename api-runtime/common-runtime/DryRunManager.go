@@ -0,0 +1,335 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Dry-Run Manager — plans the resource creations with the runtime-side validations
+// without calling the CSP's mutating APIs.
+// Only the VM and Cluster creations are planned, the other APIs reject '?dryRun' with 400(see DryRunMiddleware).
+// The quota headroom check is best-effort, see planQuotaHeadroom().
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	cdcom "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/common"
+)
+
+const (
+	PlanCheckPassed  = "PASSED"
+	PlanCheckFailed  = "FAILED"
+	PlanCheckSkipped = "SKIPPED"
+	PlanCheckWarning = "WARNING"
+)
+
+// CreatePlanInfo represents the plan of a resource creation(dry-run).
+type CreatePlanInfo struct {
+	ConnectionName    string             `json:"ConnectionName" validate:"required" example:"aws-seoul-config"`
+	ResourceType      string             `json:"ResourceType" validate:"required" example:"VM"`
+	NameId            string             `json:"NameId" validate:"required" example:"vm-01"`
+	WouldSucceed      bool               `json:"WouldSucceed" validate:"required" example:"true"` // false if there are any blocking problems
+	CheckList         []PlanCheckInfo    `json:"CheckList" validate:"required"`
+	ResolvedResources []PlanResourceInfo `json:"ResolvedResources" validate:"required"` // referenced resources resolved to the CSP IDs
+	BlockingProblems  []string           `json:"BlockingProblems" validate:"required"`
+	Warnings          []string           `json:"Warnings" validate:"required"`
+}
+
+// PlanCheckInfo represents the result of a validation of the plan.
+type PlanCheckInfo struct {
+	Check   string `json:"Check" validate:"required" example:"NameId uniqueness"`
+	Status  string `json:"Status" validate:"required" example:"PASSED"` // PASSED | FAILED | SKIPPED | WARNING
+	Message string `json:"Message,omitempty" example:""`
+}
+
+// PlanResourceInfo represents a referenced resource of the plan.
+type PlanResourceInfo struct {
+	ResourceType string `json:"ResourceType" validate:"required" example:"Subnet"`
+	NameId       string `json:"NameId" validate:"required" example:"subnet-01"`
+	SystemId     string `json:"SystemId" validate:"required" example:"subnet-0a1b2c3d"` // CSP ID
+}
+
+// keywords to find the compute service type of QuotaInfoHandler
+var computeQuotaServiceKeywords = []string{"compute", "ec2", "vm", "ecs", "cvm", "server"}
+
+func newCreatePlan(connectionName string, rsType string, nameId string) *CreatePlanInfo {
+	return &CreatePlanInfo{
+		ConnectionName:    connectionName,
+		ResourceType:      RSTypeString(rsType),
+		NameId:            nameId,
+		CheckList:         []PlanCheckInfo{},
+		ResolvedResources: []PlanResourceInfo{},
+		BlockingProblems:  []string{},
+		Warnings:          []string{},
+	}
+}
+
+// check records the result of a validation and returns true if it is passed.
+func (plan *CreatePlanInfo) check(checkName string, err error) bool {
+	if err != nil {
+		cblog.Error(err)
+		plan.CheckList = append(plan.CheckList, PlanCheckInfo{Check: checkName, Status: PlanCheckFailed, Message: err.Error()})
+		plan.BlockingProblems = append(plan.BlockingProblems, checkName+": "+err.Error())
+		return false
+	}
+	plan.CheckList = append(plan.CheckList, PlanCheckInfo{Check: checkName, Status: PlanCheckPassed})
+	return true
+}
+
+func (plan *CreatePlanInfo) skip(checkName string, reason string) {
+	plan.CheckList = append(plan.CheckList, PlanCheckInfo{Check: checkName, Status: PlanCheckSkipped, Message: reason})
+}
+
+// warn records a failed validation which is not a blocking problem.
+func (plan *CreatePlanInfo) warn(checkName string, err error) {
+	cblog.Info(err)
+	plan.CheckList = append(plan.CheckList, PlanCheckInfo{Check: checkName, Status: PlanCheckWarning, Message: err.Error()})
+	plan.Warnings = append(plan.Warnings, checkName+": "+err.Error())
+}
+
+func (plan *CreatePlanInfo) resolved(rsType string, nameId string, driverIID cres.IID) {
+	if nameId == "" {
+		return
+	}
+	plan.ResolvedResources = append(plan.ResolvedResources, PlanResourceInfo{ResourceType: RSTypeString(rsType), NameId: nameId, SystemId: driverIID.SystemId})
+}
+
+func (plan *CreatePlanInfo) done() *CreatePlanInfo {
+	plan.WouldSucceed = len(plan.BlockingProblems) == 0
+	return plan
+}
+
+// checkNameIdUnique checks that the NameId is not used in the connection.
+func checkNameIdUnique(connectionName string, rsType string, nameId string) error {
+	isExist, err := hasResourceNameId(connectionName, rsType, nameId)
+	if err != nil {
+		return err
+	}
+	if isExist {
+		return fmt.Errorf("%s '%s' already exists in connection '%s'", RSTypeString(rsType), nameId, connectionName)
+	}
+	return nil
+}
+
+// ================ Plan of VM
+
+// PlanStartVM runs the validations of StartVM() without creating the VM.
+// The blocking problems are reported in the plan, not as an error.
+// An error is returned only for the invalid input. ex) empty connection name.
+func PlanStartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo) (*CreatePlanInfo, error) {
+	cblog.Info("call PlanStartVM()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	plan := newCreatePlan(connectionName, rsType, reqInfo.IId.NameId)
+
+	// same as StartVM()
+	emptyPermissionList := []string{
+		"resources.IID:SystemId",
+		"resources.VMReqInfo:RootDiskType",
+		"resources.VMReqInfo:RootDiskSize",
+		"resources.VMReqInfo:VMUserId",
+		"resources.VMReqInfo:VMUserPasswd",
+		"resources.VMReqInfo:UserData",
+		"resources.VMReqInfo:PurchaseOption",
+		"resources.VMReqInfo:SpotMaxPrice",
+	}
+	plan.check("request validation", ValidateStruct(reqInfo, emptyPermissionList))
+
+	if reqInfo.UserData != "" {
+		if plan.check("capability: "+string(VM_USER_DATA), checkCapability(connectionName, VM_USER_DATA)) {
+			plan.check("UserData validation", cdcom.ValidateUserData(reqInfo.UserData))
+		}
+	}
+	plan.check("PurchaseOption validation", checkPurchaseOption(connectionName, reqInfo))
+	imageTypeOK := plan.check("ImageType validation", checkImageType(&reqInfo))
+
+	vmSPLock.RLock(connectionName, reqInfo.IId.NameId)
+	defer vmSPLock.RUnlock(connectionName, reqInfo.IId.NameId)
+
+	plan.check("NameId uniqueness", checkNameIdUnique(connectionName, VM, reqInfo.IId.NameId))
+
+	providerName, err := ccm.GetProviderNameByConnectionName(connectionName)
+	if plan.check("connection config", err) {
+		plan.check("root disk translation", translateRootDiskSetupInfo(providerName, &reqInfo))
+	} else {
+		plan.skip("root disk translation", "the connection config is not available")
+	}
+
+	if !imageTypeOK {
+		plan.skip("referenced resources resolution", "the ImageType is not valid")
+	} else {
+		reqInfoForDriver, err := cloneReqInfoWithDriverIID(connectionName, reqInfo)
+		if plan.check("referenced resources resolution", err) {
+			if reqInfo.ImageType == cres.MyImage {
+				plan.resolved(MYIMAGE, reqInfo.ImageIID.NameId, reqInfoForDriver.ImageIID)
+			}
+			plan.resolved(VPC, reqInfo.VpcIID.NameId, reqInfoForDriver.VpcIID)
+			plan.resolved(SUBNET, reqInfo.SubnetIID.NameId, reqInfoForDriver.SubnetIID)
+			for idx, sgIID := range reqInfo.SecurityGroupIIDs {
+				plan.resolved(SG, sgIID.NameId, reqInfoForDriver.SecurityGroupIIDs[idx])
+			}
+			plan.resolved(KEY, reqInfo.KeyPairIID.NameId, reqInfoForDriver.KeyPairIID)
+			for idx, diskIID := range reqInfo.DataDiskIIDs {
+				plan.resolved(DISK, diskIID.NameId, reqInfoForDriver.DataDiskIIDs[idx])
+			}
+		}
+	}
+
+	planQuotaHeadroom(plan, connectionName, 1)
+
+	return plan.done(), nil
+}
+
+// ================ Plan of Cluster
+
+// PlanCreateCluster runs the validations of CreateCluster() without creating the Cluster.
+// The blocking problems are reported in the plan, not as an error.
+// An error is returned only for the invalid input. ex) empty connection name.
+func PlanCreateCluster(connectionName string, rsType string, reqInfo cres.ClusterInfo) (*CreatePlanInfo, error) {
+	cblog.Info("call PlanCreateCluster()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	plan := newCreatePlan(connectionName, rsType, reqInfo.IId.NameId)
+
+	if !plan.check("capability: "+string(CLUSTER_HANDLER), checkCapability(connectionName, CLUSTER_HANDLER)) {
+		return plan.done(), nil
+	}
+
+	vpcSPLock.RLock(connectionName, reqInfo.Network.VpcIID.NameId)
+	defer vpcSPLock.RUnlock(connectionName, reqInfo.Network.VpcIID.NameId)
+	for _, sgIID := range reqInfo.Network.SecurityGroupIIDs {
+		sgSPLock.RLock(connectionName, sgIID.NameId)
+		defer sgSPLock.RUnlock(connectionName, sgIID.NameId)
+	}
+	for _, ngInfo := range reqInfo.NodeGroupList {
+		keySPLock.RLock(connectionName, ngInfo.KeyPairIID.NameId)
+		defer keySPLock.RUnlock(connectionName, ngInfo.KeyPairIID.NameId)
+	}
+
+	// keep the user's NameIds, setClusterReqInfoDriverIID() overwrites them
+	userNetInfo := reqInfo.Network
+	userNetInfo.SubnetIIDs = append([]cres.IID{}, reqInfo.Network.SubnetIIDs...)
+	userNetInfo.SecurityGroupIIDs = append([]cres.IID{}, reqInfo.Network.SecurityGroupIIDs...)
+	reqInfo.Network.SubnetIIDs = append([]cres.IID{}, reqInfo.Network.SubnetIIDs...)
+	reqInfo.Network.SecurityGroupIIDs = append([]cres.IID{}, reqInfo.Network.SecurityGroupIIDs...)
+	userNodeGroupList := reqInfo.NodeGroupList
+	reqInfo.NodeGroupList = append([]cres.NodeGroupInfo{}, reqInfo.NodeGroupList...)
+
+	if plan.check("referenced resources resolution", setClusterReqInfoDriverIID(connectionName, &reqInfo)) {
+		plan.resolved(VPC, userNetInfo.VpcIID.NameId, reqInfo.Network.VpcIID)
+		for idx, subnetIID := range userNetInfo.SubnetIIDs {
+			plan.resolved(SUBNET, subnetIID.NameId, reqInfo.Network.SubnetIIDs[idx])
+		}
+		for idx, sgIID := range userNetInfo.SecurityGroupIIDs {
+			plan.resolved(SG, sgIID.NameId, reqInfo.Network.SecurityGroupIIDs[idx])
+		}
+		for idx, ngInfo := range userNodeGroupList {
+			plan.resolved(KEY, ngInfo.KeyPairIID.NameId, reqInfo.NodeGroupList[idx].KeyPairIID)
+		}
+	}
+
+	clusterSPLock.RLock(connectionName, reqInfo.IId.NameId)
+	defer clusterSPLock.RUnlock(connectionName, reqInfo.IId.NameId)
+
+	plan.check("NameId uniqueness", checkNameIdUnique(connectionName, CLUSTER, reqInfo.IId.NameId))
+
+	nodeCount := 0
+	for _, ngInfo := range reqInfo.NodeGroupList {
+		nodeCount += ngInfo.DesiredNodeSize
+	}
+	planQuotaHeadroom(plan, connectionName, nodeCount)
+
+	return plan.done(), nil
+}
+
+// planQuotaHeadroom checks that the instance quotas of the compute service have the required headroom.
+// The check is best-effort: the QuotaInfoHandler has no common quota names, so the compute service
+// and its instance quotas are found by keywords, and other quotas(vCPU, IP, volume, ...) are not checked.
+// So a shortage is a warning, not a blocking problem. It is skipped if the connection does not support
+// QuotaInfoHandler or no quota matches, and a failure of the quota query is also a warning.
+func planQuotaHeadroom(plan *CreatePlanInfo, connectionName string, requiredInstances int) {
+	checkName := "quota headroom"
+
+	if requiredInstances <= 0 {
+		plan.skip(checkName, "no instances to be created")
+		return
+	}
+
+	if err := checkCapability(connectionName, QUOTA_INFO_HANDLER); err != nil {
+		plan.skip(checkName, err.Error())
+		return
+	}
+
+	serviceTypes, err := ListQuotaServiceType(connectionName)
+	if err != nil {
+		plan.Warnings = append(plan.Warnings, "failed to get the quota service types: "+err.Error())
+		plan.skip(checkName, err.Error())
+		return
+	}
+
+	serviceType := ""
+	for _, one := range serviceTypes {
+		for _, keyword := range computeQuotaServiceKeywords {
+			if strings.Contains(strings.ToLower(one), keyword) {
+				serviceType = one
+				break
+			}
+		}
+		if serviceType != "" {
+			break
+		}
+	}
+	if serviceType == "" {
+		plan.skip(checkName, "no compute service type in the quota service types")
+		return
+	}
+
+	quotaInfo, err := GetQuotaInfo(connectionName, serviceType)
+	if err != nil {
+		plan.Warnings = append(plan.Warnings, "failed to get the quota info of '"+serviceType+"': "+err.Error())
+		plan.skip(checkName, err.Error())
+		return
+	}
+
+	checked := false
+	for _, quota := range quotaInfo.Quotas {
+		if !strings.Contains(strings.ToLower(quota.QuotaName), "instance") {
+			continue
+		}
+		available, err := strconv.ParseFloat(strings.TrimSpace(quota.Available), 64)
+		if err != nil { // "NA"
+			continue
+		}
+		checked = true
+		if available < float64(requiredInstances) {
+			plan.warn(checkName, fmt.Errorf("quota '%s' of '%s' has %s available, but %d required",
+				quota.QuotaName, serviceType, quota.Available, requiredInstances))
+			return
+		}
+	}
+	if !checked {
+		plan.skip(checkName, "no instance quota with the available value in '"+serviceType+"'")
+		return
+	}
+	plan.check(checkName, nil)
+}
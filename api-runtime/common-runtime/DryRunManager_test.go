@@ -0,0 +1,67 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"errors"
+	"testing"
+
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+func TestCreatePlan(t *testing.T) {
+	testList := []struct {
+		checkErrs    []error
+		warnErrs     []error
+		wouldSucceed bool
+	}{
+		{[]error{nil, nil}, nil, true},
+		{[]error{nil}, []error{errors.New("quota shortage")}, true}, // a warning is not a blocking problem
+		{[]error{nil, errors.New("NameId exists")}, nil, false},
+		{[]error{errors.New("invalid request"), errors.New("NameId exists")}, nil, false},
+	}
+
+	for i, test := range testList {
+		plan := newCreatePlan("mock-config01", VM, "vm-01")
+		for _, err := range test.checkErrs {
+			if passed := plan.check("check", err); passed != (err == nil) {
+				t.Errorf("#%d: check(%v) returns %v", i, err, passed)
+			}
+		}
+		for _, err := range test.warnErrs {
+			plan.warn("warn", err)
+		}
+		plan.skip("skip", "not available")
+		plan.done()
+
+		if plan.WouldSucceed != test.wouldSucceed {
+			t.Errorf("#%d: WouldSucceed %v is not %v: %v", i, plan.WouldSucceed, test.wouldSucceed, plan.BlockingProblems)
+		}
+		failed := 0
+		for _, err := range test.checkErrs {
+			if err != nil {
+				failed++
+			}
+		}
+		if len(plan.BlockingProblems) != failed || len(plan.Warnings) != len(test.warnErrs) {
+			t.Errorf("#%d: %d BlockingProblems and %d Warnings", i, len(plan.BlockingProblems), len(plan.Warnings))
+		}
+		if len(plan.CheckList) != len(test.checkErrs)+len(test.warnErrs)+1 {
+			t.Errorf("#%d: %d checks in CheckList", i, len(plan.CheckList))
+		}
+	}
+
+	// the resources without NameId are not in the plan. ex) no KeyPair
+	plan := newCreatePlan("mock-config01", VM, "vm-01")
+	plan.resolved(VPC, "vpc-01", cres.IID{NameId: "vpc-01", SystemId: "vpc-0a1b"})
+	plan.resolved(KEY, "", cres.IID{})
+	if len(plan.ResolvedResources) != 1 || plan.ResolvedResources[0].SystemId != "vpc-0a1b" {
+		t.Errorf("unexpected ResolvedResources: %+v", plan.ResolvedResources)
+	}
+}
@@ -0,0 +1,242 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Idempotency Manager — keeps the first response of a resource-creating request
+// with an Idempotency-Key in Spider MetaDB, so that retries get the same response.
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// ====================================================================
+// type for GORM
+
+const (
+	IdempotencyInProgress = "InProgress"
+	IdempotencyCompleted  = "Completed"
+)
+
+// keys older than this are expired and can be reused
+const IdempotencyKeyTTL = 24 * time.Hour
+
+// max length of Idempotency-Key
+const MaxIdempotencyKeyLength = 255
+
+type IdempotencyKeyInfo struct {
+	ConnectionName string `gorm:"primaryKey"` // ex) "aws-seoul-config"
+	IdempotencyKey string `gorm:"primaryKey"` // ex) "8e03978e-40d5-43e8-bc93-6894a57f9324"
+	RequestPath    string // ex) "POST /spider/vm"
+	RequestHash    string // sha256 of the request body
+	ResourceType   string // ex) vm, vpc
+	NameId         string // ex) "vm-01"
+	State          string // InProgress | Completed
+	StatusCode     int    // HTTP status code of the first response
+	ContentType    string // Content-Type of the first response
+	ResponseBody   string `gorm:"type:text"` // body of the first response
+	CreatedAt      time.Time
+}
+
+func (IdempotencyKeyInfo) TableName() string {
+	return "idempotency_key_infos"
+}
+
+//====================================================================
+
+// keys of the requests running in this server process
+var inFlightIdempotencyKeys = map[string]bool{}
+var idempotencyLock sync.Mutex
+
+func init() {
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	defer infostore.Close(db)
+
+	db.AutoMigrate(&IdempotencyKeyInfo{})
+
+	// clean up expired keys
+	err = db.Where("created_at < ?", time.Now().Add(-IdempotencyKeyTTL)).Delete(&IdempotencyKeyInfo{}).Error
+	if err != nil {
+		cblog.Error(err)
+	}
+}
+
+//================ Idempotency Handler
+
+// IdempotencyError is returned by BeginIdempotentRequest with the HTTP status code to respond.
+type IdempotencyError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *IdempotencyError) Error() string {
+	return e.Message
+}
+
+// BeginIdempotentRequest registers the request with the Idempotency-Key.
+//   - (nil, nil): first request. Run it and call CompleteIdempotentRequest() or AbortIdempotentRequest().
+//   - (info, nil): retried request. Replay the first response in info.
+//   - (nil, *IdempotencyError): the request must be rejected with the status code.
+func BeginIdempotentRequest(connectionName string, idempotencyKey string, requestPath string, requestHash string,
+	rsType string, nameId string) (*IdempotencyKeyInfo, error) {
+	cblog.Info("call BeginIdempotentRequest()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, &IdempotencyError{http.StatusBadRequest, err.Error()}
+	}
+
+	idempotencyKey, err = EmptyCheckAndTrim("Idempotency-Key", idempotencyKey)
+	if err != nil {
+		cblog.Error(err)
+		return nil, &IdempotencyError{http.StatusBadRequest, err.Error()}
+	}
+	if len(idempotencyKey) > MaxIdempotencyKeyLength {
+		err := fmt.Errorf("Idempotency-Key is too long: max %d characters", MaxIdempotencyKeyLength)
+		cblog.Error(err)
+		return nil, &IdempotencyError{http.StatusBadRequest, err.Error()}
+	}
+
+	idempotencyLock.Lock()
+	defer idempotencyLock.Unlock()
+
+	var info IdempotencyKeyInfo
+	hasKey, err := infostore.HasByConditions(&info, CONNECTION_NAME_COLUMN, connectionName, "idempotency_key", idempotencyKey)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if hasKey {
+		err = infostore.GetByConditions(&info, CONNECTION_NAME_COLUMN, connectionName, "idempotency_key", idempotencyKey)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		if time.Since(info.CreatedAt) > IdempotencyKeyTTL {
+			// expired key, use it as a new key
+			hasKey = false
+		}
+	}
+
+	if hasKey {
+		if info.RequestPath != requestPath || info.RequestHash != requestHash {
+			err := fmt.Errorf("Idempotency-Key '%s' was already used with a different request", idempotencyKey)
+			cblog.Error(err)
+			return nil, &IdempotencyError{http.StatusUnprocessableEntity, err.Error()}
+		}
+
+		if info.State == IdempotencyCompleted {
+			return &info, nil
+		}
+
+		if inFlightIdempotencyKeys[inFlightKey(connectionName, idempotencyKey)] {
+			err := fmt.Errorf("a request with Idempotency-Key '%s' is in progress", idempotencyKey)
+			cblog.Error(err)
+			return nil, &IdempotencyError{http.StatusConflict, err.Error()}
+		}
+
+		// The first request was stopped by the server restart.
+		// If it already made the resource, the resource is not created again.
+		if info.NameId != "" {
+			exists, err := hasResourceNameId(connectionName, info.ResourceType, info.NameId)
+			if err != nil {
+				cblog.Error(err)
+				return nil, err
+			}
+			if exists {
+				err := fmt.Errorf("%s '%s' was already created by the request with Idempotency-Key '%s' in connection '%s'",
+					RSTypeString(info.ResourceType), info.NameId, idempotencyKey, connectionName)
+				cblog.Error(err)
+				return nil, &IdempotencyError{http.StatusConflict, err.Error()}
+			}
+		}
+	}
+
+	info = IdempotencyKeyInfo{
+		ConnectionName: connectionName,
+		IdempotencyKey: idempotencyKey,
+		RequestPath:    requestPath,
+		RequestHash:    requestHash,
+		ResourceType:   rsType,
+		NameId:         nameId,
+		State:          IdempotencyInProgress,
+		CreatedAt:      time.Now(),
+	}
+	err = infostore.Insert(&info)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	inFlightIdempotencyKeys[inFlightKey(connectionName, idempotencyKey)] = true
+
+	return nil, nil
+}
+
+// CompleteIdempotentRequest stores the first response of the request to replay it for retries.
+func CompleteIdempotentRequest(connectionName string, idempotencyKey string, statusCode int, contentType string, responseBody string) error {
+	cblog.Info("call CompleteIdempotentRequest()")
+
+	idempotencyLock.Lock()
+	defer idempotencyLock.Unlock()
+
+	delete(inFlightIdempotencyKeys, inFlightKey(connectionName, idempotencyKey))
+
+	var info IdempotencyKeyInfo
+	err := infostore.GetByConditions(&info, CONNECTION_NAME_COLUMN, connectionName, "idempotency_key", idempotencyKey)
+	if err != nil {
+		cblog.Error(err)
+		return err
+	}
+
+	info.State = IdempotencyCompleted
+	info.StatusCode = statusCode
+	info.ContentType = contentType
+	info.ResponseBody = responseBody
+	err = infostore.Insert(&info)
+	if err != nil {
+		cblog.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// AbortIdempotentRequest removes the key, so that the request can be retried with the same key.
+// ex) the request failed with a server error.
+func AbortIdempotentRequest(connectionName string, idempotencyKey string) error {
+	cblog.Info("call AbortIdempotentRequest()")
+
+	idempotencyLock.Lock()
+	defer idempotencyLock.Unlock()
+
+	delete(inFlightIdempotencyKeys, inFlightKey(connectionName, idempotencyKey))
+
+	_, err := infostore.DeleteByConditions(&IdempotencyKeyInfo{}, CONNECTION_NAME_COLUMN, connectionName,
+		"idempotency_key", idempotencyKey)
+	if err != nil {
+		cblog.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func inFlightKey(connectionName string, idempotencyKey string) string {
+	return connectionName + "/" + idempotencyKey
+}
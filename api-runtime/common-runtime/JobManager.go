@@ -0,0 +1,276 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Job Manager — runs long-running create/delete operations in the background
+// and keeps the job state in Spider MetaDB.
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/xid"
+
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// ====================================================================
+// type for GORM
+
+type JobStatus string
+
+const (
+	JobPending   JobStatus = "Pending"
+	JobRunning   JobStatus = "Running"
+	JobSucceeded JobStatus = "Succeeded"
+	JobFailed    JobStatus = "Failed"
+)
+
+const JOB_ID_COLUMN = "job_id"
+
+// jobSubmitLock serializes the active job check and the job insertion.
+var jobSubmitLock sync.Mutex
+
+// JobInfo represents an asynchronous job and its result.
+type JobInfo struct {
+	JobId          string          `gorm:"primaryKey" json:"JobId" example:"cs6q5h2jpnmc73c0bfo0"`
+	ConnectionName string          `gorm:"index" json:"ConnectionName" example:"aws-connection"`
+	ResourceType   string          `json:"ResourceType" example:"vm"`                // ex) vm, cluster, rdbms, nlb
+	Operation      string          `json:"Operation" example:"StartVM"`              // ex) StartVM, DeleteCluster
+	ResourceName   string          `json:"ResourceName" example:"vm-01"`             // NameId of the target resource
+	Status         JobStatus       `gorm:"index" json:"Status" example:"Succeeded"`  // Pending | Running | Succeeded | Failed
+	Result         json.RawMessage `json:"Result,omitempty" swaggertype:"object"`    // result of the operation(JSON) if Succeeded
+	Error          string          `json:"Error,omitempty" example:""`               // error message if Failed
+	CreatedAt      time.Time       `json:"CreatedAt" example:"2026-10-01T12:00:00Z"` // submitted time
+	StartedAt      *time.Time      `json:"StartedAt,omitempty"`
+	FinishedAt     *time.Time      `json:"FinishedAt,omitempty"`
+}
+
+func (JobInfo) TableName() string {
+	return "job_infos"
+}
+
+//====================================================================
+
+func init() {
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	defer infostore.Close(db)
+
+	db.AutoMigrate(&JobInfo{})
+
+	// Jobs in progress were lost when the server stopped.
+	now := time.Now().UTC()
+	err = db.Model(&JobInfo{}).Where("status IN ?", []JobStatus{JobPending, JobRunning}).
+		Updates(map[string]interface{}{
+			"status":      JobFailed,
+			"error":       "job was interrupted by Spider server restart",
+			"finished_at": now,
+		}).Error
+	if err != nil {
+		cblog.Error(err)
+	}
+}
+
+//================ Job Handler
+
+// SubmitJob saves a new job and runs jobFunc in the background.
+// The result of jobFunc is marshaled into JSON and kept in the job info.
+//
+// ex) SubmitJob(connectionName, VM, "StartVM", reqInfo.IId.NameId, func() (interface{}, error) {...})
+func SubmitJob(connectionName string, rsType string, operation string, nameID string,
+	jobFunc func() (interface{}, error)) (*JobInfo, error) {
+	cblog.Info("call SubmitJob()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	nameID, err = EmptyCheckAndTrim("nameID", nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	jobSubmitLock.Lock()
+	defer jobSubmitLock.Unlock()
+
+	// a retried request gets the job in progress, not a duplicated one
+	activeJob, err := getActiveJob(connectionName, rsType, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if activeJob != nil {
+		if activeJob.Operation != operation {
+			err := fmt.Errorf("%s '%s' is busy with the job '%s'(%s)", rsType, nameID, activeJob.JobId, activeJob.Operation)
+			cblog.Error(err)
+			return nil, err
+		}
+		return activeJob, nil
+	}
+
+	jobInfo := JobInfo{
+		JobId:          xid.New().String(),
+		ConnectionName: connectionName,
+		ResourceType:   rsType,
+		Operation:      operation,
+		ResourceName:   nameID,
+		Status:         JobPending,
+		CreatedAt:      time.Now().UTC(),
+	}
+	err = infostore.Insert(&jobInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	ret := jobInfo
+	go runJob(jobInfo, jobFunc)
+
+	return &ret, nil
+}
+
+// getActiveJob returns the Pending or Running job of the resource, or nil if none.
+func getActiveJob(connectionName string, rsType string, nameID string) (*JobInfo, error) {
+	db, err := infostore.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer infostore.Close(db)
+
+	jobInfoList := []*JobInfo{}
+	err = db.Where(CONNECTION_NAME_COLUMN+" = ? AND resource_type = ? AND resource_name = ? AND status IN ?",
+		connectionName, rsType, nameID, []JobStatus{JobPending, JobRunning}).Limit(1).Find(&jobInfoList).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(jobInfoList) == 0 {
+		return nil, nil
+	}
+	return jobInfoList[0], nil
+}
+
+func runJob(jobInfo JobInfo, jobFunc func() (interface{}, error)) {
+	startedAt := time.Now().UTC()
+	jobInfo.Status = JobRunning
+	jobInfo.StartedAt = &startedAt
+	if err := infostore.Insert(&jobInfo); err != nil {
+		cblog.Error(err)
+	}
+
+	result, err := func() (result interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("%s job panicked: %v", jobInfo.Operation, r)
+			}
+		}()
+		return jobFunc()
+	}()
+
+	if err == nil {
+		jobInfo.Result, err = json.Marshal(result)
+	}
+
+	finishedAt := time.Now().UTC()
+	jobInfo.FinishedAt = &finishedAt
+	if err != nil {
+		cblog.Error(err)
+		jobInfo.Status = JobFailed
+		jobInfo.Error = err.Error()
+	} else {
+		jobInfo.Status = JobSucceeded
+	}
+
+	if err := infostore.Insert(&jobInfo); err != nil {
+		cblog.Error(err)
+	}
+}
+
+// GetJob returns the job info of the given job ID.
+func GetJob(jobID string) (*JobInfo, error) {
+	cblog.Info("call GetJob()")
+
+	// check empty and trim user inputs
+	jobID, err := EmptyCheckAndTrim("jobID", jobID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var jobInfo JobInfo
+	err = infostore.Get(&jobInfo, JOB_ID_COLUMN, jobID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, fmt.Errorf("Job '%s' does not exist", jobID)
+	}
+
+	return &jobInfo, nil
+}
+
+// ListJob returns the job list, newest first.
+// If connectionName is empty, jobs of all connections are returned.
+func ListJob(connectionName string) ([]*JobInfo, error) {
+	cblog.Info("call ListJob()")
+
+	connectionName = strings.TrimSpace(connectionName)
+
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	defer infostore.Close(db)
+
+	query := db.Order("created_at desc")
+	if connectionName != "" {
+		query = query.Where(CONNECTION_NAME_COLUMN+" = ?", connectionName)
+	}
+
+	jobInfoList := []*JobInfo{}
+	if err := query.Find(&jobInfoList).Error; err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return jobInfoList, nil
+}
+
+// DeleteJob removes a finished job from the job list.
+func DeleteJob(jobID string) (bool, error) {
+	cblog.Info("call DeleteJob()")
+
+	jobInfo, err := GetJob(jobID)
+	if err != nil {
+		return false, err
+	}
+
+	if jobInfo.Status == JobPending || jobInfo.Status == JobRunning {
+		err := fmt.Errorf("Job '%s' is %s. Only finished jobs can be deleted", jobInfo.JobId, jobInfo.Status)
+		cblog.Error(err)
+		return false, err
+	}
+
+	_, err = infostore.DeleteByCondition(&JobInfo{}, JOB_ID_COLUMN, jobInfo.JobId)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,578 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// List Option Manager — pagination, filtering and sorting of the resource lists.
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+const MaxListLimit = 1000
+
+const (
+	LIST_SORT_NAME    = "name"
+	LIST_SORT_ZONE    = "zone"
+	LIST_SORT_STATUS  = "status"
+	LIST_SORT_CREATED = "created"
+)
+
+// ListOption represents the pagination, filtering and sorting options of a list.
+// The zero value lists all resources without the pagination.
+type ListOption struct {
+	Limit      int    // max number of the items of a page, 0: no limit
+	NextToken  string // continuation token returned by the previous page
+	Sort       string // name(default), zone, status, created. '-' prefix for the descending order, ex) -created
+	NamePrefix string // NameId prefix
+	Zone       string
+	Status     string
+	Tag        string // 'key=value' or 'key'(any value)
+}
+
+// ListOptionError is the error of an invalid list option.
+type ListOptionError struct {
+	msg string
+}
+
+func (e *ListOptionError) Error() string {
+	return e.msg
+}
+
+func newListOptionError(format string, args ...interface{}) error {
+	return &ListOptionError{msg: fmt.Sprintf(format, args...)}
+}
+
+// IsEmpty returns true if no option is set.
+func (opt ListOption) IsEmpty() bool {
+	return opt == ListOption{}
+}
+
+// the continuation token, the next page starts after the item(Key, NameId) in the Sort order
+type listToken struct {
+	Sort   string `json:"s"`
+	Key    string `json:"k"`
+	NameId string `json:"n"`
+}
+
+type listCandidate struct {
+	NameId   string
+	SystemId string
+	ZoneId   string
+}
+
+type listItem[T any] struct {
+	candidate listCandidate
+	status    string
+	info      *T
+	sortKey   string
+}
+
+// listTarget describes how to get and inspect the items of a resource type.
+type listTarget[T any] struct {
+	connectionName   string
+	rsType           string
+	get              func(nameId string) (*T, error)
+	idOnlyInfo       func(candidate listCandidate) *T // info of the item not found in the CSP
+	idOnlyOnAnyError bool                             // use idOnlyInfo for all Get errors, not only for the not-found errors
+	zoneSupported    bool
+	preStatus        func(candidate listCandidate) (string, error) // status without the full Get, nil: use statusOf
+	statusOf         func(info *T) string                          // nil: status is not supported
+	tagsOf           func(info *T) []cres.KeyValue
+	createdOf        func(info *T) time.Time // nil: created is not supported
+}
+
+// ================ List with Option
+
+// ListVMWithOption lists the VMs with the pagination, filtering and sorting options.
+// It returns the next token if there are more VMs.
+func ListVMWithOption(connectionName string, rsType string, opt ListOption) ([]*cres.VMInfo, string, error) {
+	cblog.Info("call ListVMWithOption()")
+
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, "", err
+	}
+
+	var iidInfoList []*VMIIDInfo
+	if err := listIIDInfoForOption(connectionName, &iidInfoList); err != nil {
+		cblog.Error(err)
+		return nil, "", err
+	}
+	candidates := []listCandidate{}
+	for _, iidInfo := range iidInfoList {
+		candidates = append(candidates, listCandidate{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId, ZoneId: iidInfo.ZoneId})
+	}
+
+	target := listTarget[cres.VMInfo]{
+		connectionName: connectionName,
+		rsType:         VM,
+		get:            func(nameId string) (*cres.VMInfo, error) { return GetVM(connectionName, rsType, nameId) },
+		idOnlyInfo: func(candidate listCandidate) *cres.VMInfo {
+			return &cres.VMInfo{IId: cres.IID{NameId: candidate.NameId, SystemId: candidate.SystemId}}
+		},
+		idOnlyOnAnyError: true,
+		zoneSupported:    true,
+		preStatus: func(candidate listCandidate) (string, error) {
+			status, err := GetVMStatus(connectionName, rsType, candidate.NameId)
+			return string(status), err
+		},
+		tagsOf:    func(info *cres.VMInfo) []cres.KeyValue { return info.TagList },
+		createdOf: func(info *cres.VMInfo) time.Time { return info.StartTime },
+	}
+
+	return listWithOption(candidates, target, opt)
+}
+
+// ListVPCWithOption lists the VPCs with the pagination, filtering and sorting options.
+// It returns the next token if there are more VPCs.
+func ListVPCWithOption(connectionName string, rsType string, opt ListOption) ([]*cres.VPCInfo, string, error) {
+	cblog.Info("call ListVPCWithOption()")
+
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, "", err
+	}
+
+	var iidInfoList []*VPCIIDInfo
+	if err := listIIDInfoForOption(connectionName, &iidInfoList); err != nil {
+		cblog.Error(err)
+		return nil, "", err
+	}
+	candidates := []listCandidate{}
+	for _, iidInfo := range iidInfoList {
+		candidates = append(candidates, listCandidate{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	}
+
+	target := listTarget[cres.VPCInfo]{
+		connectionName: connectionName,
+		rsType:         VPC,
+		get:            func(nameId string) (*cres.VPCInfo, error) { return GetVPC(connectionName, rsType, nameId) },
+		idOnlyInfo: func(candidate listCandidate) *cres.VPCInfo {
+			return &cres.VPCInfo{IId: cres.IID{NameId: candidate.NameId, SystemId: candidate.SystemId}}
+		},
+		tagsOf: func(info *cres.VPCInfo) []cres.KeyValue { return info.TagList },
+	}
+
+	return listWithOption(candidates, target, opt)
+}
+
+// ListSecurityWithOption lists the SecurityGroups with the pagination, filtering and sorting options.
+// It returns the next token if there are more SecurityGroups.
+func ListSecurityWithOption(connectionName string, rsType string, opt ListOption) ([]*cres.SecurityInfo, string, error) {
+	cblog.Info("call ListSecurityWithOption()")
+
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, "", err
+	}
+
+	var iidInfoList []*SGIIDInfo
+	if err := listIIDInfoForOption(connectionName, &iidInfoList); err != nil {
+		cblog.Error(err)
+		return nil, "", err
+	}
+	candidates := []listCandidate{}
+	for _, iidInfo := range iidInfoList {
+		candidates = append(candidates, listCandidate{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	}
+
+	target := listTarget[cres.SecurityInfo]{
+		connectionName: connectionName,
+		rsType:         SG,
+		get:            func(nameId string) (*cres.SecurityInfo, error) { return GetSecurity(connectionName, rsType, nameId) },
+		idOnlyInfo: func(candidate listCandidate) *cres.SecurityInfo {
+			return &cres.SecurityInfo{IId: cres.IID{NameId: candidate.NameId, SystemId: candidate.SystemId}}
+		},
+		tagsOf: func(info *cres.SecurityInfo) []cres.KeyValue { return info.TagList },
+	}
+
+	return listWithOption(candidates, target, opt)
+}
+
+// ListDiskWithOption lists the Disks with the pagination, filtering and sorting options.
+// It returns the next token if there are more Disks.
+func ListDiskWithOption(connectionName string, rsType string, opt ListOption) ([]*cres.DiskInfo, string, error) {
+	cblog.Info("call ListDiskWithOption()")
+
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, "", err
+	}
+
+	var iidInfoList []*DiskIIDInfo
+	if err := listIIDInfoForOption(connectionName, &iidInfoList); err != nil {
+		cblog.Error(err)
+		return nil, "", err
+	}
+	candidates := []listCandidate{}
+	for _, iidInfo := range iidInfoList {
+		candidates = append(candidates, listCandidate{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId, ZoneId: iidInfo.ZoneId})
+	}
+
+	target := listTarget[cres.DiskInfo]{
+		connectionName: connectionName,
+		rsType:         DISK,
+		get:            func(nameId string) (*cres.DiskInfo, error) { return GetDisk(connectionName, rsType, nameId) },
+		idOnlyInfo: func(candidate listCandidate) *cres.DiskInfo {
+			return &cres.DiskInfo{IId: cres.IID{NameId: candidate.NameId, SystemId: candidate.SystemId}}
+		},
+		zoneSupported: true,
+		statusOf:      func(info *cres.DiskInfo) string { return string(info.Status) },
+		tagsOf:        func(info *cres.DiskInfo) []cres.KeyValue { return info.TagList },
+		createdOf:     func(info *cres.DiskInfo) time.Time { return info.CreatedTime },
+	}
+
+	return listWithOption(candidates, target, opt)
+}
+
+// PageAllResourceList applies the options to the list of ListAllResource().
+// The items are ordered by MappedList, OnlySpiderList and OnlyCSPList,
+// and the items of OnlyCSPList are filtered and sorted by the SystemId.
+// Only NamePrefix and the name sort are supported.
+func PageAllResourceList(allResourceList AllResourceList, opt ListOption) (AllResourceList, string, error) {
+	cblog.Info("call PageAllResourceList()")
+
+	if opt.Zone != "" || opt.Status != "" || opt.Tag != "" {
+		err := newListOptionError("zone, status and tag filters are not supported for the list of all resources")
+		cblog.Error(err)
+		return AllResourceList{}, "", err
+	}
+	sortField, desc, err := parseListSort(opt.Sort)
+	if err != nil {
+		cblog.Error(err)
+		return AllResourceList{}, "", err
+	}
+	if sortField != LIST_SORT_NAME {
+		err := newListOptionError("sort '%s' is not supported for the list of all resources", opt.Sort)
+		cblog.Error(err)
+		return AllResourceList{}, "", err
+	}
+
+	lists := []*[]*cres.IID{&allResourceList.AllList.MappedList, &allResourceList.AllList.OnlySpiderList, &allResourceList.AllList.OnlyCSPList}
+	type allItem struct {
+		listIdx int
+		iid     *cres.IID
+		key     string
+	}
+	items := []allItem{}
+	for listIdx, list := range lists {
+		for _, iid := range *list {
+			name := iid.NameId
+			if listIdx == 2 {
+				name = iid.SystemId
+			}
+			if strings.HasPrefix(name, opt.NamePrefix) {
+				items = append(items, allItem{listIdx: listIdx, iid: iid, key: fmt.Sprintf("%d:%s", listIdx, name)})
+			}
+		}
+		*list = []*cres.IID{}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].listIdx != items[j].listIdx {
+			return items[i].listIdx < items[j].listIdx
+		}
+		if desc {
+			return items[i].key > items[j].key
+		}
+		return items[i].key < items[j].key
+	})
+
+	start := 0
+	if opt.NextToken != "" {
+		token, err := decodeListToken(opt.NextToken, opt.Sort)
+		if err == nil && (len(token.Key) < 2 || token.Key[1] != ':') {
+			err = newListOptionError("invalid nextToken: not a token of the list of all resources")
+		}
+		if err != nil {
+			cblog.Error(err)
+			return AllResourceList{}, "", err
+		}
+		start = sort.Search(len(items), func(i int) bool {
+			if token.Key[:1] != items[i].key[:1] {
+				return token.Key[:1] < items[i].key[:1]
+			}
+			if desc {
+				return items[i].key < token.Key
+			}
+			return items[i].key > token.Key
+		})
+	}
+
+	end, err := listPageEnd(start, len(items), opt.Limit)
+	if err != nil {
+		cblog.Error(err)
+		return AllResourceList{}, "", err
+	}
+	for _, item := range items[start:end] {
+		*lists[item.listIdx] = append(*lists[item.listIdx], item.iid)
+	}
+
+	nextToken := ""
+	if end < len(items) {
+		nextToken = encodeListToken(listToken{Sort: opt.Sort, Key: items[end-1].key})
+	}
+	return allResourceList, nextToken, nil
+}
+
+// listWithOption filters, sorts and pages the candidates from the IID table.
+// The filters on the IID table(NameId prefix, zone) and the status are applied before the per-item Get calls,
+// and only the items of the page are retrieved if the sort and filters do not need the full info.
+func listWithOption[T any](candidates []listCandidate, target listTarget[T], opt ListOption) ([]*T, string, error) {
+	sortField, desc, err := parseListSort(opt.Sort)
+	if err != nil {
+		cblog.Error(err)
+		return nil, "", err
+	}
+	if opt.Zone != "" || sortField == LIST_SORT_ZONE {
+		if !target.zoneSupported {
+			return nil, "", newListOptionError("zone filter and sort are not supported for %s", RSTypeString(target.rsType))
+		}
+	}
+	statusNeeded := opt.Status != "" || sortField == LIST_SORT_STATUS
+	if statusNeeded && target.preStatus == nil && target.statusOf == nil {
+		return nil, "", newListOptionError("status filter and sort are not supported for %s", RSTypeString(target.rsType))
+	}
+	if sortField == LIST_SORT_CREATED && target.createdOf == nil {
+		return nil, "", newListOptionError("created sort is not supported for %s", RSTypeString(target.rsType))
+	}
+	if opt.Limit < 0 || opt.Limit > MaxListLimit {
+		return nil, "", newListOptionError("limit must be between 1 and %d", MaxListLimit)
+	}
+	var token *listToken
+	if opt.NextToken != "" {
+		token, err = decodeListToken(opt.NextToken, opt.Sort)
+		if err != nil {
+			cblog.Error(err)
+			return nil, "", err
+		}
+	}
+
+	// (1) filters on the IID table
+	items := []*listItem[T]{}
+	for _, candidate := range candidates {
+		if !strings.HasPrefix(candidate.NameId, opt.NamePrefix) {
+			continue
+		}
+		if opt.Zone != "" && candidate.ZoneId != opt.Zone {
+			continue
+		}
+		items = append(items, &listItem[T]{candidate: candidate})
+	}
+
+	// (2) status without the full Get
+	if statusNeeded && target.preStatus != nil {
+		err := firstError(runFanOut(target.connectionName, len(items), func(idx int) error {
+			status, err := target.preStatus(items[idx].candidate)
+			if err != nil {
+				if !checkNotFoundError(err) {
+					return err
+				}
+				cblog.Error(err)
+			}
+			items[idx].status = status
+			return nil
+		}))
+		if err != nil {
+			cblog.Error(err)
+			return nil, "", err
+		}
+		items = filterListItems(items, func(item *listItem[T]) bool {
+			return opt.Status == "" || strings.EqualFold(item.status, opt.Status)
+		})
+	}
+
+	// (3) full Get before the paging, if the filters or the sort need the full info
+	fetched := false
+	if opt.Tag != "" || (statusNeeded && target.preStatus == nil) || sortField == LIST_SORT_CREATED {
+		if err := getListItemInfos(items, target); err != nil {
+			cblog.Error(err)
+			return nil, "", err
+		}
+		fetched = true
+
+		if statusNeeded && target.preStatus == nil {
+			for _, item := range items {
+				item.status = target.statusOf(item.info)
+			}
+			items = filterListItems(items, func(item *listItem[T]) bool {
+				return opt.Status == "" || strings.EqualFold(item.status, opt.Status)
+			})
+		}
+		if opt.Tag != "" {
+			items = filterListItems(items, func(item *listItem[T]) bool {
+				return matchTagFilter(target.tagsOf(item.info), opt.Tag)
+			})
+		}
+	}
+
+	// (4) sort
+	for _, item := range items {
+		switch sortField {
+		case LIST_SORT_ZONE:
+			item.sortKey = item.candidate.ZoneId
+		case LIST_SORT_STATUS:
+			item.sortKey = item.status
+		case LIST_SORT_CREATED:
+			item.sortKey = target.createdOf(item.info).UTC().Format(time.RFC3339Nano)
+		}
+	}
+	less := func(a, b *listItem[T]) bool {
+		if a.sortKey != b.sortKey {
+			return a.sortKey < b.sortKey
+		}
+		return a.candidate.NameId < b.candidate.NameId
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if desc {
+			return less(items[j], items[i])
+		}
+		return less(items[i], items[j])
+	})
+
+	// (5) page
+	start := 0
+	if token != nil {
+		tokenItem := &listItem[T]{candidate: listCandidate{NameId: token.NameId}, sortKey: token.Key}
+		start = sort.Search(len(items), func(i int) bool {
+			if desc {
+				return less(items[i], tokenItem)
+			}
+			return less(tokenItem, items[i])
+		})
+	}
+	end, err := listPageEnd(start, len(items), opt.Limit)
+	if err != nil {
+		cblog.Error(err)
+		return nil, "", err
+	}
+	page := items[start:end]
+
+	if !fetched {
+		if err := getListItemInfos(page, target); err != nil {
+			cblog.Error(err)
+			return nil, "", err
+		}
+	}
+
+	infoList := []*T{}
+	for _, item := range page {
+		infoList = append(infoList, item.info)
+	}
+
+	nextToken := ""
+	if end < len(items) {
+		last := items[end-1]
+		nextToken = encodeListToken(listToken{Sort: opt.Sort, Key: last.sortKey, NameId: last.candidate.NameId})
+	}
+	return infoList, nextToken, nil
+}
+
+// listIIDInfoForOption lists the IIDInfos of the connection.
+func listIIDInfoForOption(connectionName string, iidInfoList interface{}) error {
+	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+		return getAuthIIDInfoList(connectionName, iidInfoList)
+	}
+	return infostore.ListByCondition(iidInfoList, CONNECTION_NAME_COLUMN, connectionName)
+}
+
+func getListItemInfos[T any](items []*listItem[T], target listTarget[T]) error {
+	return firstError(runFanOut(target.connectionName, len(items), func(idx int) error {
+		info, err := target.get(items[idx].candidate.NameId)
+		if err != nil {
+			if !target.idOnlyOnAnyError && !checkNotFoundError(err) {
+				return fmt.Errorf("%s:%s # %v", RSTypeString(target.rsType), items[idx].candidate.NameId, err)
+			}
+			cblog.Error(err)
+			info = target.idOnlyInfo(items[idx].candidate)
+		}
+		items[idx].info = info
+		return nil
+	}))
+}
+
+func filterListItems[T any](items []*listItem[T], keep func(item *listItem[T]) bool) []*listItem[T] {
+	filtered := []*listItem[T]{}
+	for _, item := range items {
+		if keep(item) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// parseListSort returns the sort field and the descending order. ex) "-created" => "created", true
+func parseListSort(sortOpt string) (string, bool, error) {
+	sortOpt = strings.ToLower(strings.TrimSpace(sortOpt))
+	desc := strings.HasPrefix(sortOpt, "-")
+	field := strings.TrimPrefix(sortOpt, "-")
+	switch field {
+	case "":
+		return LIST_SORT_NAME, desc, nil
+	case LIST_SORT_NAME, LIST_SORT_ZONE, LIST_SORT_STATUS, LIST_SORT_CREATED:
+		return field, desc, nil
+	default:
+		return "", false, newListOptionError("invalid sort '%s': must be one of name, zone, status, created with optional '-' prefix", sortOpt)
+	}
+}
+
+func listPageEnd(start int, total int, limit int) (int, error) {
+	if limit < 0 || limit > MaxListLimit {
+		return 0, newListOptionError("limit must be between 1 and %d", MaxListLimit)
+	}
+	if limit == 0 || start+limit > total {
+		return total, nil
+	}
+	return start + limit, nil
+}
+
+func encodeListToken(token listToken) string {
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeListToken decodes the token, the token must be made with the same sort.
+func decodeListToken(nextToken string, sortOpt string) (*listToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(nextToken)
+	if err != nil {
+		return nil, newListOptionError("invalid nextToken: %v", err)
+	}
+	var token listToken
+	if err := json.Unmarshal(data, &token); err != nil || token.Key == "" && token.NameId == "" {
+		return nil, newListOptionError("invalid nextToken")
+	}
+	if token.Sort != sortOpt {
+		return nil, newListOptionError("invalid nextToken: the sort is changed from '%s' to '%s'", token.Sort, sortOpt)
+	}
+	return &token, nil
+}
+
+// matchTagFilter checks the tag filter, 'key=value' or 'key'(any value).
+func matchTagFilter(tagList []cres.KeyValue, tagFilter string) bool {
+	key, value, hasValue := strings.Cut(tagFilter, "=")
+	key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+	for _, tag := range tagList {
+		if tag.Key == key && (!hasValue || tag.Value == value) {
+			return true
+		}
+	}
+	return false
+}
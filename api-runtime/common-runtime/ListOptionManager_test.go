@@ -0,0 +1,356 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+type testListInfo struct {
+	NameId  string
+	ZoneId  string
+	Status  string
+	Created time.Time
+	TagList []cres.KeyValue
+}
+
+var testListInfoList = func() []*testListInfo {
+	baseTime := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	infoList := []*testListInfo{}
+	for i := 0; i < 11; i++ {
+		infoList = append(infoList, &testListInfo{
+			NameId:  fmt.Sprintf("res-%02d", (i*7)%11), // not in the name order
+			ZoneId:  []string{"zone-a", "zone-b", "zone-c"}[i%3],
+			Status:  []string{"Running", "Stopped"}[i%2],
+			Created: baseTime.Add(time.Duration(i%4) * time.Hour), // the same created times
+			TagList: []cres.KeyValue{{Key: "env", Value: []string{"dev", "prod"}[i%2]}},
+		})
+	}
+	return infoList
+}()
+
+// newTestListTarget returns the target of testListInfoList and the NameIds retrieved with get.
+func newTestListTarget() (listTarget[testListInfo], []listCandidate, *[]string) {
+	infoMap := map[string]*testListInfo{}
+	candidates := []listCandidate{}
+	for _, info := range testListInfoList {
+		infoMap[info.NameId] = info
+		candidates = append(candidates, listCandidate{NameId: info.NameId, SystemId: "sys-" + info.NameId, ZoneId: info.ZoneId})
+	}
+
+	var lock sync.Mutex
+	gotList := []string{}
+	target := listTarget[testListInfo]{
+		connectionName: "mock-config01",
+		rsType:         DISK,
+		get: func(nameId string) (*testListInfo, error) {
+			lock.Lock()
+			gotList = append(gotList, nameId)
+			lock.Unlock()
+			return infoMap[nameId], nil
+		},
+		idOnlyInfo:    func(candidate listCandidate) *testListInfo { return &testListInfo{NameId: candidate.NameId} },
+		zoneSupported: true,
+		statusOf:      func(info *testListInfo) string { return info.Status },
+		tagsOf:        func(info *testListInfo) []cres.KeyValue { return info.TagList },
+		createdOf:     func(info *testListInfo) time.Time { return info.Created },
+	}
+	return target, candidates, &gotList
+}
+
+func TestListToken(t *testing.T) {
+	testList := []listToken{
+		{Sort: "", Key: "", NameId: "res-01"},
+		{Sort: "-created", Key: "2026-10-01T01:00:00Z", NameId: "res-02"},
+		{Sort: "zone", Key: "zone-a", NameId: "name/with?special&chars="},
+	}
+	for _, token := range testList {
+		decoded, err := decodeListToken(encodeListToken(token), token.Sort)
+		if err != nil {
+			t.Errorf("%+v: %v", token, err)
+			continue
+		}
+		if *decoded != token {
+			t.Errorf("decoded %+v is not same %+v", *decoded, token)
+		}
+	}
+
+	invalidList := []struct {
+		nextToken string
+		sort      string
+	}{
+		{"not-base64!!", ""},
+		{encodeListToken(listToken{Sort: "name"})[:4] + "@@", "name"},
+		{"bm90LWpzb24", ""},                // "not-json"
+		{encodeListToken(listToken{}), ""}, // no key
+		{encodeListToken(listToken{Sort: "zone", Key: "zone-a", NameId: "res-01"}), "-zone"}, // the sort is changed
+	}
+	for _, invalid := range invalidList {
+		_, err := decodeListToken(invalid.nextToken, invalid.sort)
+		var optErr *ListOptionError
+		if !errors.As(err, &optErr) {
+			t.Errorf("%q(sort: %q): error %v is not a ListOptionError", invalid.nextToken, invalid.sort, err)
+		}
+	}
+}
+
+func TestParseListSort(t *testing.T) {
+	testList := []struct {
+		sort    string
+		field   string
+		desc    bool
+		isError bool
+	}{
+		{"", LIST_SORT_NAME, false, false},
+		{"-", LIST_SORT_NAME, true, false},
+		{"name", LIST_SORT_NAME, false, false},
+		{" -Created ", LIST_SORT_CREATED, true, false},
+		{"zone", LIST_SORT_ZONE, false, false},
+		{"-status", LIST_SORT_STATUS, true, false},
+		{"size", "", false, true},
+		{"--name", "", false, true},
+	}
+
+	for _, test := range testList {
+		field, desc, err := parseListSort(test.sort)
+		var optErr *ListOptionError
+		if test.isError != errors.As(err, &optErr) {
+			t.Errorf("%q: unexpected error: %v", test.sort, err)
+			continue
+		}
+		if field != test.field || desc != test.desc {
+			t.Errorf("%q: (%s, %v) is not (%s, %v)", test.sort, field, desc, test.field, test.desc)
+		}
+	}
+}
+
+func TestListWithOptionInvalid(t *testing.T) {
+	target, candidates, _ := newTestListTarget()
+	noZoneTarget := target
+	noZoneTarget.zoneSupported = false
+	noStatusTarget := target
+	noStatusTarget.statusOf = nil
+	noCreatedTarget := target
+	noCreatedTarget.createdOf = nil
+
+	testList := []struct {
+		name   string
+		target listTarget[testListInfo]
+		opt    ListOption
+	}{
+		{"invalid sort", target, ListOption{Sort: "size"}},
+		{"negative limit", target, ListOption{Limit: -1}},
+		{"too large limit", target, ListOption{Limit: MaxListLimit + 1}},
+		{"invalid token", target, ListOption{Limit: 2, NextToken: "invalid"}},
+		{"token of another sort", target, ListOption{Limit: 2, Sort: "zone",
+			NextToken: encodeListToken(listToken{Sort: "name", NameId: "res-01"})}},
+		{"zone filter not supported", noZoneTarget, ListOption{Zone: "zone-a"}},
+		{"zone sort not supported", noZoneTarget, ListOption{Sort: "-zone"}},
+		{"status filter not supported", noStatusTarget, ListOption{Status: "Running"}},
+		{"created sort not supported", noCreatedTarget, ListOption{Sort: "created"}},
+	}
+
+	for _, test := range testList {
+		_, _, err := listWithOption(candidates, test.target, test.opt)
+		var optErr *ListOptionError
+		if !errors.As(err, &optErr) {
+			t.Errorf("%s: error %v is not a ListOptionError", test.name, err)
+		}
+	}
+}
+
+func TestListWithOptionPaging(t *testing.T) {
+	testList := []ListOption{
+		{},
+		{Sort: "-name"},
+		{Sort: "zone"},
+		{Sort: "-zone"},
+		{Sort: "status"},
+		{Sort: "created"},
+		{Sort: "-created"},
+		{Zone: "zone-b"},
+		{Status: "stopped", Sort: "-created"},
+		{Tag: "env=prod", Sort: "zone"},
+		{Tag: "env"},
+		{NamePrefix: "res-0"},
+	}
+
+	for _, opt := range testList {
+		for _, limit := range []int{1, 2, 3, 5, 100} {
+			// all items in one page
+			target, candidates, _ := newTestListTarget()
+			allList, nextToken, err := listWithOption(candidates, target, opt)
+			if err != nil || nextToken != "" {
+				t.Fatalf("%+v: %v, nextToken: %q", opt, err, nextToken)
+			}
+
+			// the pages have the same items in the same order, without a duplicated or missing item
+			pagedList := []*testListInfo{}
+			pageOpt := opt
+			pageOpt.Limit = limit
+			for page := 0; ; page++ {
+				if page > len(testListInfoList) {
+					t.Fatalf("%+v, limit %d: too many pages", opt, limit)
+				}
+				target, candidates, gotList := newTestListTarget()
+				infoList, nextToken, err := listWithOption(candidates, target, pageOpt)
+				if err != nil {
+					t.Fatalf("%+v, limit %d, page %d: %v", opt, limit, page, err)
+				}
+				if len(infoList) > limit {
+					t.Errorf("%+v, limit %d, page %d: %d items", opt, limit, page, len(infoList))
+				}
+				// only the items of the page are retrieved, if the filters and the sort do not need the full info
+				if opt.Tag == "" && opt.Status == "" && opt.Sort != "status" && opt.Sort != "created" && opt.Sort != "-created" &&
+					len(*gotList) != len(infoList) {
+					t.Errorf("%+v, limit %d, page %d: %d items retrieved for %d items", opt, limit, page, len(*gotList), len(infoList))
+				}
+				pagedList = append(pagedList, infoList...)
+				if nextToken == "" {
+					break
+				}
+				pageOpt.NextToken = nextToken
+			}
+
+			if len(pagedList) != len(allList) {
+				t.Errorf("%+v, limit %d: %d items in the pages, %d items in all", opt, limit, len(pagedList), len(allList))
+				continue
+			}
+			for i := range allList {
+				if pagedList[i].NameId != allList[i].NameId {
+					t.Errorf("%+v, limit %d: #%d %s is not same %s", opt, limit, i, pagedList[i].NameId, allList[i].NameId)
+				}
+			}
+		}
+	}
+}
+
+func TestListWithOptionOrder(t *testing.T) {
+	testList := []struct {
+		opt  ListOption
+		less func(a, b *testListInfo) bool
+	}{
+		{ListOption{}, func(a, b *testListInfo) bool { return a.NameId < b.NameId }},
+		{ListOption{Sort: "-name"}, func(a, b *testListInfo) bool { return a.NameId > b.NameId }},
+		{ListOption{Sort: "zone"}, func(a, b *testListInfo) bool {
+			return a.ZoneId < b.ZoneId || a.ZoneId == b.ZoneId && a.NameId < b.NameId
+		}},
+		{ListOption{Sort: "-created"}, func(a, b *testListInfo) bool {
+			return a.Created.After(b.Created) || a.Created.Equal(b.Created) && a.NameId > b.NameId
+		}},
+	}
+
+	for _, test := range testList {
+		target, candidates, _ := newTestListTarget()
+		infoList, _, err := listWithOption(candidates, target, test.opt)
+		if err != nil {
+			t.Fatalf("%+v: %v", test.opt, err)
+		}
+		if len(infoList) != len(testListInfoList) {
+			t.Errorf("%+v: %d items", test.opt, len(infoList))
+		}
+		for i := 1; i < len(infoList); i++ {
+			if !test.less(infoList[i-1], infoList[i]) {
+				t.Errorf("%+v: %s is before %s", test.opt, infoList[i-1].NameId, infoList[i].NameId)
+			}
+		}
+	}
+}
+
+func TestPageAllResourceList(t *testing.T) {
+	newAllResourceList := func() AllResourceList {
+		var allResourceList AllResourceList
+		for _, name := range []string{"vm-03", "vm-01", "vm-02"} {
+			allResourceList.AllList.MappedList = append(allResourceList.AllList.MappedList, &cres.IID{NameId: name, SystemId: "sys-" + name})
+		}
+		allResourceList.AllList.OnlySpiderList = []*cres.IID{{NameId: "vm-04", SystemId: "sys-vm-04"}}
+		for _, systemId := range []string{"i-0b", "i-0a"} {
+			allResourceList.AllList.OnlyCSPList = append(allResourceList.AllList.OnlyCSPList, &cres.IID{SystemId: systemId})
+		}
+		return allResourceList
+	}
+	expected := []string{"vm-01", "vm-02", "vm-03", "vm-04", "i-0a", "i-0b"}
+
+	for _, limit := range []int{1, 2, 4, 10} {
+		var pagedList []string
+		opt := ListOption{Limit: limit}
+		for page := 0; ; page++ {
+			if page > len(expected) {
+				t.Fatalf("limit %d: too many pages", limit)
+			}
+			pageList, nextToken, err := PageAllResourceList(newAllResourceList(), opt)
+			if err != nil {
+				t.Fatalf("limit %d, page %d: %v", limit, page, err)
+			}
+			for _, iid := range pageList.AllList.MappedList {
+				pagedList = append(pagedList, iid.NameId)
+			}
+			for _, iid := range pageList.AllList.OnlySpiderList {
+				pagedList = append(pagedList, iid.NameId)
+			}
+			for _, iid := range pageList.AllList.OnlyCSPList {
+				pagedList = append(pagedList, iid.SystemId)
+			}
+			if nextToken == "" {
+				break
+			}
+			opt.NextToken = nextToken
+		}
+		if fmt.Sprint(pagedList) != fmt.Sprint(expected) {
+			t.Errorf("limit %d: %v is not same %v", limit, pagedList, expected)
+		}
+	}
+
+	// the token of a resource list is not a token of the list of all resources
+	resourceToken := encodeListToken(listToken{Key: "", NameId: "vm-01"})
+	invalidList := []ListOption{
+		{Zone: "zone-a"},
+		{Status: "Running"},
+		{Tag: "env"},
+		{Sort: "created"},
+		{Sort: "invalid"},
+		{Limit: 1, NextToken: resourceToken},
+		{Limit: 1, NextToken: "invalid"},
+	}
+	for _, opt := range invalidList {
+		_, _, err := PageAllResourceList(newAllResourceList(), opt)
+		var optErr *ListOptionError
+		if !errors.As(err, &optErr) {
+			t.Errorf("%+v: error %v is not a ListOptionError", opt, err)
+		}
+	}
+}
+
+func TestMatchTagFilter(t *testing.T) {
+	tagList := []cres.KeyValue{{Key: "env", Value: "prod"}, {Key: "team", Value: ""}}
+	testList := []struct {
+		tagFilter string
+		matched   bool
+	}{
+		{"env=prod", true},
+		{" env = prod ", true},
+		{"env", true},
+		{"env=dev", false},
+		{"team=", true},
+		{"team", true},
+		{"owner", false},
+	}
+
+	for _, test := range testList {
+		if matched := matchTagFilter(tagList, test.tagFilter); matched != test.matched {
+			t.Errorf("%q: matched %v is not %v", test.tagFilter, matched, test.matched)
+		}
+	}
+}
@@ -0,0 +1,359 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"fmt"
+	"strings"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// ====================================================================
+// type for GORM
+
+type NATGatewayIIDInfo VPCDependentIIDInfo
+
+func (NATGatewayIIDInfo) TableName() string {
+	return "natgateway_iid_infos"
+}
+
+//====================================================================
+
+func init() {
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	db.AutoMigrate(&NATGatewayIIDInfo{})
+	infostore.Close(db)
+}
+
+//================ NAT Gateway Handler
+
+// (1) check capability and exist
+// (2) get the driver IIDs of the VPC, the Subnet and the PublicIP
+// (3) generate SP-XID
+// (4) create Resource
+// (5) insert spiderIID
+// (6) return userIID
+func CreateNATGateway(connectionName string, rsType string, reqInfo cres.NATGatewayReqInfo, IDTransformMode string) (*cres.NATGatewayInfo, error) {
+	cblog.Info("call CreateNATGateway()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if reqInfo.IId.NameId == "" || reqInfo.VpcIID.NameId == "" || reqInfo.SubnetIID.NameId == "" {
+		err := fmt.Errorf("NATGateway Name, VPC Name and Subnet Name are required!")
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (1) check capability and exist
+	if err := checkCapability(connectionName, NAT_GATEWAY_HANDLER); err != nil {
+		return nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateNATGatewayHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	vpcName := reqInfo.VpcIID.NameId
+
+	natGatewaySPLock.Lock(connectionName, reqInfo.IId.NameId)
+	defer natGatewaySPLock.Unlock(connectionName, reqInfo.IId.NameId)
+
+	vpcSPLock.RLock(connectionName, vpcName)
+	defer vpcSPLock.RUnlock(connectionName, vpcName)
+
+	bool_ret, err := infostore.HasByConditions(&NATGatewayIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, reqInfo.IId.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if bool_ret {
+		err := fmt.Errorf("%s '%s' already exists in connection '%s'", RSTypeString(rsType), reqInfo.IId.NameId, connectionName)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) get the driver IIDs of the VPC, the Subnet and the PublicIP
+	var vpcIIDInfo VPCIIDInfo
+	if err = infostore.GetByConditions(&vpcIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, vpcName); err != nil {
+		cblog.Error(err)
+		return nil, fmt.Errorf("VPC '%s' not found in connection '%s'", vpcName, connectionName)
+	}
+	reqInfo.VpcIID = getDriverIID(cres.IID{NameId: vpcIIDInfo.NameId, SystemId: vpcIIDInfo.SystemId})
+
+	var subnetIIDInfo SubnetIIDInfo
+	if err = infostore.GetBy3Conditions(&subnetIIDInfo, CONNECTION_NAME_COLUMN, connectionName, OWNER_VPC_NAME_COLUMN, vpcName, NAME_ID_COLUMN, reqInfo.SubnetIID.NameId); err != nil {
+		cblog.Error(err)
+		return nil, fmt.Errorf("Subnet '%s' not found in VPC '%s'", reqInfo.SubnetIID.NameId, vpcName)
+	}
+	reqInfo.SubnetIID = getDriverIID(cres.IID{NameId: subnetIIDInfo.NameId, SystemId: subnetIIDInfo.SystemId})
+
+	if reqInfo.PublicIPIID.NameId != "" {
+		var publicIPIIDInfo PublicIPIIDInfo
+		if err = infostore.GetByConditions(&publicIPIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, reqInfo.PublicIPIID.NameId); err != nil {
+			cblog.Error(err)
+			return nil, fmt.Errorf("PublicIP '%s' not found in connection '%s'", reqInfo.PublicIPIID.NameId, connectionName)
+		}
+		reqInfo.PublicIPIID = getDriverIID(cres.IID{NameId: publicIPIIDInfo.NameId, SystemId: publicIPIIDInfo.SystemId})
+	}
+
+	// (3) generate SP-XID
+	spUUID := ""
+	if GetID_MGMT(IDTransformMode) == "ON" {
+		spUUID, err = iidm.New(connectionName, rsType, reqInfo.IId.NameId)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	} else {
+		spUUID = reqInfo.IId.NameId
+	}
+
+	reqIId := cres.IID{NameId: reqInfo.IId.NameId, SystemId: spUUID}
+	reqInfo.IId = cres.IID{NameId: spUUID, SystemId: ""}
+
+	// (4) create Resource
+	info, err := handler.CreateNATGateway(reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (5) insert spiderIID
+	spiderIId := cres.IID{NameId: reqIId.NameId, SystemId: spUUID + ":" + info.IId.SystemId}
+	err = infostore.Insert(&NATGatewayIIDInfo{ConnectionName: connectionName, NameId: spiderIId.NameId, SystemId: spiderIId.SystemId,
+		OwnerVPCName: vpcName})
+	if err != nil {
+		cblog.Error(err)
+		// rollback
+		_, err2 := handler.DeleteNATGateway(info.IId)
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf("%v, %v", err, err2)
+		}
+		return nil, err
+	}
+
+	// (6) return userIID
+	info.IId = getUserIID(spiderIId)
+	resolveNATGatewayRelatedIIDs(connectionName, &info)
+	return &info, nil
+}
+
+func ListNATGateway(connectionName string, rsType string) ([]*cres.NATGatewayInfo, error) {
+	cblog.Info("call ListNATGateway()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateNATGatewayHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var iidInfoList []*NATGatewayIIDInfo
+	err = infostore.ListByCondition(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	infoList := []*cres.NATGatewayInfo{}
+	for _, iidInfo := range iidInfoList {
+		natGatewaySPLock.RLock(connectionName, iidInfo.NameId)
+		info, err := handler.GetNATGateway(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+		natGatewaySPLock.RUnlock(connectionName, iidInfo.NameId)
+		if err != nil {
+			cblog.Error(err)
+			info = cres.NATGatewayInfo{IId: getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}),
+				VpcIID: cres.IID{NameId: iidInfo.OwnerVPCName}, Status: cres.NATGatewayNotFound}
+			infoList = append(infoList, &info)
+			continue
+		}
+		info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+		resolveNATGatewayRelatedIIDs(connectionName, &info)
+		infoList = append(infoList, &info)
+	}
+	return infoList, nil
+}
+
+func GetNATGateway(connectionName string, rsType string, nameID string) (*cres.NATGatewayInfo, error) {
+	cblog.Info("call GetNATGateway()")
+
+	handler, iidInfo, err := getNATGatewayHandlerAndIIDInfo(connectionName, nameID)
+	if err != nil {
+		return nil, err
+	}
+
+	natGatewaySPLock.RLock(connectionName, nameID)
+	defer natGatewaySPLock.RUnlock(connectionName, nameID)
+
+	info, err := handler.GetNATGateway(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	resolveNATGatewayRelatedIIDs(connectionName, &info)
+	return &info, nil
+}
+
+func DeleteNATGateway(connectionName string, rsType string, nameID string, force string) (bool, error) {
+	cblog.Info("call DeleteNATGateway()")
+
+	handler, iidInfo, err := getNATGatewayHandlerAndIIDInfo(connectionName, nameID)
+	if err != nil {
+		return false, err
+	}
+
+	natGatewaySPLock.Lock(connectionName, nameID)
+	defer natGatewaySPLock.Unlock(connectionName, nameID)
+
+	driverIID := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+
+	// the routes to the NATGateway must be removed before the NATGateway
+	routeTableNameList, err := getRouteTableNamesUsingTarget(connectionName, iidInfo.OwnerVPCName, cres.RouteTargetNATGateway, driverIID)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	if len(routeTableNameList) > 0 {
+		err := fmt.Errorf("%s '%s' is used by the %s(s): %s", RSTypeString(rsType), iidInfo.NameId, RSTypeString(ROUTETABLE), strings.Join(routeTableNameList, ", "))
+		cblog.Error(err)
+		return false, err
+	}
+
+	result, err := handler.DeleteNATGateway(driverIID)
+	if err != nil {
+		cblog.Error(err)
+		if force != "true" {
+			return false, err
+		}
+	}
+	if force != "true" && !result {
+		return false, nil
+	}
+
+	_, err = infostore.DeleteByConditions(&NATGatewayIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, iidInfo.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func CountAllNATGateways() (int64, error) {
+	var info NATGatewayIIDInfo
+	count, err := infostore.CountAllNameIDs(&info)
+	if err != nil {
+		cblog.Error(err)
+		return count, err
+	}
+	return count, nil
+}
+
+func CountNATGatewaysByConnection(connectionName string) (int64, error) {
+	var info NATGatewayIIDInfo
+	count, err := infostore.CountNameIDsByConnection(&info, connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return count, err
+	}
+	return count, nil
+}
+
+func getNATGatewayHandlerAndIIDInfo(connectionName string, nameID string) (cres.NATGatewayHandler, *NATGatewayIIDInfo, error) {
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, nil, err
+	}
+
+	nameID, err = EmptyCheckAndTrim("nameID", nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, nil, err
+	}
+
+	handler, err := cldConn.CreateNATGatewayHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, nil, err
+	}
+
+	var iidInfo NATGatewayIIDInfo
+	err = infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, nil, fmt.Errorf("%s '%s' not found in connection '%s': %v", RSTypeString(NATGATEWAY), nameID, connectionName, err)
+	}
+	return handler, &iidInfo, nil
+}
+
+// resolveNATGatewayRelatedIIDs resolves the NameIds of the VPC, the Subnet and the PublicIP from the CSP SystemIds.
+func resolveNATGatewayRelatedIIDs(connectionName string, info *cres.NATGatewayInfo) {
+	if info.VpcIID.SystemId != "" {
+		var vpcInfo VPCIIDInfo
+		if err := infostore.GetByContain(&vpcInfo, CONNECTION_NAME_COLUMN, connectionName, SYSTEM_ID_COLUMN, info.VpcIID.SystemId); err == nil {
+			info.VpcIID.NameId = vpcInfo.NameId
+		}
+	}
+
+	if info.SubnetIID.SystemId != "" {
+		var subnetInfo SubnetIIDInfo
+		if err := infostore.GetByContain(&subnetInfo, CONNECTION_NAME_COLUMN, connectionName, SYSTEM_ID_COLUMN, info.SubnetIID.SystemId); err == nil {
+			info.SubnetIID.NameId = subnetInfo.NameId
+		}
+	}
+
+	if info.PublicIPIID.SystemId != "" {
+		var publicIPInfo PublicIPIIDInfo
+		if err := infostore.GetByContain(&publicIPInfo, CONNECTION_NAME_COLUMN, connectionName, SYSTEM_ID_COLUMN, info.PublicIPIID.SystemId); err == nil {
+			info.PublicIPIID.NameId = publicIPInfo.NameId
+		}
+	}
+}
@@ -11,6 +11,7 @@ package commonruntime
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
@@ -293,6 +294,22 @@ func DeleteNIC(connectionName string, rsType string, nameID string, force string
 	}
 
 	driverIId := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+
+	// the routes to the NIC must be removed before the NIC
+	nicInfo, err := handler.GetNIC(driverIId)
+	if err != nil {
+		cblog.Error(err)
+		if force != "true" { return false, err }
+	} else {
+		resolveNICRelatedIIDs(connectionName, &nicInfo)
+		routeTableNameList, err := getRouteTableNamesUsingTarget(connectionName, nicInfo.VpcIID.NameId, cres.RouteTargetNIC, driverIId)
+		if err != nil { cblog.Error(err); return false, err }
+		if len(routeTableNameList) > 0 {
+			err := fmt.Errorf("%s '%s' is used by the %s(s): %s", RSTypeString(rsType), iidInfo.NameId, RSTypeString(ROUTETABLE), strings.Join(routeTableNameList, ", "))
+			cblog.Error(err); return false, err
+		}
+	}
+
 	result, err := handler.DeleteNIC(driverIId)
 	if err != nil { cblog.Error(err); if force != "true" { return false, err } }
 	if force != "true" && !result { return false, nil }
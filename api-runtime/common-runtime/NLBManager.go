@@ -343,6 +343,12 @@ func CreateNLB(connectionName string, rsType string, reqInfo cres.NLBInfo, IDTra
 	   }
 	*/
 
+	err = validateNLBOptions(connectionName, reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	vpcSPLock.RLock(connectionName, reqInfo.VpcIID.NameId)
 	defer vpcSPLock.RUnlock(connectionName, reqInfo.VpcIID.NameId)
 
@@ -482,6 +488,9 @@ func CreateNLB(connectionName string, rsType string, reqInfo cres.NLBInfo, IDTra
 	}
 	// set default configuration of HealthChecker
 	setDefaultHealthCheckerConfig(providerName, &reqInfo.HealthChecker)
+	for idx := range reqInfo.ListenerRules {
+		setDefaultHealthCheckerConfig(providerName, &reqInfo.ListenerRules[idx].HealthChecker)
+	}
 
 	// (3) create Resource
 	info, err := handler.CreateNLB(reqInfo)
@@ -530,7 +539,7 @@ func setDefaultHealthCheckerConfig(providerName string, reqInfo *cres.HealthChec
 	// * -1(int) => set up with spider's default value
 	// * Spider's default values for Health Checking
 	//	[TCP]  Interval:10 / Timeout:10 (IBM:9) / Threshold:3
-	//	[HTTP] Interval:10 / Timeout:6 / Threshold:3
+	//	[HTTP, HTTPS] Interval:10 / Timeout:6 / Threshold:3
 	// * AWS, Azure: disable Timeout Configuration
 
 	// (1) TCP
@@ -551,8 +560,8 @@ func setDefaultHealthCheckerConfig(providerName string, reqInfo *cres.HealthChec
 			reqInfo.Threshold = 3
 		}
 	}
-	// (2) HTTP
-	if reqInfo.Protocol == "HTTP" {
+	// (2) HTTP, HTTPS
+	if reqInfo.Protocol == "HTTP" || reqInfo.Protocol == "HTTPS" {
 		if reqInfo.Interval == -1 {
 			reqInfo.Interval = 10
 		}
@@ -577,6 +586,102 @@ func transformArgsToUpper(nlbInfo *cres.NLBInfo) {
 	nlbInfo.VMGroup.Protocol = strings.ToUpper(nlbInfo.VMGroup.Protocol)
 	// HealthCheckerInfo
 	nlbInfo.HealthChecker.Protocol = strings.ToUpper(nlbInfo.HealthChecker.Protocol)
+	// ListenerRuleInfo
+	for idx := range nlbInfo.ListenerRules {
+		rule := &nlbInfo.ListenerRules[idx]
+		rule.Listener.Protocol = strings.ToUpper(rule.Listener.Protocol)
+		rule.TargetGroup.Protocol = strings.ToUpper(rule.TargetGroup.Protocol)
+		rule.HealthChecker.Protocol = strings.ToUpper(rule.HealthChecker.Protocol)
+	}
+}
+
+// validateNLBOptions checks the additional listeners, the HTTP/HTTPS protocols, the certificates and the health check paths of an NLB,
+// and checks if the connection's driver supports them.
+func validateNLBOptions(connectionName string, nlbInfo cres.NLBInfo) error {
+	if len(nlbInfo.ListenerRules) > 0 {
+		err := checkCapability(connectionName, NLB_MULTI_LISTENER)
+		if err != nil {
+			return err
+		}
+	}
+
+	listenerList := []cres.ListenerInfo{nlbInfo.Listener}
+	healthCheckerList := []cres.HealthCheckerInfo{nlbInfo.HealthChecker}
+	for _, rule := range nlbInfo.ListenerRules {
+		if strings.TrimSpace(rule.Listener.Protocol) == "" || strings.TrimSpace(rule.Listener.Port) == "" {
+			return fmt.Errorf("the Protocol and Port of a listener rule's Listener are required")
+		}
+		if strings.TrimSpace(rule.TargetGroup.Protocol) == "" || strings.TrimSpace(rule.TargetGroup.Port) == "" {
+			return fmt.Errorf("the Protocol and Port of the TargetGroup of the listener(%s:%s) are required", rule.Listener.Protocol, rule.Listener.Port)
+		}
+		listenerList = append(listenerList, rule.Listener)
+		healthCheckerList = append(healthCheckerList, rule.HealthChecker)
+	}
+
+	// HTTP/HTTPS(L7) listeners and backends, not supported by the L4 only drivers
+	protocolList := []string{nlbInfo.VMGroup.Protocol}
+	for _, listener := range listenerList {
+		protocolList = append(protocolList, listener.Protocol)
+	}
+	for _, rule := range nlbInfo.ListenerRules {
+		protocolList = append(protocolList, rule.TargetGroup.Protocol)
+	}
+	for _, protocol := range protocolList {
+		if strings.EqualFold(protocol, "HTTP") || strings.EqualFold(protocol, "HTTPS") {
+			err := checkCapability(connectionName, NLB_HTTP_PROTOCOL)
+			if err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	// a port can be listened by only one listener
+	portMap := map[string]bool{}
+	for _, listener := range listenerList {
+		port := strings.TrimSpace(listener.Port)
+		if port == "" {
+			continue
+		}
+		if portMap[port] {
+			return fmt.Errorf("the listener port %s is duplicated", port)
+		}
+		portMap[port] = true
+	}
+
+	for _, listener := range listenerList {
+		if listener.CertificateID == "" {
+			if strings.EqualFold(listener.Protocol, "HTTPS") {
+				return fmt.Errorf("the HTTPS listener(%s:%s) requires a CertificateID", listener.Protocol, listener.Port)
+			}
+			continue
+		}
+		if !strings.EqualFold(listener.Protocol, "HTTPS") {
+			return fmt.Errorf("the CertificateID is allowed only for the HTTPS listener, but the listener(%s:%s) is %s", listener.Protocol, listener.Port, listener.Protocol)
+		}
+		err := checkCapability(connectionName, NLB_HTTPS_CERTIFICATE)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, healthChecker := range healthCheckerList {
+		if healthChecker.Path == "" {
+			continue
+		}
+		if !strings.EqualFold(healthChecker.Protocol, "HTTP") && !strings.EqualFold(healthChecker.Protocol, "HTTPS") {
+			return fmt.Errorf("the Path is allowed only for the HTTP or HTTPS health checker, but the health checker is %s", healthChecker.Protocol)
+		}
+		if !strings.HasPrefix(healthChecker.Path, "/") {
+			return fmt.Errorf("the health check Path must start with '/': %s", healthChecker.Path)
+		}
+		err := checkCapability(connectionName, NLB_HEALTH_CHECK_PATH)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // (1) get IID:list
@@ -1165,7 +1270,8 @@ func ChangeListener(connectionName string, nlbName string, listener cres.Listene
 		"resources.IID:SystemId",
 		"resources.ListenerInfo:IP",
 		"resources.ListenerInfo:DNSName",
-		"resources.ListenerInfo:CspID", // because can be unused in some CSP
+		"resources.ListenerInfo:CertificateID", // because used only for HTTPS
+		"resources.ListenerInfo:CspID",         // because can be unused in some CSP
 	}
 	err = ValidateStruct(listener, emptyPermissionList)
 	if err != nil {
@@ -1173,6 +1279,12 @@ func ChangeListener(connectionName string, nlbName string, listener cres.Listene
 		return nil, err
 	}
 
+	err = validateNLBOptions(connectionName, cres.NLBInfo{Listener: listener})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	cldConn, err := ccm.GetCloudConnection(connectionName)
 	if err != nil {
 		cblog.Error(err)
@@ -1328,6 +1440,12 @@ func ChangeVMGroup(connectionName string, nlbName string, vmGroup cres.VMGroupIn
 	   }
 	*/
 
+	err = validateNLBOptions(connectionName, cres.NLBInfo{VMGroup: vmGroup})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	cldConn, err := ccm.GetCloudConnection(connectionName)
 	if err != nil {
 		cblog.Error(err)
@@ -1472,6 +1590,7 @@ func ChangeHealthChecker(connectionName string, nlbName string, healthChecker cr
 
 	emptyPermissionList := []string{
 		"resources.IID:SystemId",
+		"resources.HealthCheckerInfo:Path",  // because used only for HTTP and HTTPS
 		"resources.HealthCheckerInfo:CspID", // because can be unused in some CSP
 	}
 	err = ValidateStruct(healthChecker, emptyPermissionList)
@@ -1480,6 +1599,12 @@ func ChangeHealthChecker(connectionName string, nlbName string, healthChecker cr
 		return nil, err
 	}
 
+	err = validateNLBOptions(connectionName, cres.NLBInfo{HealthChecker: healthChecker})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	cldConn, err := ccm.GetCloudConnection(connectionName)
 	if err != nil {
 		cblog.Error(err)
@@ -38,6 +38,20 @@ func (RDBMSIIDInfo) TableName() string {
 	return "rdbms_iid_infos"
 }
 
+type RDBMSSnapshotIIDInfo struct {
+	ConnectionName  string `gorm:"primaryKey"` // ex) "aws-seoul-config"
+	NameId          string `gorm:"primaryKey"` // ex) "my-rdbms-01-snapshot"
+	SystemId        string // ID in CSP, ex) "my-rdbms-01-snapshot-d1e2f3"
+	SourceRDBMSName string // ex) "my-rdbms-01" - NOT primaryKey, kept after the source RDBMS is deleted
+	SourceDBEngine  string // ex) "mysql" - to check the driver support after the source RDBMS is deleted
+}
+
+func (RDBMSSnapshotIIDInfo) TableName() string {
+	return "rdbms_snapshot_iid_infos"
+}
+
+const SOURCE_RDBMS_NAME_COLUMN = "source_rdbms_name"
+
 //====================================================================
 
 func init() {
@@ -47,6 +61,7 @@ func init() {
 		return
 	}
 	db.AutoMigrate(&RDBMSIIDInfo{})
+	db.AutoMigrate(&RDBMSSnapshotIIDInfo{})
 	infostore.Close(db)
 }
 
@@ -324,92 +339,15 @@ func CreateRDBMS(connectionName string, rsType string, reqInfo cres.RDBMSInfo, I
 	vpcSPLock.RLock(connectionName, reqInfo.VpcIID.NameId)
 	defer vpcSPLock.RUnlock(connectionName, reqInfo.VpcIID.NameId)
 
-	//+++++++++++++++++++++++++++++++++++++++++++
-	// set VPC's SystemId
-	var vpcIIDInfo VPCIIDInfo
-	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
-		var iidInfoList []*VPCIIDInfo
-		err = getAuthIIDInfoList(connectionName, &iidInfoList)
-		if err != nil {
-			cblog.Error(err)
-			return nil, err
-		}
-		castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, reqInfo.VpcIID.NameId)
-		if err != nil {
-			cblog.Error(err)
-			return nil, err
-		}
-		vpcIIDInfo = *castedIIDInfo.(*VPCIIDInfo)
-	} else {
-		err = infostore.GetByConditions(&vpcIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, reqInfo.VpcIID.NameId)
-		if err != nil {
-			cblog.Error(err)
-			return nil, err
-		}
-	}
-	reqInfo.VpcIID = getDriverIID(cres.IID{NameId: vpcIIDInfo.NameId, SystemId: vpcIIDInfo.SystemId})
-	//+++++++++++++++++++++++++++++++++++++++++++
-
-	// SubnetIIDs translation
-	for idx, subnetIID := range reqInfo.SubnetIIDs {
-		var subnetIIdInfo SubnetIIDInfo
-		if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
-			var iidInfoList []*VPCIIDInfo
-			err = getAuthIIDInfoList(connectionName, &iidInfoList)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-			castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, vpcIIDInfo.NameId)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-			vpcInfo := *castedIIDInfo.(*VPCIIDInfo)
-			err = infostore.GetBy3Conditions(&subnetIIdInfo, CONNECTION_NAME_COLUMN, vpcInfo.ConnectionName, NAME_ID_COLUMN, subnetIID.NameId, OWNER_VPC_NAME_COLUMN, vpcInfo.NameId)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-		} else {
-			err = infostore.GetBy3Conditions(&subnetIIdInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, subnetIID.NameId, OWNER_VPC_NAME_COLUMN, vpcIIDInfo.NameId)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-		}
-		reqInfo.SubnetIIDs[idx] = getDriverIID(cres.IID{NameId: subnetIIdInfo.NameId, SystemId: subnetIIdInfo.SystemId})
-	}
-	//+++++++++++++++++++++++++++++++++++++++++++
-
-	// SecurityGroupIIDs translation
-	for idx, sgIID := range reqInfo.SecurityGroupIIDs {
+	for _, sgIID := range reqInfo.SecurityGroupIIDs {
 		sgSPLock.RLock(connectionName, sgIID.NameId)
 		defer sgSPLock.RUnlock(connectionName, sgIID.NameId)
-		var sgIIdInfo SGIIDInfo
-		if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
-			var iidInfoList []*SGIIDInfo
-			err := getAuthIIDInfoList(connectionName, &iidInfoList)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-			castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, sgIID.NameId)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-			sgIIdInfo = *castedIIDInfo.(*SGIIDInfo)
-		} else {
-			err = infostore.GetByConditions(&sgIIdInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, sgIID.NameId)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-		}
-		reqInfo.SecurityGroupIIDs[idx] = getDriverIID(cres.IID{NameId: sgIIdInfo.NameId, SystemId: sgIIdInfo.SystemId})
 	}
-	//+++++++++++++++++++++++++++++++++++++++++++
+
+	vpcIIDInfo, err := setRDBMSNetworkDriverIIDs(connectionName, &reqInfo)
+	if err != nil {
+		return nil, err
+	}
 
 	cldConn, err := ccm.GetCloudConnection(connectionName)
 	if err != nil {
@@ -505,13 +443,105 @@ func CreateRDBMS(connectionName string, rsType string, reqInfo cres.RDBMSInfo, I
 	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
 
 	// set SubnetIIDs UserIID
-	setRDBMSSubnetUserIID(connectionName, vpcIIDInfo, &info)
+	setRDBMSSubnetUserIID(connectionName, *vpcIIDInfo, &info)
 	// set SecurityGroupIIDs UserIID
-	setRDBMSSGUserIID(connectionName, vpcIIDInfo, &info)
+	setRDBMSSGUserIID(connectionName, *vpcIIDInfo, &info)
 
 	return &info, nil
 }
 
+// setRDBMSNetworkDriverIIDs sets the VPC, Subnet and SecurityGroup IIDs of reqInfo given by NameId to the driver IIDs,
+// and returns the IID info of the VPC. The caller locks the VPC and the SecurityGroups.
+func setRDBMSNetworkDriverIIDs(connectionName string, reqInfo *cres.RDBMSInfo) (*VPCIIDInfo, error) {
+	//+++++++++++++++++++++++++++++++++++++++++++
+	// set VPC's SystemId
+	var vpcIIDInfo VPCIIDInfo
+	var err error
+	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+		var iidInfoList []*VPCIIDInfo
+		err = getAuthIIDInfoList(connectionName, &iidInfoList)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, reqInfo.VpcIID.NameId)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		vpcIIDInfo = *castedIIDInfo.(*VPCIIDInfo)
+	} else {
+		err = infostore.GetByConditions(&vpcIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, reqInfo.VpcIID.NameId)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+	reqInfo.VpcIID = getDriverIID(cres.IID{NameId: vpcIIDInfo.NameId, SystemId: vpcIIDInfo.SystemId})
+	//+++++++++++++++++++++++++++++++++++++++++++
+
+	// SubnetIIDs translation
+	for idx, subnetIID := range reqInfo.SubnetIIDs {
+		var subnetIIdInfo SubnetIIDInfo
+		if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+			var iidInfoList []*VPCIIDInfo
+			err = getAuthIIDInfoList(connectionName, &iidInfoList)
+			if err != nil {
+				cblog.Error(err)
+				return nil, err
+			}
+			castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, vpcIIDInfo.NameId)
+			if err != nil {
+				cblog.Error(err)
+				return nil, err
+			}
+			vpcInfo := *castedIIDInfo.(*VPCIIDInfo)
+			err = infostore.GetBy3Conditions(&subnetIIdInfo, CONNECTION_NAME_COLUMN, vpcInfo.ConnectionName, NAME_ID_COLUMN, subnetIID.NameId, OWNER_VPC_NAME_COLUMN, vpcInfo.NameId)
+			if err != nil {
+				cblog.Error(err)
+				return nil, err
+			}
+		} else {
+			err = infostore.GetBy3Conditions(&subnetIIdInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, subnetIID.NameId, OWNER_VPC_NAME_COLUMN, vpcIIDInfo.NameId)
+			if err != nil {
+				cblog.Error(err)
+				return nil, err
+			}
+		}
+		reqInfo.SubnetIIDs[idx] = getDriverIID(cres.IID{NameId: subnetIIdInfo.NameId, SystemId: subnetIIdInfo.SystemId})
+	}
+	//+++++++++++++++++++++++++++++++++++++++++++
+
+	// SecurityGroupIIDs translation
+	for idx, sgIID := range reqInfo.SecurityGroupIIDs {
+		var sgIIdInfo SGIIDInfo
+		if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+			var iidInfoList []*SGIIDInfo
+			err := getAuthIIDInfoList(connectionName, &iidInfoList)
+			if err != nil {
+				cblog.Error(err)
+				return nil, err
+			}
+			castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, sgIID.NameId)
+			if err != nil {
+				cblog.Error(err)
+				return nil, err
+			}
+			sgIIdInfo = *castedIIDInfo.(*SGIIDInfo)
+		} else {
+			err = infostore.GetByConditions(&sgIIdInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, sgIID.NameId)
+			if err != nil {
+				cblog.Error(err)
+				return nil, err
+			}
+		}
+		reqInfo.SecurityGroupIIDs[idx] = getDriverIID(cres.IID{NameId: sgIIdInfo.NameId, SystemId: sgIIdInfo.SystemId})
+	}
+	//+++++++++++++++++++++++++++++++++++++++++++
+
+	return &vpcIIDInfo, nil
+}
+
 // setRDBMSSubnetUserIID sets SubnetIIDs to user-friendly names from metadb
 func setRDBMSSubnetUserIID(connectionName string, vpcIIDInfo VPCIIDInfo, info *cres.RDBMSInfo) {
 	for idx, subnetIID := range info.SubnetIIDs {
@@ -1194,3 +1224,798 @@ func DeleteRDBMSDatabase(connectionName, rdbmsName, dbName, masterUserPassword s
 	// SQL fallback
 	return deleteDatabaseSQL(&info, masterUserPassword, dbName)
 }
+
+// -------- RDBMS Lifecycle Operations --------
+// Each operation is checked with the Supports* fields of the driver meta info for the DBEngine of the RDBMS,
+// and fails with ErrRDBMSOperationNotSupported if the driver does not support it.
+
+// ErrRDBMSOperationNotSupported is returned when the driver meta info does not support an RDBMS lifecycle operation.
+var ErrRDBMSOperationNotSupported = fmt.Errorf("RDBMS operation is not supported by the driver")
+
+// ErrNotSnapshotOfRDBMS is returned when a snapshot is accessed under an RDBMS
+// other than the RDBMS from which it was taken.
+var ErrNotSnapshotOfRDBMS = fmt.Errorf("not a snapshot of the RDBMS")
+
+// ErrRDBMSRestoreNetworkRequired is returned when the source RDBMS of a snapshot is deleted
+// and the VPC and the Subnets of the restored RDBMS are not given.
+var ErrRDBMSRestoreNetworkRequired = fmt.Errorf("the VPC and the Subnets of the restored RDBMS are required")
+
+// checkRDBMSOperation checks if the driver supports an operation for the DBEngine with the driver meta info.
+func checkRDBMSOperation(handler cres.RDBMSHandler, dbEngine string, operation string, supported func(metaInfo cres.RDBMSMetaInfo) bool) error {
+	engine := strings.ToLower(strings.TrimSpace(dbEngine))
+	// some CSPs report PostgreSQL as "postgres"(ex: AWS)
+	if strings.HasPrefix(engine, "postgres") {
+		engine = "postgresql"
+	}
+
+	metaInfo, err := handler.GetMetaInfo(engine)
+	if err != nil {
+		return err
+	}
+	if !supported(metaInfo) {
+		return fmt.Errorf("%w: %s of %s RDBMS", ErrRDBMSOperationNotSupported, operation, engine)
+	}
+	return nil
+}
+
+// checkRDBMSOperationOf checks an operation with the DBEngine of the RDBMS, and returns the driver info of the RDBMS.
+func checkRDBMSOperationOf(handler cres.RDBMSHandler, driverIId cres.IID, operation string, supported func(metaInfo cres.RDBMSMetaInfo) bool) (*cres.RDBMSInfo, error) {
+	info, err := handler.GetRDBMS(driverIId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkRDBMSOperation(handler, info.DBEngine, operation, supported)
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// getRDBMSIIDInfo returns the IID info of the RDBMS with the given NameId.
+func getRDBMSIIDInfo(connectionName string, rsType string, nameID string) (*RDBMSIIDInfo, error) {
+	var iidInfoList []*RDBMSIIDInfo
+	var err error
+	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+		err = getAuthIIDInfoList(connectionName, &iidInfoList)
+	} else {
+		err = infostore.ListByCondition(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, OneIIdInfo := range iidInfoList {
+		if OneIIdInfo.NameId == nameID {
+			return OneIIdInfo, nil
+		}
+	}
+	return nil, fmt.Errorf("%s '%s' does not exist in connection '%s'", RSTypeString(rsType), nameID, connectionName)
+}
+
+// getRDBMSOwnerVPCIIDInfo returns the IID info of the owner VPC of an RDBMS.
+func getRDBMSOwnerVPCIIDInfo(connectionName string, iidInfo *RDBMSIIDInfo) (*VPCIIDInfo, error) {
+	var vpcIIDInfo VPCIIDInfo
+	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+		var iidInfoList []*VPCIIDInfo
+		err := getAuthIIDInfoList(connectionName, &iidInfoList)
+		if err != nil {
+			return nil, err
+		}
+		castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, iidInfo.OwnerVPCName)
+		if err != nil {
+			return nil, err
+		}
+		vpcIIDInfo = *castedIIDInfo.(*VPCIIDInfo)
+	} else {
+		err := infostore.GetByConditions(&vpcIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, iidInfo.OwnerVPCName)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &vpcIIDInfo, nil
+}
+
+// setRDBMSUserIIDs sets the IIDs of the RDBMS and its VPC, Subnets and SecurityGroups to user IIDs.
+func setRDBMSUserIIDs(connectionName string, iidInfo *RDBMSIIDInfo, info *cres.RDBMSInfo) error {
+	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+
+	vpcIIDInfo, err := getRDBMSOwnerVPCIIDInfo(connectionName, iidInfo)
+	if err != nil {
+		return err
+	}
+	info.VpcIID = getUserIID(cres.IID{NameId: vpcIIDInfo.NameId, SystemId: vpcIIDInfo.SystemId})
+
+	setRDBMSSubnetUserIID(connectionName, *vpcIIDInfo, info)
+	setRDBMSSGUserIID(connectionName, *vpcIIDInfo, info)
+	return nil
+}
+
+// ChangeRDBMSSpec changes the DBInstanceSpec of an RDBMS.
+func ChangeRDBMSSpec(connectionName string, rsType string, nameID string, newSpec string) (*cres.RDBMSInfo, error) {
+	cblog.Info("call ChangeRDBMSSpec()")
+
+	return changeRDBMS(connectionName, rsType, nameID, "newSpec", newSpec, "ChangeSpec",
+		func(metaInfo cres.RDBMSMetaInfo) bool { return metaInfo.SupportsChangeSpec },
+		func(handler cres.RDBMSHandler, driverIId cres.IID, value string) (cres.RDBMSInfo, error) {
+			return handler.ChangeSpec(driverIId, value)
+		})
+}
+
+// ChangeRDBMSStorageSize expands the StorageSize(GB) of an RDBMS.
+func ChangeRDBMSStorageSize(connectionName string, rsType string, nameID string, newSize string) (*cres.RDBMSInfo, error) {
+	cblog.Info("call ChangeRDBMSStorageSize()")
+
+	return changeRDBMS(connectionName, rsType, nameID, "newSize", newSize, "ChangeStorageSize",
+		func(metaInfo cres.RDBMSMetaInfo) bool { return metaInfo.SupportsChangeStorageSize },
+		func(handler cres.RDBMSHandler, driverIId cres.IID, value string) (cres.RDBMSInfo, error) {
+			return handler.ChangeStorageSize(driverIId, value)
+		})
+}
+
+func changeRDBMS(connectionName string, rsType string, nameID string, valueName string, value string,
+	operation string, supported func(metaInfo cres.RDBMSMetaInfo) bool,
+	changeFunc func(handler cres.RDBMSHandler, driverIId cres.IID, value string) (cres.RDBMSInfo, error)) (*cres.RDBMSInfo, error) {
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	nameID, err = EmptyCheckAndTrim("nameID", nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	value, err = EmptyCheckAndTrim(valueName, value)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateRDBMSHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	rdbmsSPLock.Lock(connectionName, nameID)
+	defer rdbmsSPLock.Unlock(connectionName, nameID)
+
+	// (1) get IID(NameId)
+	iidInfo, err := getRDBMSIIDInfo(connectionName, rsType, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	driverIId := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+
+	// (2) check the driver support
+	_, err = checkRDBMSOperationOf(handler, driverIId, operation, supported)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) change resource(SystemId)
+	info, err := changeFunc(handler, driverIId, value)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (4) set ResourceInfo(userIID)
+	err = setRDBMSUserIIDs(connectionName, iidInfo, &info)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// StartRDBMS starts a stopped RDBMS.
+func StartRDBMS(connectionName string, rsType string, nameID string) (bool, error) {
+	cblog.Info("call StartRDBMS()")
+
+	return controlRDBMS(connectionName, rsType, nameID, "StartRDBMS", func(handler cres.RDBMSHandler, driverIId cres.IID) (bool, error) {
+		return handler.StartRDBMS(driverIId)
+	})
+}
+
+// StopRDBMS stops an RDBMS. Some CSPs restart a stopped RDBMS automatically after a while(ex: AWS after 7 days).
+func StopRDBMS(connectionName string, rsType string, nameID string) (bool, error) {
+	cblog.Info("call StopRDBMS()")
+
+	return controlRDBMS(connectionName, rsType, nameID, "StopRDBMS", func(handler cres.RDBMSHandler, driverIId cres.IID) (bool, error) {
+		return handler.StopRDBMS(driverIId)
+	})
+}
+
+func controlRDBMS(connectionName string, rsType string, nameID string, operation string,
+	controlFunc func(handler cres.RDBMSHandler, driverIId cres.IID) (bool, error)) (bool, error) {
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	nameID, err = EmptyCheckAndTrim("nameID", nameID)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	handler, err := cldConn.CreateRDBMSHandler()
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	rdbmsSPLock.Lock(connectionName, nameID)
+	defer rdbmsSPLock.Unlock(connectionName, nameID)
+
+	iidInfo, err := getRDBMSIIDInfo(connectionName, rsType, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	driverIId := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+
+	_, err = checkRDBMSOperationOf(handler, driverIId, operation,
+		func(metaInfo cres.RDBMSMetaInfo) bool { return metaInfo.SupportsStartStop })
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	result, err := controlFunc(handler, driverIId)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	return result, nil
+}
+
+func supportsRDBMSSnapshot(metaInfo cres.RDBMSMetaInfo) bool {
+	return metaInfo.SupportsSnapshot
+}
+
+// getRDBMSSnapshotIIDInfo returns the snapshot's IIDInfo. If rdbmsName is not empty,
+// the snapshot must be a snapshot of the RDBMS.
+// In PERMISSION_BASED_CONTROL_MODE, the snapshots are scoped by the authorized source RDBMS.
+func getRDBMSSnapshotIIDInfo(connectionName string, rdbmsName string, nameID string) (*RDBMSSnapshotIIDInfo, error) {
+	var iidInfo RDBMSSnapshotIIDInfo
+	err := infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, nameID)
+	if err != nil {
+		return nil, fmt.Errorf("%s '%s' does not exist in connection '%s'", RSTypeString(RDBMSSNAPSHOT), nameID, connectionName)
+	}
+	if rdbmsName != "" && iidInfo.SourceRDBMSName != rdbmsName {
+		return nil, fmt.Errorf("%s '%s' of RDBMS '%s': %w", RSTypeString(RDBMSSNAPSHOT), nameID, rdbmsName, ErrNotSnapshotOfRDBMS)
+	}
+	return &iidInfo, nil
+}
+
+// setRDBMSSnapshotUserIIDs sets the IIDs of the snapshot and its source RDBMS to user IIDs.
+// The source RDBMS is the RDBMS recorded at the snapshot creation, it can be deleted after the snapshot is created.
+func setRDBMSSnapshotUserIIDs(iidInfo *RDBMSSnapshotIIDInfo, info *cres.RDBMSSnapshotInfo) {
+	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	info.SourceRDBMS.NameId = iidInfo.SourceRDBMSName
+}
+
+// CreateRDBMSSnapshot takes a manual snapshot of an RDBMS.
+// (1) check exist(NameID)
+// (2) check the source RDBMS and the driver support
+// (3) generate SP-XID and create reqIID, driverIID
+// (4) create Resource
+// (5) insert spiderIID
+// (6) create userIID
+func CreateRDBMSSnapshot(connectionName string, rsType string, nameID string, snapshotName string, IDTransformMode string) (*cres.RDBMSSnapshotInfo, error) {
+	cblog.Info("call CreateRDBMSSnapshot()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	nameID, err = EmptyCheckAndTrim("nameID", nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	snapshotName, err = EmptyCheckAndTrim("snapshotName", snapshotName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateRDBMSHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	rdbmsSPLock.RLock(connectionName, nameID)
+	defer rdbmsSPLock.RUnlock(connectionName, nameID)
+
+	rdbmsSnapshotSPLock.Lock(connectionName, snapshotName)
+	defer rdbmsSnapshotSPLock.Unlock(connectionName, snapshotName)
+
+	// (1) check exist(NameID)
+	bool_ret, err := infostore.HasByConditions(&RDBMSSnapshotIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, snapshotName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if bool_ret {
+		err := fmt.Errorf("%s '%s' already exists in connection '%s'", RSTypeString(RDBMSSNAPSHOT), snapshotName, connectionName)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) check the source RDBMS and the driver support
+	rdbmsIIDInfo, err := getRDBMSIIDInfo(connectionName, rsType, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	rdbmsDriverIId := getDriverIID(cres.IID{NameId: rdbmsIIDInfo.NameId, SystemId: rdbmsIIDInfo.SystemId})
+
+	rdbmsInfo, err := checkRDBMSOperationOf(handler, rdbmsDriverIId, "CreateSnapshot", supportsRDBMSSnapshot)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	spUUID := ""
+	if GetID_MGMT(IDTransformMode) == "ON" {
+		// (3) generate SP-XID and create reqIID, driverIID
+		spUUID, err = iidm.New(connectionName, RDBMSSNAPSHOT, snapshotName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	} else {
+		spUUID = snapshotName
+	}
+
+	// (4) create Resource
+	info, err := handler.CreateSnapshot(rdbmsDriverIId, spUUID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (5) insert spiderIID: {reqNameID, "driverNameID:driverSystemID"}
+	iidInfo := RDBMSSnapshotIIDInfo{ConnectionName: connectionName, NameId: snapshotName, SystemId: spUUID + ":" + info.IId.SystemId,
+		SourceRDBMSName: rdbmsIIDInfo.NameId, SourceDBEngine: rdbmsInfo.DBEngine}
+	err = infostore.Insert(&iidInfo)
+	if err != nil {
+		cblog.Error(err)
+		// rollback
+		_, err2 := handler.DeleteSnapshot(info.IId)
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
+		}
+		return nil, err
+	}
+
+	// (6) create userIID: {reqNameID, driverSystemID}
+	info.SourceRDBMS = getUserIID(cres.IID{NameId: rdbmsIIDInfo.NameId, SystemId: rdbmsIIDInfo.SystemId})
+	setRDBMSSnapshotUserIIDs(&iidInfo, &info)
+
+	return &info, nil
+}
+
+// ListRDBMSSnapshot returns the manual snapshots of an RDBMS registered in Spider.
+// A registered snapshot which does not exist in the CSP is listed with its IID only.
+func ListRDBMSSnapshot(connectionName string, rsType string, nameID string) ([]*cres.RDBMSSnapshotInfo, error) {
+	cblog.Info("call ListRDBMSSnapshot()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	nameID, err = EmptyCheckAndTrim("nameID", nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateRDBMSHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	rdbmsSPLock.RLock(connectionName, nameID)
+	defer rdbmsSPLock.RUnlock(connectionName, nameID)
+
+	// (1) get IID:list
+	rdbmsIIDInfo, err := getRDBMSIIDInfo(connectionName, rsType, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	rdbmsDriverIId := getDriverIID(cres.IID{NameId: rdbmsIIDInfo.NameId, SystemId: rdbmsIIDInfo.SystemId})
+
+	var iidInfoList []*RDBMSSnapshotIIDInfo
+	err = infostore.ListByConditions(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName, SOURCE_RDBMS_NAME_COLUMN, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	infoList := []*cres.RDBMSSnapshotInfo{}
+	if len(iidInfoList) == 0 {
+		return infoList, nil
+	}
+
+	_, err = checkRDBMSOperationOf(handler, rdbmsDriverIId, "ListSnapshot", supportsRDBMSSnapshot)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) get the snapshots of the RDBMS in the CSP
+	driverInfoList, err := handler.ListSnapshot(rdbmsDriverIId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	driverInfoMap := map[string]*cres.RDBMSSnapshotInfo{}
+	for _, driverInfo := range driverInfoList {
+		driverInfoMap[driverInfo.IId.SystemId] = driverInfo
+	}
+
+	// (3) set userIID, and ...
+	rdbmsUserIId := getUserIID(cres.IID{NameId: rdbmsIIDInfo.NameId, SystemId: rdbmsIIDInfo.SystemId})
+	for _, iidInfo := range iidInfoList {
+		info, ok := driverInfoMap[getDriverSystemId(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})]
+		if !ok {
+			cblog.Errorf("%s '%s' does not exist in the CSP", RSTypeString(RDBMSSNAPSHOT), iidInfo.NameId)
+			info = &cres.RDBMSSnapshotInfo{IId: cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}}
+			infoList = append(infoList, info)
+			continue
+		}
+		info.SourceRDBMS = rdbmsUserIId
+		setRDBMSSnapshotUserIIDs(iidInfo, info)
+		infoList = append(infoList, info)
+	}
+
+	return infoList, nil
+}
+
+// DeleteRDBMSSnapshot deletes a manual snapshot registered in Spider.
+// If nameID(RDBMS) is not empty, the snapshot must be a snapshot of the RDBMS.
+// The driver support is checked with the source RDBMS if it still exists.
+// (1) get IID(NameId)
+// (2) delete Resource(SystemId)
+// (3) delete IID
+func DeleteRDBMSSnapshot(connectionName string, rsType string, nameID string, snapshotName string) (bool, error) {
+	cblog.Info("call DeleteRDBMSSnapshot()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	nameID = strings.TrimSpace(nameID)
+
+	snapshotName, err = EmptyCheckAndTrim("snapshotName", snapshotName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	handler, err := cldConn.CreateRDBMSHandler()
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	rdbmsSnapshotSPLock.Lock(connectionName, snapshotName)
+	defer rdbmsSnapshotSPLock.Unlock(connectionName, snapshotName)
+
+	// (1) get IID(NameId)
+	if nameID != "" {
+		_, err = getRDBMSIIDInfo(connectionName, rsType, nameID)
+		if err != nil {
+			cblog.Error(err)
+			return false, err
+		}
+	}
+	iidInfo, err := getRDBMSSnapshotIIDInfo(connectionName, nameID, snapshotName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	var rdbmsIIDInfo RDBMSIIDInfo
+	err = infostore.GetByConditions(&rdbmsIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, iidInfo.SourceRDBMSName)
+	if err == nil {
+		_, err = checkRDBMSOperationOf(handler, getDriverIID(cres.IID{NameId: rdbmsIIDInfo.NameId, SystemId: rdbmsIIDInfo.SystemId}),
+			"DeleteSnapshot", supportsRDBMSSnapshot)
+		if err != nil && !checkNotFoundError(err) {
+			cblog.Error(err)
+			return false, err
+		}
+	}
+
+	// (2) delete Resource(SystemId)
+	result, err := handler.DeleteSnapshot(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	if err != nil {
+		cblog.Error(err)
+		// if not found in CSP, continue
+		if !checkNotFoundError(err) {
+			return false, err
+		}
+		result = true
+	}
+	if !result {
+		return result, nil
+	}
+
+	// (3) delete IID
+	_, err = infostore.DeleteByConditions(&RDBMSSnapshotIIDInfo{}, CONNECTION_NAME_COLUMN, iidInfo.ConnectionName, NAME_ID_COLUMN, iidInfo.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	return result, nil
+}
+
+// RestoreRDBMSSnapshot creates a new RDBMS from a snapshot of an RDBMS.
+// The new RDBMS is placed in the VPC, Subnets and SecurityGroups given by reqInfo, or of the source RDBMS if reqInfo.VpcIID is empty.
+// The source RDBMS can be deleted after the snapshot is taken, then reqInfo.VpcIID and reqInfo.SubnetIIDs are required.
+// reqInfo.IId.NameId is the name of the new RDBMS; reqInfo.DBInstanceSpec is optional.
+// (1) check the snapshot, the source RDBMS and the driver support
+// (2) check exist(NameID)
+// (3) generate SP-XID and create reqIID, driverIID
+// (4) restore Resource
+// (5) insert spiderIID
+// (6) create userIID
+func RestoreRDBMSSnapshot(connectionName string, rsType string, nameID string, snapshotName string, reqInfo cres.RDBMSInfo, IDTransformMode string) (*cres.RDBMSInfo, error) {
+	cblog.Info("call RestoreRDBMSSnapshot()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	nameID, err = EmptyCheckAndTrim("nameID", nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	snapshotName, err = EmptyCheckAndTrim("snapshotName", snapshotName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	reqInfo.IId.NameId, err = EmptyCheckAndTrim("reqInfo.IId.NameId", reqInfo.IId.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if reqInfo.IId.NameId == nameID {
+		err := fmt.Errorf("%s '%s' already exists in connection '%s'", RSTypeString(rsType), reqInfo.IId.NameId, connectionName)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateRDBMSHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	rdbmsSPLock.RLock(connectionName, nameID)
+	defer rdbmsSPLock.RUnlock(connectionName, nameID)
+
+	rdbmsSnapshotSPLock.RLock(connectionName, snapshotName)
+	defer rdbmsSnapshotSPLock.RUnlock(connectionName, snapshotName)
+
+	// (1) check the snapshot, the source RDBMS and the driver support
+	snapshotIIDInfo, err := getRDBMSSnapshotIIDInfo(connectionName, nameID, snapshotName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// the source RDBMS can be deleted after the snapshot is taken
+	var srcInfo *cres.RDBMSInfo
+	srcIIDInfo, err := getRDBMSIIDInfo(connectionName, rsType, nameID)
+	if err == nil {
+		srcInfo, err = checkRDBMSOperationOf(handler, getDriverIID(cres.IID{NameId: srcIIDInfo.NameId, SystemId: srcIIDInfo.SystemId}),
+			"RestoreSnapshot", supportsRDBMSSnapshot)
+		if err != nil && !checkNotFoundError(err) {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+	if srcInfo == nil && snapshotIIDInfo.SourceDBEngine != "" {
+		err = checkRDBMSOperation(handler, snapshotIIDInfo.SourceDBEngine, "RestoreSnapshot", supportsRDBMSSnapshot)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
+	// the network of the new RDBMS: the request, or the source RDBMS
+	restoreReqInfo := cres.RDBMSInfo{
+		VpcIID:            cres.IID{NameId: strings.TrimSpace(reqInfo.VpcIID.NameId)},
+		SubnetIIDs:        reqInfo.SubnetIIDs,
+		SecurityGroupIIDs: reqInfo.SecurityGroupIIDs,
+	}
+	var vpcIIDInfo *VPCIIDInfo
+	if restoreReqInfo.VpcIID.NameId != "" {
+		if len(restoreReqInfo.SubnetIIDs) == 0 {
+			err := fmt.Errorf("%w: the Subnets of VPC '%s' are empty", ErrRDBMSRestoreNetworkRequired, restoreReqInfo.VpcIID.NameId)
+			cblog.Error(err)
+			return nil, err
+		}
+
+		vpcSPLock.RLock(connectionName, restoreReqInfo.VpcIID.NameId)
+		defer vpcSPLock.RUnlock(connectionName, restoreReqInfo.VpcIID.NameId)
+
+		for _, sgIID := range restoreReqInfo.SecurityGroupIIDs {
+			sgSPLock.RLock(connectionName, sgIID.NameId)
+			defer sgSPLock.RUnlock(connectionName, sgIID.NameId)
+		}
+
+		vpcIIDInfo, err = setRDBMSNetworkDriverIIDs(connectionName, &restoreReqInfo)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if srcInfo == nil {
+			err := fmt.Errorf("%w: the source RDBMS '%s' of %s '%s' does not exist", ErrRDBMSRestoreNetworkRequired,
+				nameID, RSTypeString(RDBMSSNAPSHOT), snapshotName)
+			cblog.Error(err)
+			return nil, err
+		}
+
+		vpcIIDInfo, err = getRDBMSOwnerVPCIIDInfo(connectionName, srcIIDInfo)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+
+		vpcSPLock.RLock(connectionName, vpcIIDInfo.NameId)
+		defer vpcSPLock.RUnlock(connectionName, vpcIIDInfo.NameId)
+
+		restoreReqInfo.VpcIID = getDriverIID(cres.IID{NameId: vpcIIDInfo.NameId, SystemId: vpcIIDInfo.SystemId})
+		restoreReqInfo.SubnetIIDs = srcInfo.SubnetIIDs
+		restoreReqInfo.SecurityGroupIIDs = srcInfo.SecurityGroupIIDs
+	}
+
+	rdbmsSPLock.Lock(connectionName, reqInfo.IId.NameId)
+	defer rdbmsSPLock.Unlock(connectionName, reqInfo.IId.NameId)
+
+	// (2) check exist(NameID)
+	var iidInfoList []*RDBMSIIDInfo
+	err = infostore.ListByCondition(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	for _, OneIIdInfo := range iidInfoList {
+		if OneIIdInfo.NameId == reqInfo.IId.NameId {
+			err := fmt.Errorf("%s '%s' already exists in connection '%s'", RSTypeString(rsType), reqInfo.IId.NameId, connectionName)
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
+	spUUID := ""
+	if GetID_MGMT(IDTransformMode) == "ON" {
+		// (3) generate SP-XID and create reqIID, driverIID
+		spUUID, err = iidm.New(connectionName, rsType, reqInfo.IId.NameId)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	} else {
+		spUUID = reqInfo.IId.NameId
+	}
+
+	// reqIID
+	reqIId := cres.IID{NameId: reqInfo.IId.NameId, SystemId: spUUID}
+
+	restoreReqInfo.IId = cres.IID{NameId: spUUID, SystemId: ""}
+	restoreReqInfo.DBInstanceSpec = strings.TrimSpace(reqInfo.DBInstanceSpec)
+	restoreReqInfo.TagList = reqInfo.TagList
+	// the new RDBMS inherits the options of the source RDBMS if it exists
+	if srcInfo != nil {
+		restoreReqInfo.HighAvailability = srcInfo.HighAvailability
+		restoreReqInfo.PublicAccess = srcInfo.PublicAccess
+		restoreReqInfo.DeletionProtection = srcInfo.DeletionProtection
+	}
+
+	// (4) restore Resource
+	info, err := handler.RestoreSnapshot(getDriverIID(cres.IID{NameId: snapshotIIDInfo.NameId, SystemId: snapshotIIDInfo.SystemId}), restoreReqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (5) insert spiderIID: {reqNameID, "driverNameID:driverSystemID"}
+	iidInfo := RDBMSIIDInfo{ConnectionName: connectionName, NameId: reqIId.NameId, SystemId: spUUID + ":" + info.IId.SystemId,
+		OwnerVPCName: vpcIIDInfo.NameId}
+	err = infostore.Insert(&iidInfo)
+	if err != nil {
+		cblog.Error(err)
+		// rollback
+		_, err2 := handler.DeleteRDBMS(info.IId)
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
+		}
+		return nil, err
+	}
+
+	// (6) create userIID: {reqNameID, driverSystemID}
+	err = setRDBMSUserIIDs(connectionName, &iidInfo, &info)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &info, nil
+}
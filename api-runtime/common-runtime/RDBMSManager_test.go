@@ -0,0 +1,67 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"errors"
+	"testing"
+
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// metaInfoRDBMSHandler returns the meta info and records the requested DBEngine,
+// the other methods of cres.RDBMSHandler are not used.
+type metaInfoRDBMSHandler struct {
+	cres.RDBMSHandler
+	metaInfo        cres.RDBMSMetaInfo
+	requestedEngine string
+}
+
+func (handler *metaInfoRDBMSHandler) GetMetaInfo(dbEngine string) (cres.RDBMSMetaInfo, error) {
+	handler.requestedEngine = dbEngine
+	if _, err := cres.NormalizeRDBMSEngine(dbEngine); err != nil {
+		return cres.RDBMSMetaInfo{}, err
+	}
+	return handler.metaInfo, nil
+}
+
+func TestCheckRDBMSOperation(t *testing.T) {
+	testList := []struct {
+		dbEngine        string
+		metaInfo        cres.RDBMSMetaInfo
+		requestedEngine string
+		notSupported    bool
+	}{
+		{"mysql", cres.RDBMSMetaInfo{SupportsSnapshot: true}, "mysql", false},
+		{"mysql", cres.RDBMSMetaInfo{SupportsStartStop: true}, "mysql", true},
+		{"MariaDB", cres.RDBMSMetaInfo{SupportsSnapshot: true}, "mariadb", false},
+		{"postgres", cres.RDBMSMetaInfo{SupportsSnapshot: true}, "postgresql", false}, // AWS
+		{"postgresql", cres.RDBMSMetaInfo{}, "postgresql", true},
+	}
+
+	for _, test := range testList {
+		handler := &metaInfoRDBMSHandler{metaInfo: test.metaInfo}
+		err := checkRDBMSOperation(handler, test.dbEngine, "CreateSnapshot", supportsRDBMSSnapshot)
+		if handler.requestedEngine != test.requestedEngine {
+			t.Errorf("%s: requested DBEngine %s is not same %s", test.dbEngine, handler.requestedEngine, test.requestedEngine)
+		}
+		if test.notSupported != errors.Is(err, ErrRDBMSOperationNotSupported) {
+			t.Errorf("%s: %+v: unexpected error: %v", test.dbEngine, test.metaInfo, err)
+		}
+		if !test.notSupported && err != nil {
+			t.Errorf("%s: %v", test.dbEngine, err)
+		}
+	}
+
+	// the error of the meta info is not a support error
+	err := checkRDBMSOperation(&metaInfoRDBMSHandler{}, "oracle", "CreateSnapshot", supportsRDBMSSnapshot)
+	if err == nil || errors.Is(err, ErrRDBMSOperationNotSupported) {
+		t.Errorf("oracle: unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,666 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// ====================================================================
+// type for GORM
+
+type RouteTableIIDInfo VPCDependentIIDInfo
+
+func (RouteTableIIDInfo) TableName() string {
+	return "routetable_iid_infos"
+}
+
+//====================================================================
+
+func init() {
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	db.AutoMigrate(&RouteTableIIDInfo{})
+	infostore.Close(db)
+}
+
+//================ Route Table Handler
+
+// (1) check capability and exist
+// (2) get the driver IIDs of the VPC and the route targets
+// (3) generate SP-XID
+// (4) create Resource
+// (5) insert spiderIID
+// (6) return userIID
+func CreateRouteTable(connectionName string, rsType string, reqInfo cres.RouteTableReqInfo, IDTransformMode string) (*cres.RouteTableInfo, error) {
+	cblog.Info("call CreateRouteTable()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if reqInfo.IId.NameId == "" || reqInfo.VpcIID.NameId == "" {
+		err := fmt.Errorf("RouteTable Name and VPC Name are required!")
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (1) check capability and exist
+	if err := checkCapability(connectionName, ROUTE_TABLE_HANDLER); err != nil {
+		return nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateRouteTableHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	vpcName := reqInfo.VpcIID.NameId
+
+	rUnlockTargets := rLockRouteTargets(connectionName, reqInfo.RouteList)
+	defer rUnlockTargets()
+
+	routeTableSPLock.Lock(connectionName, reqInfo.IId.NameId)
+	defer routeTableSPLock.Unlock(connectionName, reqInfo.IId.NameId)
+
+	vpcSPLock.RLock(connectionName, vpcName)
+	defer vpcSPLock.RUnlock(connectionName, vpcName)
+
+	bool_ret, err := infostore.HasByConditions(&RouteTableIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, reqInfo.IId.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if bool_ret {
+		err := fmt.Errorf("%s '%s' already exists in connection '%s'", RSTypeString(rsType), reqInfo.IId.NameId, connectionName)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) get the driver IIDs of the VPC and the route targets
+	var vpcIIDInfo VPCIIDInfo
+	if err = infostore.GetByConditions(&vpcIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, vpcName); err != nil {
+		cblog.Error(err)
+		return nil, fmt.Errorf("VPC '%s' not found in connection '%s'", vpcName, connectionName)
+	}
+	reqInfo.VpcIID = getDriverIID(cres.IID{NameId: vpcIIDInfo.NameId, SystemId: vpcIIDInfo.SystemId})
+
+	err = setRouteTargetDriverIIDs(connectionName, vpcName, reqInfo.RouteList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) generate SP-XID
+	spUUID := ""
+	if GetID_MGMT(IDTransformMode) == "ON" {
+		spUUID, err = iidm.New(connectionName, rsType, reqInfo.IId.NameId)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	} else {
+		spUUID = reqInfo.IId.NameId
+	}
+
+	reqIId := cres.IID{NameId: reqInfo.IId.NameId, SystemId: spUUID}
+	reqInfo.IId = cres.IID{NameId: spUUID, SystemId: ""}
+
+	// (4) create Resource
+	info, err := handler.CreateRouteTable(reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (5) insert spiderIID
+	spiderIId := cres.IID{NameId: reqIId.NameId, SystemId: spUUID + ":" + info.IId.SystemId}
+	err = infostore.Insert(&RouteTableIIDInfo{ConnectionName: connectionName, NameId: spiderIId.NameId, SystemId: spiderIId.SystemId,
+		OwnerVPCName: vpcName})
+	if err != nil {
+		cblog.Error(err)
+		// rollback
+		_, err2 := handler.DeleteRouteTable(info.IId)
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf("%v, %v", err, err2)
+		}
+		return nil, err
+	}
+
+	// (6) return userIID
+	info.IId = getUserIID(spiderIId)
+	resolveRouteTableRelatedIIDs(connectionName, &info)
+	return &info, nil
+}
+
+func ListRouteTable(connectionName string, rsType string) ([]*cres.RouteTableInfo, error) {
+	cblog.Info("call ListRouteTable()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateRouteTableHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var iidInfoList []*RouteTableIIDInfo
+	err = infostore.ListByCondition(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	infoList := []*cres.RouteTableInfo{}
+	for _, iidInfo := range iidInfoList {
+		routeTableSPLock.RLock(connectionName, iidInfo.NameId)
+		info, err := handler.GetRouteTable(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+		routeTableSPLock.RUnlock(connectionName, iidInfo.NameId)
+		if err != nil {
+			cblog.Error(err)
+			info = cres.RouteTableInfo{IId: getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}),
+				VpcIID: cres.IID{NameId: iidInfo.OwnerVPCName}, Status: cres.RouteTableNotFound, RouteList: []cres.RouteInfo{}}
+			infoList = append(infoList, &info)
+			continue
+		}
+		info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+		resolveRouteTableRelatedIIDs(connectionName, &info)
+		infoList = append(infoList, &info)
+	}
+	return infoList, nil
+}
+
+func GetRouteTable(connectionName string, rsType string, nameID string) (*cres.RouteTableInfo, error) {
+	cblog.Info("call GetRouteTable()")
+
+	handler, iidInfo, err := getRouteTableHandlerAndIIDInfo(connectionName, nameID)
+	if err != nil {
+		return nil, err
+	}
+
+	routeTableSPLock.RLock(connectionName, nameID)
+	defer routeTableSPLock.RUnlock(connectionName, nameID)
+
+	info, err := handler.GetRouteTable(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	resolveRouteTableRelatedIIDs(connectionName, &info)
+	return &info, nil
+}
+
+func DeleteRouteTable(connectionName string, rsType string, nameID string, force string) (bool, error) {
+	cblog.Info("call DeleteRouteTable()")
+
+	handler, iidInfo, err := getRouteTableHandlerAndIIDInfo(connectionName, nameID)
+	if err != nil {
+		return false, err
+	}
+
+	routeTableSPLock.Lock(connectionName, nameID)
+	defer routeTableSPLock.Unlock(connectionName, nameID)
+
+	driverIID := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+
+	// a route table associated with subnets can not be deleted in the CSPs,
+	// the subnets are returned to the default route table of the VPC.
+	info, err := handler.GetRouteTable(driverIID)
+	if err == nil {
+		for _, subnetIID := range info.SubnetIIDs {
+			_, err = handler.DisassociateSubnet(driverIID, subnetIID)
+			if err != nil {
+				cblog.Error(err)
+				if force != "true" {
+					return false, err
+				}
+			}
+		}
+	}
+
+	result, err := handler.DeleteRouteTable(driverIID)
+	if err != nil {
+		cblog.Error(err)
+		if force != "true" {
+			return false, err
+		}
+	}
+	if force != "true" && !result {
+		return false, nil
+	}
+
+	_, err = infostore.DeleteByConditions(&RouteTableIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, iidInfo.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// AddRouteTableRoutes adds the routes into a route table.
+func AddRouteTableRoutes(connectionName string, nameID string, routeList []cres.RouteInfo) (*cres.RouteTableInfo, error) {
+	cblog.Info("call AddRouteTableRoutes()")
+
+	handler, iidInfo, err := getRouteTableHandlerAndIIDInfo(connectionName, nameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(routeList) == 0 {
+		err := fmt.Errorf("the Route list is empty")
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// the targets are not deleted while adding the routes
+	rUnlockTargets := rLockRouteTargets(connectionName, routeList)
+	defer rUnlockTargets()
+
+	routeTableSPLock.Lock(connectionName, nameID)
+	defer routeTableSPLock.Unlock(connectionName, nameID)
+
+	err = setRouteTargetDriverIIDs(connectionName, iidInfo.OwnerVPCName, routeList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	info, err := handler.AddRoutes(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}), routeList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	resolveRouteTableRelatedIIDs(connectionName, &info)
+	return &info, nil
+}
+
+// RemoveRouteTableRoutes removes the routes to the destination CIDRs from a route table.
+func RemoveRouteTableRoutes(connectionName string, nameID string, destinationCIDRs []string) (bool, error) {
+	cblog.Info("call RemoveRouteTableRoutes()")
+
+	handler, iidInfo, err := getRouteTableHandlerAndIIDInfo(connectionName, nameID)
+	if err != nil {
+		return false, err
+	}
+
+	if len(destinationCIDRs) == 0 {
+		err := fmt.Errorf("the DestinationCIDR list is empty")
+		cblog.Error(err)
+		return false, err
+	}
+
+	routeList := []cres.RouteInfo{}
+	for _, cidr := range destinationCIDRs {
+		cidr = strings.TrimSpace(cidr)
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			cblog.Error(err)
+			return false, fmt.Errorf("invalid DestinationCIDR: %s", cidr)
+		}
+		routeList = append(routeList, cres.RouteInfo{DestinationCIDR: cidr})
+	}
+
+	routeTableSPLock.Lock(connectionName, nameID)
+	defer routeTableSPLock.Unlock(connectionName, nameID)
+
+	result, err := handler.RemoveRoutes(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}), routeList)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	return result, nil
+}
+
+// AssociateRouteTableSubnet associates a subnet of the owner VPC with a route table.
+func AssociateRouteTableSubnet(connectionName string, nameID string, subnetName string) (*cres.RouteTableInfo, error) {
+	cblog.Info("call AssociateRouteTableSubnet()")
+
+	handler, iidInfo, err := getRouteTableHandlerAndIIDInfo(connectionName, nameID)
+	if err != nil {
+		return nil, err
+	}
+
+	routeTableSPLock.Lock(connectionName, nameID)
+	defer routeTableSPLock.Unlock(connectionName, nameID)
+
+	subnetIID, err := getRouteTableSubnetDriverIID(connectionName, iidInfo.OwnerVPCName, subnetName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	info, err := handler.AssociateSubnet(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}), subnetIID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	resolveRouteTableRelatedIIDs(connectionName, &info)
+	return &info, nil
+}
+
+// DisassociateRouteTableSubnet makes a subnet use the default route table of the VPC.
+func DisassociateRouteTableSubnet(connectionName string, nameID string, subnetName string) (bool, error) {
+	cblog.Info("call DisassociateRouteTableSubnet()")
+
+	handler, iidInfo, err := getRouteTableHandlerAndIIDInfo(connectionName, nameID)
+	if err != nil {
+		return false, err
+	}
+
+	routeTableSPLock.Lock(connectionName, nameID)
+	defer routeTableSPLock.Unlock(connectionName, nameID)
+
+	subnetIID, err := getRouteTableSubnetDriverIID(connectionName, iidInfo.OwnerVPCName, subnetName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	result, err := handler.DisassociateSubnet(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}), subnetIID)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	return result, nil
+}
+
+func CountAllRouteTables() (int64, error) {
+	var info RouteTableIIDInfo
+	count, err := infostore.CountAllNameIDs(&info)
+	if err != nil {
+		cblog.Error(err)
+		return count, err
+	}
+	return count, nil
+}
+
+func CountRouteTablesByConnection(connectionName string) (int64, error) {
+	var info RouteTableIIDInfo
+	count, err := infostore.CountNameIDsByConnection(&info, connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return count, err
+	}
+	return count, nil
+}
+
+func getRouteTableHandlerAndIIDInfo(connectionName string, nameID string) (cres.RouteTableHandler, *RouteTableIIDInfo, error) {
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, nil, err
+	}
+
+	nameID, err = EmptyCheckAndTrim("nameID", nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, nil, err
+	}
+
+	handler, err := cldConn.CreateRouteTableHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, nil, err
+	}
+
+	var iidInfo RouteTableIIDInfo
+	err = infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, nil, fmt.Errorf("%s '%s' not found in connection '%s': %v", RSTypeString(ROUTETABLE), nameID, connectionName, err)
+	}
+	return handler, &iidInfo, nil
+}
+
+func getRouteTableSubnetDriverIID(connectionName string, vpcName string, subnetName string) (cres.IID, error) {
+	subnetName, err := EmptyCheckAndTrim("subnetName", subnetName)
+	if err != nil {
+		return cres.IID{}, err
+	}
+
+	var subnetIIDInfo SubnetIIDInfo
+	err = infostore.GetBy3Conditions(&subnetIIDInfo, CONNECTION_NAME_COLUMN, connectionName, OWNER_VPC_NAME_COLUMN, vpcName, NAME_ID_COLUMN, subnetName)
+	if err != nil {
+		return cres.IID{}, fmt.Errorf("Subnet '%s' not found in VPC '%s'", subnetName, vpcName)
+	}
+	return getDriverIID(cres.IID{NameId: subnetIIDInfo.NameId, SystemId: subnetIIDInfo.SystemId}), nil
+}
+
+// setRouteTargetDriverIIDs checks the routes and sets the driver IIDs of the route targets given by NameId.
+// The NATGateway and the NIC must be in the VPC of the route table, and the VPCPeering must be a peering of the VPC.
+func setRouteTargetDriverIIDs(connectionName string, vpcName string, routeList []cres.RouteInfo) error {
+	for i := range routeList {
+		route := &routeList[i]
+		route.DestinationCIDR = strings.TrimSpace(route.DestinationCIDR)
+		if _, _, err := net.ParseCIDR(route.DestinationCIDR); err != nil {
+			return fmt.Errorf("invalid DestinationCIDR: %s", route.DestinationCIDR)
+		}
+
+		targetName := strings.TrimSpace(route.TargetIID.NameId)
+		if route.TargetType != cres.RouteTargetInternetGateway && targetName == "" {
+			return fmt.Errorf("the target of the route to %s is empty", route.DestinationCIDR)
+		}
+
+		var err error
+		inVPC := true
+		switch route.TargetType {
+		case cres.RouteTargetInternetGateway:
+			route.TargetIID = cres.IID{}
+		case cres.RouteTargetNATGateway:
+			var iidInfo NATGatewayIIDInfo
+			if err = infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, targetName); err == nil {
+				route.TargetIID = getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+				inVPC = iidInfo.OwnerVPCName == vpcName
+			}
+		case cres.RouteTargetNIC:
+			var iidInfo NICIIDInfo
+			if err = infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, targetName); err == nil {
+				route.TargetIID = getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+				nicInfo, err2 := GetNIC(connectionName, NIC, targetName)
+				if err2 != nil {
+					return fmt.Errorf("failed to get %s '%s' of the route to %s: %v", route.TargetType, targetName, route.DestinationCIDR, err2)
+				}
+				inVPC = nicInfo.VpcIID.NameId == vpcName
+			}
+		case cres.RouteTargetVPCPeering:
+			var iidInfo VPCPeeringIIDInfo
+			if err = infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, targetName); err == nil {
+				route.TargetIID = getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+				inVPC = iidInfo.RequesterVpcName == vpcName || iidInfo.AccepterVpcName == vpcName
+			}
+		default:
+			return fmt.Errorf("%s is not a supported route target type, use one of %s, %s, %s and %s", route.TargetType,
+				cres.RouteTargetInternetGateway, cres.RouteTargetNATGateway, cres.RouteTargetNIC, cres.RouteTargetVPCPeering)
+		}
+		if err != nil {
+			return fmt.Errorf("%s '%s' of the route to %s not found in connection '%s'", route.TargetType, targetName, route.DestinationCIDR, connectionName)
+		}
+		if !inVPC {
+			return fmt.Errorf("%s '%s' of the route to %s does not belong to VPC '%s'", route.TargetType, targetName, route.DestinationCIDR, vpcName)
+		}
+	}
+	return nil
+}
+
+// rLockRouteTargets read-locks the NATGateways and the NICs of the routes and returns the function to unlock them.
+// The targets are locked before the route table, in the same order as DeleteNATGateway and DeleteNIC.
+func rLockRouteTargets(connectionName string, routeList []cres.RouteInfo) func() {
+	natGatewayNameMap := map[string]bool{}
+	nicNameMap := map[string]bool{}
+	for _, route := range routeList {
+		targetName := strings.TrimSpace(route.TargetIID.NameId)
+		if targetName == "" {
+			continue
+		}
+		switch route.TargetType {
+		case cres.RouteTargetNATGateway:
+			natGatewayNameMap[targetName] = true
+		case cres.RouteTargetNIC:
+			nicNameMap[targetName] = true
+		}
+	}
+	// a name is locked once, in the sorted order
+	natGatewayNames := []string{}
+	for name := range natGatewayNameMap {
+		natGatewayNames = append(natGatewayNames, name)
+	}
+	sort.Strings(natGatewayNames)
+	nicNames := []string{}
+	for name := range nicNameMap {
+		nicNames = append(nicNames, name)
+	}
+	sort.Strings(nicNames)
+
+	for _, name := range natGatewayNames {
+		natGatewaySPLock.RLock(connectionName, name)
+	}
+	for _, name := range nicNames {
+		nicSPLock.RLock(connectionName, name)
+	}
+	return func() {
+		for _, name := range nicNames {
+			nicSPLock.RUnlock(connectionName, name)
+		}
+		for _, name := range natGatewayNames {
+			natGatewaySPLock.RUnlock(connectionName, name)
+		}
+	}
+}
+
+// getRouteTableNamesUsingTarget returns the names of the route tables in the VPC with a route to the target.
+// A route table not found in the CSP is skipped.
+func getRouteTableNamesUsingTarget(connectionName string, vpcName string, targetType cres.RouteTargetType, targetDriverIID cres.IID) ([]string, error) {
+	var iidInfoList []*RouteTableIIDInfo
+	err := infostore.ListByConditions(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName, OWNER_VPC_NAME_COLUMN, vpcName)
+	if err != nil {
+		return nil, err
+	}
+	nameList := []string{}
+	if len(iidInfoList) == 0 {
+		return nameList, nil
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		return nil, err
+	}
+	handler, err := cldConn.CreateRouteTableHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, iidInfo := range iidInfoList {
+		routeTableSPLock.RLock(connectionName, iidInfo.NameId)
+		info, err := handler.GetRouteTable(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+		routeTableSPLock.RUnlock(connectionName, iidInfo.NameId)
+		if err != nil {
+			if checkNotFoundError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get %s '%s': %v", RSTypeString(ROUTETABLE), iidInfo.NameId, err)
+		}
+		for _, route := range info.RouteList {
+			if route.TargetType == targetType && route.TargetIID.SystemId == targetDriverIID.SystemId {
+				nameList = append(nameList, iidInfo.NameId)
+				break
+			}
+		}
+	}
+	return nameList, nil
+}
+
+// resolveRouteTableRelatedIIDs resolves the NameIds of the VPC, the route targets and the Subnets from the CSP SystemIds.
+func resolveRouteTableRelatedIIDs(connectionName string, info *cres.RouteTableInfo) {
+	if info.VpcIID.SystemId != "" {
+		var vpcInfo VPCIIDInfo
+		if err := infostore.GetByContain(&vpcInfo, CONNECTION_NAME_COLUMN, connectionName, SYSTEM_ID_COLUMN, info.VpcIID.SystemId); err == nil {
+			info.VpcIID.NameId = vpcInfo.NameId
+		}
+	}
+
+	for i, route := range info.RouteList {
+		systemId := route.TargetIID.SystemId
+		if systemId == "" {
+			continue
+		}
+		var err error
+		nameId := ""
+		switch route.TargetType {
+		case cres.RouteTargetNATGateway:
+			var iidInfo NATGatewayIIDInfo
+			err = infostore.GetByContain(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, SYSTEM_ID_COLUMN, systemId)
+			nameId = iidInfo.NameId
+		case cres.RouteTargetNIC:
+			var iidInfo NICIIDInfo
+			err = infostore.GetByContain(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, SYSTEM_ID_COLUMN, systemId)
+			nameId = iidInfo.NameId
+		case cres.RouteTargetVPCPeering:
+			var iidInfo VPCPeeringIIDInfo
+			err = infostore.GetByContain(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, SYSTEM_ID_COLUMN, systemId)
+			nameId = iidInfo.NameId
+		default:
+			continue
+		}
+		if err == nil {
+			info.RouteList[i].TargetIID.NameId = nameId
+		}
+	}
+
+	for i, subnetIID := range info.SubnetIIDs {
+		if subnetIID.SystemId == "" {
+			continue
+		}
+		var subnetInfo SubnetIIDInfo
+		if err := infostore.GetByContain(&subnetInfo, CONNECTION_NAME_COLUMN, connectionName, SYSTEM_ID_COLUMN, subnetIID.SystemId); err == nil {
+			info.SubnetIIDs[i].NameId = subnetInfo.NameId
+		}
+	}
+}
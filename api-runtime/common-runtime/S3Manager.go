@@ -323,6 +323,12 @@ func NewS3ClientForBucketCreation(connInfo *S3ConnectionInfo) (*minio.Client, er
 }
 
 func CreateS3Bucket(connectionName, bucketName string) (*minio.BucketInfo, error) {
+	return CreateS3BucketWithOptions(connectionName, bucketName, nil)
+}
+
+// CreateS3BucketWithOptions creates a bucket with optional default encryption and object lock.
+// Options not supported by the provider fail before the bucket is created.
+func CreateS3BucketWithOptions(connectionName, bucketName string, opts *S3BucketCreateOptions) (*minio.BucketInfo, error) {
 	cblog.Info("call CreateS3Bucket()")
 
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
@@ -347,6 +353,12 @@ func CreateS3Bucket(connectionName, bucketName string) (*minio.BucketInfo, error
 
 	cblog.Infof("CreateS3Bucket: Provider=%s, AppId='%s', BucketName=%s", connInfo.ProviderName, connInfo.AppId, bucketName)
 
+	if err := checkS3BucketCreateOptions(connectionName, connInfo, opts); err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	objectLocking := opts != nil && opts.ObjectLock
+
 	// Azure: use Azure Blob SDK instead of minio
 	if connInfo.ProviderName == "AZURE" {
 		result, err := createAzureBucket(connectionName, bucketName, connInfo)
@@ -404,10 +416,10 @@ func CreateS3Bucket(connectionName, bucketName string) (*minio.BucketInfo, error
 		if connInfo.Region == "" {
 			return nil, fmt.Errorf("Region is required for S3 connection %s", connectionName)
 		} else {
-			err = client.MakeBucket(ctx, bucketName, minio.MakeBucketOptions{Region: connInfo.Region})
+			err = client.MakeBucket(ctx, bucketName, minio.MakeBucketOptions{Region: connInfo.Region, ObjectLocking: objectLocking})
 		}
 	} else {
-		err = client.MakeBucket(ctx, bucketName, minio.MakeBucketOptions{})
+		err = client.MakeBucket(ctx, bucketName, minio.MakeBucketOptions{ObjectLocking: objectLocking})
 	}
 	if err != nil {
 		cblog.Error(err)
@@ -425,6 +437,15 @@ func CreateS3Bucket(connectionName, bucketName string) (*minio.BucketInfo, error
 		_ = client.RemoveBucket(ctx, bucketName)
 		return nil, err
 	}
+	if opts != nil && opts.Encryption != "" {
+		_, err = SetS3BucketEncryption(connectionName, originalBucketName, opts.Encryption, opts.KMSKeyID)
+		if err != nil {
+			cblog.Error(err)
+			_ = client.RemoveBucket(ctx, bucketName)
+			_, _ = infostore.DeleteByConditions(&S3BucketIIDInfo{}, "connection_name", connectionName, "name_id", originalBucketName)
+			return nil, fmt.Errorf("failed to set default encryption, bucket creation is rolled back: %w", err)
+		}
+	}
 	buckets, err := client.ListBuckets(ctx)
 	if err != nil {
 		return nil, err
@@ -1434,6 +1455,12 @@ func GetS3ObjectStreamWithVersion(connectionName, bucketName, objectName, versio
 
 func PutS3ObjectFromReader(connectionName string, bucketName string, objectName string, reader io.Reader, objectSize int64) (minio.UploadInfo, error) {
 	cblog.Info("call PutS3ObjectFromReader()")
+	return putS3ObjectFromReader(connectionName, bucketName, objectName, reader, objectSize, nil)
+}
+
+// putS3ObjectFromReader uploads an object with the Content-Type and user metadata of metadata.
+// A nil metadata uploads an application/octet-stream object without user metadata.
+func putS3ObjectFromReader(connectionName string, bucketName string, objectName string, reader io.Reader, objectSize int64, metadata *S3CopyMetadata) (minio.UploadInfo, error) {
 
 	var iidInfo S3BucketIIDInfo
 	err := infostore.GetByConditions(&iidInfo, "connection_name", connectionName, "name_id", bucketName)
@@ -1448,7 +1475,7 @@ func PutS3ObjectFromReader(connectionName string, bucketName string, objectName
 
 	// Azure: use Azure Blob SDK
 	if connInfo.ProviderName == "AZURE" {
-		return putAzureObject(connInfo, iidInfo.SystemId, objectName, reader, objectSize)
+		return putAzureObject(connInfo, iidInfo.SystemId, objectName, reader, objectSize, metadata)
 	}
 
 	client, err := NewS3Client(connInfo)
@@ -1458,7 +1485,6 @@ func PutS3ObjectFromReader(connectionName string, bucketName string, objectName
 
 	ctx, cancel := context.WithTimeout(context.Background(), 1800*time.Second)
 	defer cancel()
-	contentType := "application/octet-stream"
 
 	info, err := client.PutObject(
 		ctx,
@@ -1466,7 +1492,7 @@ func PutS3ObjectFromReader(connectionName string, bucketName string, objectName
 		objectName,
 		reader,
 		objectSize,
-		minio.PutObjectOptions{ContentType: contentType},
+		s3PutObjectOptions(metadata),
 	)
 
 	if err != nil {
@@ -1500,8 +1526,26 @@ type DeleteResult struct {
 	Error   string
 }
 
+// s3PutObjectOptions returns the upload options with the Content-Type and user metadata of metadata.
+func s3PutObjectOptions(metadata *S3CopyMetadata) minio.PutObjectOptions {
+	opts := minio.PutObjectOptions{ContentType: "application/octet-stream"}
+	if metadata == nil {
+		return opts
+	}
+	if metadata.ContentType != "" {
+		opts.ContentType = metadata.ContentType
+	}
+	opts.UserMetadata = metadata.UserMetadata
+	return opts
+}
+
 func InitiateMultipartUpload(connectionName string, bucketName string, objectName string) (string, error) {
 	cblog.Info("call InitiateMultipartUpload()")
+	return initiateMultipartUpload(connectionName, bucketName, objectName, nil)
+}
+
+// initiateMultipartUpload starts a multipart upload of an object with the Content-Type and user metadata of metadata.
+func initiateMultipartUpload(connectionName string, bucketName string, objectName string, metadata *S3CopyMetadata) (string, error) {
 
 	var iidInfo S3BucketIIDInfo
 	err := infostore.GetByConditions(&iidInfo, "connection_name", connectionName, "name_id", bucketName)
@@ -1535,7 +1579,11 @@ func InitiateMultipartUpload(connectionName string, bucketName string, objectNam
 	defer cancel()
 
 	core := minio.Core{Client: client}
-	uploadID, err := core.NewMultipartUpload(ctx, iidInfo.SystemId, objectName, minio.PutObjectOptions{})
+	var opts minio.PutObjectOptions
+	if metadata != nil {
+		opts = s3PutObjectOptions(metadata)
+	}
+	uploadID, err := core.NewMultipartUpload(ctx, iidInfo.SystemId, objectName, opts)
 	if err != nil {
 		cblog.Errorf("Failed to initiate multipart upload for provider %s: %v", connInfo.ProviderName, err)
 		if ctx.Err() == context.DeadlineExceeded {
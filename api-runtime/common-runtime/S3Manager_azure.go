@@ -429,6 +429,7 @@ func getAzureObjectInfo(connInfo *S3ConnectionInfo, bucketName, objectName strin
 	if props.ContentType != nil {
 		info.ContentType = *props.ContentType
 	}
+	info.UserMetadata = azureUserMetadata(props.Metadata)
 	if props.VersionID != nil {
 		info.VersionID = *props.VersionID
 	}
@@ -476,6 +477,7 @@ func getAzureObjectInfoWithVersion(connInfo *S3ConnectionInfo, bucketName, objec
 	if props.ContentType != nil {
 		info.ContentType = *props.ContentType
 	}
+	info.UserMetadata = azureUserMetadata(props.Metadata)
 
 	return info, nil
 }
@@ -633,7 +635,21 @@ func getAzureObjectStreamWithVersion(connInfo *S3ConnectionInfo, bucketName, obj
 	return resp.Body, nil
 }
 
-func putAzureObject(connInfo *S3ConnectionInfo, bucketName, objectName string, reader io.Reader, objectSize int64) (minio.UploadInfo, error) {
+// azureUserMetadata converts the metadata of a blob to the user metadata of an object.
+func azureUserMetadata(metadata map[string]*string) minio.StringMap {
+	if len(metadata) == 0 {
+		return nil
+	}
+	userMetadata := make(minio.StringMap, len(metadata))
+	for key, value := range metadata {
+		if value != nil {
+			userMetadata[key] = *value
+		}
+	}
+	return userMetadata
+}
+
+func putAzureObject(connInfo *S3ConnectionInfo, bucketName, objectName string, reader io.Reader, objectSize int64, metadata *S3CopyMetadata) (minio.UploadInfo, error) {
 	cblog.Infof("putAzureObject: Uploading blob '%s' to container '%s' (size: %d)", objectName, bucketName, objectSize)
 
 	client, _, err := newAzureBlobClient(connInfo)
@@ -641,8 +657,23 @@ func putAzureObject(connInfo *S3ConnectionInfo, bucketName, objectName string, r
 		return minio.UploadInfo{}, err
 	}
 
+	// Content-Type and user metadata of the blob
+	var uploadOpts *azblob.UploadStreamOptions
+	if metadata != nil {
+		uploadOpts = &azblob.UploadStreamOptions{}
+		if metadata.ContentType != "" {
+			uploadOpts.HTTPHeaders = &blob.HTTPHeaders{BlobContentType: to.Ptr(metadata.ContentType)}
+		}
+		if len(metadata.UserMetadata) > 0 {
+			uploadOpts.Metadata = make(map[string]*string, len(metadata.UserMetadata))
+			for key, value := range metadata.UserMetadata {
+				uploadOpts.Metadata[key] = to.Ptr(value)
+			}
+		}
+	}
+
 	ctx := context.Background()
-	resp, err := client.UploadStream(ctx, bucketName, objectName, reader, nil)
+	resp, err := client.UploadStream(ctx, bucketName, objectName, reader, uploadOpts)
 	if err != nil {
 		return minio.UploadInfo{}, fmt.Errorf("failed to upload blob '%s/%s': %w", bucketName, objectName, err)
 	}
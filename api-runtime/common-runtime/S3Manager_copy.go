@@ -0,0 +1,355 @@
+// Server-side object copy (CopyObject, UploadPartCopy) and
+// cross-connection object copy for S3 operations.
+// by CB-Spider Team
+
+package commonruntime
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+const (
+	// s3MaxSingleCopySize is the largest object copied with a single CopyObject call (5GiB).
+	s3MaxSingleCopySize = int64(5 * 1024 * 1024 * 1024)
+	// s3StreamCopyPartSize is the part size of a cross-connection copy.
+	s3StreamCopyPartSize = int64(64 * 1024 * 1024)
+	// s3MaxPartsCount is the maximum number of parts of a multipart upload.
+	s3MaxPartsCount = int64(10000)
+)
+
+// ErrNoSuchS3Bucket is returned when a bucket is not registered in the connection.
+var ErrNoSuchS3Bucket = fmt.Errorf("bucket does not exist")
+
+// getS3BucketIIDInfo returns the IID info of a bucket, ErrNoSuchS3Bucket if not registered.
+func getS3BucketIIDInfo(connectionName, bucketName string) (S3BucketIIDInfo, error) {
+	var iidInfo S3BucketIIDInfo
+	bool_ret, err := infostore.HasByConditions(&iidInfo, "connection_name", connectionName, "name_id", bucketName)
+	if err != nil {
+		return S3BucketIIDInfo{}, err
+	}
+	if !bool_ret {
+		return S3BucketIIDInfo{}, fmt.Errorf("%w: '%s' in connection '%s'", ErrNoSuchS3Bucket, bucketName, connectionName)
+	}
+	err = infostore.GetByConditions(&iidInfo, "connection_name", connectionName, "name_id", bucketName)
+	if err != nil {
+		return S3BucketIIDInfo{}, err
+	}
+	return iidInfo, nil
+}
+
+// S3CopyMetadata replaces the metadata of the copied object (x-amz-metadata-directive: REPLACE).
+// A nil S3CopyMetadata keeps the metadata of the source object.
+type S3CopyMetadata struct {
+	ContentType  string
+	UserMetadata map[string]string
+}
+
+// CopyS3Object copies an object between buckets of the same connection on the server side.
+// Objects larger than 5GiB are copied with multipart part copies.
+func CopyS3Object(connectionName, srcBucketName, srcObjectName, srcVersionId, dstBucketName, dstObjectName string, metadata *S3CopyMetadata) (minio.UploadInfo, error) {
+	cblog.Info("call CopyS3Object()")
+
+	srcIIDInfo, err := getS3BucketIIDInfo(connectionName, srcBucketName)
+	if err != nil {
+		return minio.UploadInfo{}, fmt.Errorf("source bucket: %w", err)
+	}
+	dstIIDInfo, err := getS3BucketIIDInfo(connectionName, dstBucketName)
+	if err != nil {
+		return minio.UploadInfo{}, fmt.Errorf("destination bucket: %w", err)
+	}
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+
+	// Azure: Blob SDK is not S3 compatible, copy through Spider
+	if connInfo.ProviderName == "AZURE" {
+		return streamCopyS3Object(connectionName, srcBucketName, srcObjectName, srcVersionId, connectionName, dstBucketName, dstObjectName, metadata)
+	}
+
+	client, err := NewS3Client(connInfo)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1800*time.Second)
+	defer cancel()
+
+	src := minio.CopySrcOptions{
+		Bucket:    srcIIDInfo.SystemId,
+		Object:    srcObjectName,
+		VersionID: srcVersionId,
+	}
+	dst := minio.CopyDestOptions{
+		Bucket: dstIIDInfo.SystemId,
+		Object: dstObjectName,
+	}
+	if metadata != nil {
+		dst.ReplaceMetadata = true
+		dst.UserMetadata = metadata.UserMetadata
+		dst.ContentType = metadata.ContentType
+	}
+
+	stat, err := client.StatObject(ctx, src.Bucket, src.Object, minio.StatObjectOptions{VersionID: srcVersionId})
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+
+	var info minio.UploadInfo
+	if stat.Size > s3MaxSingleCopySize {
+		cblog.Infof("Copying %s/%s (%d bytes) with multipart part copies", srcBucketName, srcObjectName, stat.Size)
+		info, err = client.ComposeObject(ctx, dst, src)
+	} else {
+		info, err = client.CopyObject(ctx, dst, src)
+	}
+	if err != nil {
+		cblog.Errorf("Failed to copy %s/%s to %s/%s: %v", srcBucketName, srcObjectName, dstBucketName, dstObjectName, err)
+		if ctx.Err() == context.DeadlineExceeded {
+			return minio.UploadInfo{}, fmt.Errorf("object copy timed out after 1800s (provider: %s may have network issues)", connInfo.ProviderName)
+		}
+		return minio.UploadInfo{}, err
+	}
+
+	info.Bucket = dstBucketName
+	info.Size = stat.Size
+	cblog.Infof("Successfully copied %s/%s to %s/%s", srcBucketName, srcObjectName, dstBucketName, dstObjectName)
+	return info, nil
+}
+
+// CopyS3ObjectAcrossConnections copies an object from a bucket of the source connection
+// to a bucket of the destination connection, ex) AWS => GCP.
+// The object is streamed through Spider and uploaded with multipart for large objects.
+// If both connections are the same, the object is copied on the server side.
+func CopyS3ObjectAcrossConnections(srcConnectionName, srcBucketName, srcObjectName, srcVersionId, dstConnectionName, dstBucketName, dstObjectName string, metadata *S3CopyMetadata) (minio.UploadInfo, error) {
+	cblog.Info("call CopyS3ObjectAcrossConnections()")
+
+	if srcConnectionName == dstConnectionName {
+		return CopyS3Object(srcConnectionName, srcBucketName, srcObjectName, srcVersionId, dstBucketName, dstObjectName, metadata)
+	}
+	return streamCopyS3Object(srcConnectionName, srcBucketName, srcObjectName, srcVersionId, dstConnectionName, dstBucketName, dstObjectName, metadata)
+}
+
+// streamCopyS3Object reads the source object and writes it to the destination.
+// A nil metadata keeps the Content-Type and user metadata of the source object.
+func streamCopyS3Object(srcConnectionName, srcBucketName, srcObjectName, srcVersionId, dstConnectionName, dstBucketName, dstObjectName string, metadata *S3CopyMetadata) (minio.UploadInfo, error) {
+	if _, err := getS3BucketIIDInfo(srcConnectionName, srcBucketName); err != nil {
+		return minio.UploadInfo{}, fmt.Errorf("source bucket: %w", err)
+	}
+	if _, err := getS3BucketIIDInfo(dstConnectionName, dstBucketName); err != nil {
+		return minio.UploadInfo{}, fmt.Errorf("destination bucket: %w", err)
+	}
+
+	var srcInfo *minio.ObjectInfo
+	var err error
+	if srcVersionId != "" {
+		srcInfo, err = GetS3ObjectInfoWithVersion(srcConnectionName, srcBucketName, srcObjectName, srcVersionId)
+	} else {
+		srcInfo, err = GetS3ObjectInfo(srcConnectionName, srcBucketName, srcObjectName)
+	}
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+
+	if metadata == nil {
+		metadata = &S3CopyMetadata{ContentType: srcInfo.ContentType, UserMetadata: srcInfo.UserMetadata}
+	}
+
+	dstConnInfo, err := GetS3ConnectionInfo(dstConnectionName)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+
+	var reader io.ReadCloser
+	if srcVersionId != "" {
+		reader, err = GetS3ObjectStreamWithVersion(srcConnectionName, srcBucketName, srcObjectName, srcVersionId)
+	} else {
+		reader, err = GetS3ObjectStream(srcConnectionName, srcBucketName, srcObjectName)
+	}
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+	defer reader.Close()
+
+	cblog.Infof("Stream copying %s:%s/%s (%d bytes) to %s:%s/%s", srcConnectionName, srcBucketName, srcObjectName,
+		srcInfo.Size, dstConnectionName, dstBucketName, dstObjectName)
+
+	// small objects and providers without multipart upload: single upload
+	if srcInfo.Size <= s3StreamCopyPartSize || dstConnInfo.ProviderName == "OPENSTACK" || dstConnInfo.ProviderName == "AZURE" {
+		info, err := putS3ObjectFromReader(dstConnectionName, dstBucketName, dstObjectName, reader, srcInfo.Size, metadata)
+		if err != nil {
+			return minio.UploadInfo{}, err
+		}
+		info.Bucket = dstBucketName
+		return info, nil
+	}
+
+	partSize := s3StreamCopyPartSize
+	if srcInfo.Size > partSize*s3MaxPartsCount {
+		partSize = (srcInfo.Size + s3MaxPartsCount - 1) / s3MaxPartsCount
+	}
+
+	uploadID, err := initiateMultipartUpload(dstConnectionName, dstBucketName, dstObjectName, metadata)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+
+	var parts []CompletePart
+	remaining := srcInfo.Size
+	for partNumber := 1; remaining > 0; partNumber++ {
+		size := partSize
+		if remaining < size {
+			size = remaining
+		}
+		etag, err := UploadPart(dstConnectionName, dstBucketName, dstObjectName, uploadID, partNumber, io.LimitReader(reader, size), size)
+		if err != nil {
+			abortStreamCopy(dstConnectionName, dstBucketName, dstObjectName, uploadID)
+			return minio.UploadInfo{}, fmt.Errorf("failed to copy part %d: %v", partNumber, err)
+		}
+		parts = append(parts, CompletePart{PartNumber: partNumber, ETag: etag})
+		remaining -= size
+	}
+
+	location, etag, err := CompleteMultipartUpload(dstConnectionName, dstBucketName, dstObjectName, uploadID, parts)
+	if err != nil {
+		abortStreamCopy(dstConnectionName, dstBucketName, dstObjectName, uploadID)
+		return minio.UploadInfo{}, err
+	}
+
+	cblog.Infof("Successfully stream copied %s:%s/%s to %s:%s/%s with %d parts", srcConnectionName, srcBucketName, srcObjectName,
+		dstConnectionName, dstBucketName, dstObjectName, len(parts))
+	return minio.UploadInfo{
+		Bucket:       dstBucketName,
+		Key:          dstObjectName,
+		ETag:         etag,
+		Size:         srcInfo.Size,
+		LastModified: time.Now(),
+		Location:     location,
+	}, nil
+}
+
+func abortStreamCopy(connectionName, bucketName, objectName, uploadID string) {
+	if err := AbortMultipartUpload(connectionName, bucketName, objectName, uploadID); err != nil {
+		cblog.Errorf("Failed to abort multipart upload %s of %s/%s: %v", uploadID, bucketName, objectName, err)
+	}
+}
+
+// UploadPartCopy uploads a part of a multipart upload by copying a byte range of a source object.
+// A length of -1 copies the whole source object.
+// If the source is in another connection, the range is streamed through Spider.
+func UploadPartCopy(connectionName, bucketName, objectName, uploadID string, partNumber int,
+	srcConnectionName, srcBucketName, srcObjectName, srcVersionId string, startOffset, length int64) (string, error) {
+	cblog.Info("call UploadPartCopy()")
+
+	if srcConnectionName != "" && srcConnectionName != connectionName {
+		return streamUploadPartCopy(connectionName, bucketName, objectName, uploadID, partNumber,
+			srcConnectionName, srcBucketName, srcObjectName, srcVersionId, startOffset, length)
+	}
+
+	iidInfo, err := getS3BucketIIDInfo(connectionName, bucketName)
+	if err != nil {
+		return "", err
+	}
+	srcIIDInfo, err := getS3BucketIIDInfo(connectionName, srcBucketName)
+	if err != nil {
+		return "", fmt.Errorf("source bucket: %w", err)
+	}
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return "", err
+	}
+
+	// Check if provider supports multipart upload
+	if connInfo.ProviderName == "OPENSTACK" || connInfo.ProviderName == "AZURE" {
+		return "", fmt.Errorf("multipart upload is not supported by %s:%s", connectionName, connInfo.ProviderName)
+	}
+
+	client, err := NewS3Client(connInfo)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1800*time.Second)
+	defer cancel()
+
+	// CopyObjectPart has no source version option, set the copy source header directly
+	headers := map[string]string{}
+	if srcVersionId != "" {
+		headers["x-amz-copy-source"] = s3utils.EncodePath(srcIIDInfo.SystemId+"/"+srcObjectName) + "?versionId=" + srcVersionId
+	}
+
+	core := minio.Core{Client: client}
+	part, err := core.CopyObjectPart(ctx, srcIIDInfo.SystemId, srcObjectName, iidInfo.SystemId, objectName, uploadID,
+		partNumber, startOffset, length, headers)
+	if err != nil {
+		cblog.Errorf("Failed to copy part %d for provider %s: %v", partNumber, connInfo.ProviderName, err)
+		if ctx.Err() == context.DeadlineExceeded {
+			return "", fmt.Errorf("part copy timed out after 1800s (provider: %s may have network issues)", connInfo.ProviderName)
+		}
+		return "", err
+	}
+
+	cblog.Infof("Successfully copied part %d - ETag: %s", partNumber, part.ETag)
+	return part.ETag, nil
+}
+
+// streamUploadPartCopy reads a byte range of the source object in another connection and uploads it as a part.
+func streamUploadPartCopy(connectionName, bucketName, objectName, uploadID string, partNumber int,
+	srcConnectionName, srcBucketName, srcObjectName, srcVersionId string, startOffset, length int64) (string, error) {
+
+	srcIIDInfo, err := getS3BucketIIDInfo(srcConnectionName, srcBucketName)
+	if err != nil {
+		return "", fmt.Errorf("source bucket: %w", err)
+	}
+	if _, err := getS3BucketIIDInfo(connectionName, bucketName); err != nil {
+		return "", err
+	}
+
+	srcConnInfo, err := GetS3ConnectionInfo(srcConnectionName)
+	if err != nil {
+		return "", err
+	}
+	if srcConnInfo.ProviderName == "AZURE" {
+		return "", fmt.Errorf("ranged copy source is not supported by %s:%s", srcConnectionName, srcConnInfo.ProviderName)
+	}
+
+	client, err := NewS3Client(srcConnInfo)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1800*time.Second)
+	defer cancel()
+
+	opts := minio.GetObjectOptions{VersionID: srcVersionId}
+	if length < 0 {
+		stat, err := client.StatObject(ctx, srcIIDInfo.SystemId, srcObjectName, minio.StatObjectOptions{VersionID: srcVersionId})
+		if err != nil {
+			return "", err
+		}
+		length = stat.Size - startOffset
+	}
+	if length <= 0 {
+		return "", fmt.Errorf("invalid copy source range: offset %d, length %d", startOffset, length)
+	}
+	if err := opts.SetRange(startOffset, startOffset+length-1); err != nil {
+		return "", err
+	}
+
+	obj, err := client.GetObject(ctx, srcIIDInfo.SystemId, srcObjectName, opts)
+	if err != nil {
+		return "", err
+	}
+	defer obj.Close()
+
+	return UploadPart(connectionName, bucketName, objectName, uploadID, partNumber, obj, length)
+}
@@ -0,0 +1,699 @@
+// Bucket lifecycle configuration and bucket/object tagging for S3 operations.
+// GCP uses GCP Storage SDK: lifecycle rules and bucket labels.
+// by CB-Spider Team
+
+package commonruntime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"google.golang.org/api/option"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
+)
+
+// newGCPStorageBucket returns a GCP Storage SDK client and the handle of the bucket.
+// The caller must close the client.
+func newGCPStorageBucket(ctx context.Context, connectionName, bucketName string) (*storage.Client, *storage.BucketHandle, error) {
+	cccInfo, err := ccim.GetConnectionConfig(connectionName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get connection config: %w", err)
+	}
+
+	crdInfo, err := cim.GetCredentialDecrypt(cccInfo.CredentialName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get credential: %w", err)
+	}
+
+	clientEmail := ccm.KeyValueListGetValue(crdInfo.KeyValueInfoList, "ClientEmail")
+	privateKey := ccm.KeyValueListGetValue(crdInfo.KeyValueInfoList, "PrivateKey")
+	if clientEmail == "" || privateKey == "" {
+		return nil, nil, fmt.Errorf("GCP credentials (ClientEmail, PrivateKey) not found")
+	}
+
+	credBytes, err := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"private_key":  privateKey,
+		"client_email": clientEmail,
+		"token_uri":    "https://oauth2.googleapis.com/token",
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal credentials: %w", err)
+	}
+
+	iidInfo, err := getS3BucketIIDInfo(connectionName, bucketName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storageClient, err := storage.NewClient(ctx, option.WithCredentialsJSON(credBytes))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create GCP storage client: %w", err)
+	}
+	return storageClient, storageClient.Bucket(iidInfo.SystemId), nil
+}
+
+// getS3ClientAndBucket returns the S3 client of the connection and the CSP bucket name.
+func getS3ClientAndBucket(connInfo *S3ConnectionInfo, connectionName, bucketName string) (*minio.Client, string, error) {
+	iidInfo, err := getS3BucketIIDInfo(connectionName, bucketName)
+	if err != nil {
+		return nil, "", err
+	}
+
+	client, err := NewS3Client(connInfo)
+	if err != nil {
+		return nil, "", err
+	}
+	return client, iidInfo.SystemId, nil
+}
+
+// ============================================================================
+// Bucket Lifecycle
+// ============================================================================
+
+// lifecycleRulePrefix returns the prefix filter of an S3 lifecycle rule.
+func lifecycleRulePrefix(rule lifecycle.Rule) string {
+	if rule.RuleFilter.Prefix != "" {
+		return rule.RuleFilter.Prefix
+	}
+	if rule.RuleFilter.And.Prefix != "" {
+		return rule.RuleFilter.And.Prefix
+	}
+	return rule.Prefix
+}
+
+// toGCPLifecycle converts S3 lifecycle rules to GCP lifecycle rules.
+// GCP has no rule ID and no disabled rule, so a disabled rule is an error: remove it instead.
+// The S3 actions and filters without a GCP condition are errors, not dropped.
+// Transition StorageClass is passed as is, use GCP storage classes (NEARLINE, COLDLINE, ARCHIVE) for GCP.
+func toGCPLifecycle(config *lifecycle.Configuration) (*storage.Lifecycle, error) {
+	gcpLifecycle := &storage.Lifecycle{}
+	for _, rule := range config.Rules {
+		if rule.Status != "Enabled" {
+			return nil, fmt.Errorf("disabled lifecycle rule %s is not supported by GCP, remove the rule instead", rule.ID)
+		}
+		if len(rule.RuleFilter.And.Tags) > 0 || !rule.RuleFilter.Tag.IsEmpty() {
+			return nil, fmt.Errorf("tag filter of lifecycle rule %s is not supported by GCP", rule.ID)
+		}
+		if rule.RuleFilter.ObjectSizeLessThan > 0 || rule.RuleFilter.ObjectSizeGreaterThan > 0 ||
+			rule.RuleFilter.And.ObjectSizeLessThan > 0 || rule.RuleFilter.And.ObjectSizeGreaterThan > 0 {
+			return nil, fmt.Errorf("object size filter of lifecycle rule %s is not supported by GCP", rule.ID)
+		}
+		if rule.Expiration.IsDeleteMarkerExpirationEnabled() || rule.Expiration.DeleteAll.IsEnabled() {
+			return nil, fmt.Errorf("ExpiredObjectDeleteMarker and ExpiredObjectAllVersions of lifecycle rule %s are not supported by GCP", rule.ID)
+		}
+		if rule.DelMarkerExpiration.Days > 0 || rule.AllVersionsExpiration.Days > 0 {
+			return nil, fmt.Errorf("DelMarkerExpiration and AllVersionsExpiration of lifecycle rule %s are not supported by GCP", rule.ID)
+		}
+		if !rule.NoncurrentVersionTransition.IsStorageClassEmpty() {
+			return nil, fmt.Errorf("NoncurrentVersionTransition of lifecycle rule %s is not supported by GCP", rule.ID)
+		}
+
+		condition := storage.LifecycleCondition{}
+		if prefix := lifecycleRulePrefix(rule); prefix != "" {
+			condition.MatchesPrefix = []string{prefix}
+		}
+
+		// a Delete rule without the age or the date condition deletes all the objects
+		if !rule.Expiration.IsDaysNull() || !rule.Expiration.IsDateNull() {
+			expCondition := condition
+			if !rule.Expiration.IsDaysNull() {
+				expCondition.AgeInDays = int64(rule.Expiration.Days)
+			} else {
+				expCondition.CreatedBefore = rule.Expiration.Date.Time
+			}
+			gcpLifecycle.Rules = append(gcpLifecycle.Rules, storage.LifecycleRule{
+				Action:    storage.LifecycleAction{Type: storage.DeleteAction},
+				Condition: expCondition,
+			})
+		}
+		if !rule.Transition.IsNull() {
+			trCondition := condition
+			if !rule.Transition.IsDaysNull() {
+				trCondition.AgeInDays = int64(rule.Transition.Days)
+			} else if !rule.Transition.IsDateNull() {
+				trCondition.CreatedBefore = rule.Transition.Date.Time
+			} else {
+				// S3 transits the objects in 0 days, GCP ignores AgeInDays 0
+				trCondition.AllObjects = true
+			}
+			gcpLifecycle.Rules = append(gcpLifecycle.Rules, storage.LifecycleRule{
+				Action:    storage.LifecycleAction{Type: storage.SetStorageClassAction, StorageClass: rule.Transition.StorageClass},
+				Condition: trCondition,
+			})
+		}
+		if !rule.NoncurrentVersionExpiration.IsDaysNull() || rule.NoncurrentVersionExpiration.NewerNoncurrentVersions > 0 {
+			ncCondition := condition
+			ncCondition.DaysSinceNoncurrentTime = int64(rule.NoncurrentVersionExpiration.NoncurrentDays)
+			ncCondition.Liveness = storage.Archived
+			if newer := rule.NoncurrentVersionExpiration.NewerNoncurrentVersions; newer > 0 {
+				// S3 keeps the newer noncurrent versions, GCP counts the live version too
+				ncCondition.NumNewerVersions = int64(newer) + 1
+			}
+			gcpLifecycle.Rules = append(gcpLifecycle.Rules, storage.LifecycleRule{
+				Action:    storage.LifecycleAction{Type: storage.DeleteAction},
+				Condition: ncCondition,
+			})
+		}
+		if !rule.AbortIncompleteMultipartUpload.IsDaysNull() {
+			mpuCondition := condition
+			mpuCondition.AgeInDays = int64(rule.AbortIncompleteMultipartUpload.DaysAfterInitiation)
+			gcpLifecycle.Rules = append(gcpLifecycle.Rules, storage.LifecycleRule{
+				Action:    storage.LifecycleAction{Type: storage.AbortIncompleteMPUAction},
+				Condition: mpuCondition,
+			})
+		}
+	}
+	return gcpLifecycle, nil
+}
+
+// fromGCPLifecycle converts GCP lifecycle rules to S3 lifecycle rules, one S3 rule per GCP rule.
+// The GCP rules without an S3 action, ex) a Delete rule with the storage class condition only, are skipped.
+func fromGCPLifecycle(gcpLifecycle storage.Lifecycle) *lifecycle.Configuration {
+	config := lifecycle.NewConfiguration()
+	for i, gcpRule := range gcpLifecycle.Rules {
+		rule := lifecycle.Rule{
+			ID:     fmt.Sprintf("gcp-rule-%d", i+1),
+			Status: "Enabled",
+		}
+		if len(gcpRule.Condition.MatchesPrefix) > 0 {
+			rule.RuleFilter.Prefix = gcpRule.Condition.MatchesPrefix[0]
+		}
+
+		cond := gcpRule.Condition
+		switch gcpRule.Action.Type {
+		case storage.DeleteAction:
+			if cond.DaysSinceNoncurrentTime > 0 || cond.NumNewerVersions > 1 {
+				rule.NoncurrentVersionExpiration.NoncurrentDays = lifecycle.ExpirationDays(cond.DaysSinceNoncurrentTime)
+				if cond.NumNewerVersions > 1 {
+					rule.NoncurrentVersionExpiration.NewerNoncurrentVersions = int(cond.NumNewerVersions - 1)
+				}
+			} else if !cond.CreatedBefore.IsZero() {
+				rule.Expiration.Date = lifecycle.ExpirationDate{Time: cond.CreatedBefore}
+			} else if cond.AgeInDays > 0 {
+				rule.Expiration.Days = lifecycle.ExpirationDays(cond.AgeInDays)
+			} else {
+				// no S3 expiration without the days or the date
+				continue
+			}
+		case storage.SetStorageClassAction:
+			rule.Transition.StorageClass = gcpRule.Action.StorageClass
+			if !cond.CreatedBefore.IsZero() {
+				rule.Transition.Date = lifecycle.ExpirationDate{Time: cond.CreatedBefore}
+			} else {
+				rule.Transition.Days = lifecycle.ExpirationDays(cond.AgeInDays)
+			}
+		case storage.AbortIncompleteMPUAction:
+			rule.AbortIncompleteMultipartUpload.DaysAfterInitiation = lifecycle.ExpirationDays(cond.AgeInDays)
+		default:
+			continue
+		}
+		config.Rules = append(config.Rules, rule)
+	}
+	return config
+}
+
+func isLifecycleUnsupported(providerName string) bool {
+	return providerName == "AZURE" || providerName == "OPENSTACK"
+}
+
+// SetS3BucketLifecycle replaces the lifecycle configuration of a bucket.
+func SetS3BucketLifecycle(connectionName string, bucketName string, config *lifecycle.Configuration) (bool, error) {
+	cblog.Info("call SetS3BucketLifecycle()")
+
+	if config.Empty() {
+		return false, fmt.Errorf("at least one lifecycle rule is required")
+	}
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return false, err
+	}
+
+	if isLifecycleUnsupported(connInfo.ProviderName) {
+		return false, fmt.Errorf("lifecycle configuration is not supported by %s:%s", connectionName, connInfo.ProviderName)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 180*time.Second)
+	defer cancel()
+
+	// Use GCP Storage SDK for GCP
+	if connInfo.ProviderName == "GCP" {
+		gcpLifecycle, err := toGCPLifecycle(config)
+		if err != nil {
+			return false, err
+		}
+		storageClient, bucket, err := newGCPStorageBucket(ctx, connectionName, bucketName)
+		if err != nil {
+			return false, err
+		}
+		defer storageClient.Close()
+
+		if _, err = bucket.Update(ctx, storage.BucketAttrsToUpdate{Lifecycle: gcpLifecycle}); err != nil {
+			cblog.Errorf("Failed to set GCP bucket lifecycle: %v", err)
+			return false, fmt.Errorf("failed to set GCP bucket lifecycle: %w", err)
+		}
+		cblog.Infof("Successfully set lifecycle (%d GCP rules) for GCP bucket %s", len(gcpLifecycle.Rules), bucketName)
+		return true, nil
+	}
+
+	client, systemId, err := getS3ClientAndBucket(connInfo, connectionName, bucketName)
+	if err != nil {
+		return false, err
+	}
+
+	if err = client.SetBucketLifecycle(ctx, systemId, config); err != nil {
+		cblog.Errorf("Failed to set bucket lifecycle: %v", err)
+		return false, err
+	}
+
+	cblog.Infof("Successfully set lifecycle (%d rules) for bucket %s", len(config.Rules), bucketName)
+	return true, nil
+}
+
+// GetS3BucketLifecycle returns the lifecycle configuration of a bucket.
+func GetS3BucketLifecycle(connectionName string, bucketName string) (*lifecycle.Configuration, error) {
+	cblog.Info("call GetS3BucketLifecycle()")
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	if isLifecycleUnsupported(connInfo.ProviderName) {
+		return nil, fmt.Errorf("lifecycle configuration is not supported by %s:%s", connectionName, connInfo.ProviderName)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 180*time.Second)
+	defer cancel()
+
+	// Use GCP Storage SDK for GCP
+	if connInfo.ProviderName == "GCP" {
+		storageClient, bucket, err := newGCPStorageBucket(ctx, connectionName, bucketName)
+		if err != nil {
+			return nil, err
+		}
+		defer storageClient.Close()
+
+		attrs, err := bucket.Attrs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get bucket attributes: %w", err)
+		}
+		if len(attrs.Lifecycle.Rules) == 0 {
+			return nil, fmt.Errorf("NoSuchLifecycleConfiguration: lifecycle configuration not found for bucket %s", bucketName)
+		}
+		return fromGCPLifecycle(attrs.Lifecycle), nil
+	}
+
+	client, systemId, err := getS3ClientAndBucket(connInfo, connectionName, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := client.GetBucketLifecycle(ctx, systemId)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchLifecycleConfiguration" {
+			return nil, fmt.Errorf("NoSuchLifecycleConfiguration: lifecycle configuration not found for bucket %s", bucketName)
+		}
+		cblog.Errorf("Failed to get bucket lifecycle: %v", err)
+		return nil, err
+	}
+	if config.Empty() {
+		return nil, fmt.Errorf("NoSuchLifecycleConfiguration: lifecycle configuration not found for bucket %s", bucketName)
+	}
+	return config, nil
+}
+
+// DeleteS3BucketLifecycle removes the lifecycle configuration of a bucket.
+func DeleteS3BucketLifecycle(connectionName string, bucketName string) (bool, error) {
+	cblog.Info("call DeleteS3BucketLifecycle()")
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return false, err
+	}
+
+	if isLifecycleUnsupported(connInfo.ProviderName) {
+		return false, fmt.Errorf("lifecycle configuration is not supported by %s:%s", connectionName, connInfo.ProviderName)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 180*time.Second)
+	defer cancel()
+
+	// Use GCP Storage SDK for GCP
+	if connInfo.ProviderName == "GCP" {
+		storageClient, bucket, err := newGCPStorageBucket(ctx, connectionName, bucketName)
+		if err != nil {
+			return false, err
+		}
+		defer storageClient.Close()
+
+		// Set Lifecycle to empty rules to delete
+		if _, err = bucket.Update(ctx, storage.BucketAttrsToUpdate{Lifecycle: &storage.Lifecycle{}}); err != nil {
+			cblog.Errorf("Failed to delete GCP bucket lifecycle: %v", err)
+			return false, fmt.Errorf("failed to delete GCP bucket lifecycle: %w", err)
+		}
+		cblog.Infof("Successfully deleted lifecycle for GCP bucket %s", bucketName)
+		return true, nil
+	}
+
+	client, systemId, err := getS3ClientAndBucket(connInfo, connectionName, bucketName)
+	if err != nil {
+		return false, err
+	}
+
+	// minio removes the lifecycle configuration with an empty configuration
+	if err = client.SetBucketLifecycle(ctx, systemId, lifecycle.NewConfiguration()); err != nil {
+		cblog.Errorf("Failed to delete bucket lifecycle: %v", err)
+		return false, err
+	}
+
+	cblog.Infof("Successfully deleted lifecycle for bucket %s", bucketName)
+	return true, nil
+}
+
+// ============================================================================
+// Bucket and Object Tagging
+// ============================================================================
+
+// S3TagError is an invalid tag error with the S3 error code, ex) InvalidTag.
+type S3TagError struct {
+	code    string
+	message string
+}
+
+func (e S3TagError) Code() string  { return e.code }
+func (e S3TagError) Error() string { return e.message }
+
+var (
+	gcpLabelKeyRegexp   = regexp.MustCompile(`^[\p{Ll}\p{Lo}][\p{Ll}\p{Lo}\p{N}_-]{0,62}$`)
+	gcpLabelValueRegexp = regexp.MustCompile(`^[\p{Ll}\p{Lo}\p{N}_-]{0,63}$`)
+)
+
+// validateGCPLabels checks the tags are valid GCP bucket labels.
+// GCP label keys start with a lowercase letter, and keys and values have lowercase letters, digits, '_' and '-' only (max 63 characters).
+func validateGCPLabels(tagMap map[string]string) error {
+	if len(tagMap) > 64 {
+		return S3TagError{"BadRequest", "GCP bucket labels cannot be more than 64"}
+	}
+	for key, value := range tagMap {
+		if !gcpLabelKeyRegexp.MatchString(key) {
+			return S3TagError{"InvalidTag", fmt.Sprintf("invalid GCP label key '%s': start with a lowercase letter and use lowercase letters, digits, '_' and '-' only (max 63 characters)", key)}
+		}
+		if !gcpLabelValueRegexp.MatchString(value) {
+			return S3TagError{"InvalidTag", fmt.Sprintf("invalid GCP label value '%s' of key '%s': use lowercase letters, digits, '_' and '-' only (max 63 characters)", value, key)}
+		}
+	}
+	return nil
+}
+
+func isTaggingUnsupported(providerName string) bool {
+	return providerName == "AZURE" || providerName == "OPENSTACK"
+}
+
+// SetS3BucketTagging replaces the tags of a bucket. GCP uses bucket labels.
+func SetS3BucketTagging(connectionName string, bucketName string, tagMap map[string]string) (bool, error) {
+	cblog.Info("call SetS3BucketTagging()")
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return false, err
+	}
+
+	if isTaggingUnsupported(connInfo.ProviderName) {
+		return false, fmt.Errorf("bucket tagging is not supported by %s:%s", connectionName, connInfo.ProviderName)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 180*time.Second)
+	defer cancel()
+
+	// Use GCP Storage SDK for GCP: bucket labels
+	if connInfo.ProviderName == "GCP" {
+		if err := validateGCPLabels(tagMap); err != nil {
+			return false, err
+		}
+
+		storageClient, bucket, err := newGCPStorageBucket(ctx, connectionName, bucketName)
+		if err != nil {
+			return false, err
+		}
+		defer storageClient.Close()
+
+		attrs, err := bucket.Attrs(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to get bucket attributes: %w", err)
+		}
+
+		var attrsToUpdate storage.BucketAttrsToUpdate
+		for key := range attrs.Labels {
+			if _, ok := tagMap[key]; !ok {
+				attrsToUpdate.DeleteLabel(key)
+			}
+		}
+		for key, value := range tagMap {
+			attrsToUpdate.SetLabel(key, value)
+		}
+		if _, err = bucket.Update(ctx, attrsToUpdate); err != nil {
+			cblog.Errorf("Failed to set GCP bucket labels: %v", err)
+			return false, fmt.Errorf("failed to set GCP bucket labels: %w", err)
+		}
+		cblog.Infof("Successfully set %d labels for GCP bucket %s", len(tagMap), bucketName)
+		return true, nil
+	}
+
+	bucketTags, err := tags.MapToBucketTags(tagMap)
+	if err != nil {
+		return false, err
+	}
+
+	client, systemId, err := getS3ClientAndBucket(connInfo, connectionName, bucketName)
+	if err != nil {
+		return false, err
+	}
+
+	if err = client.SetBucketTagging(ctx, systemId, bucketTags); err != nil {
+		cblog.Errorf("Failed to set bucket tagging: %v", err)
+		return false, err
+	}
+
+	cblog.Infof("Successfully set %d tags for bucket %s", len(tagMap), bucketName)
+	return true, nil
+}
+
+// GetS3BucketTagging returns the tags of a bucket. GCP uses bucket labels.
+func GetS3BucketTagging(connectionName string, bucketName string) (map[string]string, error) {
+	cblog.Info("call GetS3BucketTagging()")
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	if isTaggingUnsupported(connInfo.ProviderName) {
+		return nil, fmt.Errorf("bucket tagging is not supported by %s:%s", connectionName, connInfo.ProviderName)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 180*time.Second)
+	defer cancel()
+
+	// Use GCP Storage SDK for GCP: bucket labels
+	if connInfo.ProviderName == "GCP" {
+		storageClient, bucket, err := newGCPStorageBucket(ctx, connectionName, bucketName)
+		if err != nil {
+			return nil, err
+		}
+		defer storageClient.Close()
+
+		attrs, err := bucket.Attrs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get bucket attributes: %w", err)
+		}
+		if len(attrs.Labels) == 0 {
+			return nil, fmt.Errorf("NoSuchTagSet: tag set not found for bucket %s", bucketName)
+		}
+		return attrs.Labels, nil
+	}
+
+	client, systemId, err := getS3ClientAndBucket(connInfo, connectionName, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketTags, err := client.GetBucketTagging(ctx, systemId)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchTagSet" {
+			return nil, fmt.Errorf("NoSuchTagSet: tag set not found for bucket %s", bucketName)
+		}
+		cblog.Errorf("Failed to get bucket tagging: %v", err)
+		return nil, err
+	}
+	return bucketTags.ToMap(), nil
+}
+
+// DeleteS3BucketTagging removes all tags of a bucket. GCP uses bucket labels.
+func DeleteS3BucketTagging(connectionName string, bucketName string) (bool, error) {
+	cblog.Info("call DeleteS3BucketTagging()")
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return false, err
+	}
+
+	if isTaggingUnsupported(connInfo.ProviderName) {
+		return false, fmt.Errorf("bucket tagging is not supported by %s:%s", connectionName, connInfo.ProviderName)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 180*time.Second)
+	defer cancel()
+
+	// Use GCP Storage SDK for GCP: bucket labels
+	if connInfo.ProviderName == "GCP" {
+		storageClient, bucket, err := newGCPStorageBucket(ctx, connectionName, bucketName)
+		if err != nil {
+			return false, err
+		}
+		defer storageClient.Close()
+
+		attrs, err := bucket.Attrs(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to get bucket attributes: %w", err)
+		}
+		if len(attrs.Labels) == 0 {
+			return true, nil
+		}
+		var attrsToUpdate storage.BucketAttrsToUpdate
+		for key := range attrs.Labels {
+			attrsToUpdate.DeleteLabel(key)
+		}
+		if _, err = bucket.Update(ctx, attrsToUpdate); err != nil {
+			cblog.Errorf("Failed to delete GCP bucket labels: %v", err)
+			return false, fmt.Errorf("failed to delete GCP bucket labels: %w", err)
+		}
+		cblog.Infof("Successfully deleted labels for GCP bucket %s", bucketName)
+		return true, nil
+	}
+
+	client, systemId, err := getS3ClientAndBucket(connInfo, connectionName, bucketName)
+	if err != nil {
+		return false, err
+	}
+
+	if err = client.RemoveBucketTagging(ctx, systemId); err != nil {
+		cblog.Errorf("Failed to delete bucket tagging: %v", err)
+		return false, err
+	}
+
+	cblog.Infof("Successfully deleted tags for bucket %s", bucketName)
+	return true, nil
+}
+
+// GCP has no object tags: the XML API of GCP does not support ?tagging of objects.
+func isObjectTaggingUnsupported(providerName string) bool {
+	return isTaggingUnsupported(providerName) || providerName == "GCP"
+}
+
+// SetS3ObjectTagging replaces the tags of an object (or an object version).
+func SetS3ObjectTagging(connectionName, bucketName, objectName, versionId string, tagMap map[string]string) (bool, error) {
+	cblog.Info("call SetS3ObjectTagging()")
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return false, err
+	}
+
+	if isObjectTaggingUnsupported(connInfo.ProviderName) {
+		return false, fmt.Errorf("object tagging is not supported by %s:%s", connectionName, connInfo.ProviderName)
+	}
+
+	objectTags, err := tags.MapToObjectTags(tagMap)
+	if err != nil {
+		return false, err
+	}
+
+	client, systemId, err := getS3ClientAndBucket(connInfo, connectionName, bucketName)
+	if err != nil {
+		return false, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 180*time.Second)
+	defer cancel()
+
+	err = client.PutObjectTagging(ctx, systemId, objectName, objectTags, minio.PutObjectTaggingOptions{VersionID: versionId})
+	if err != nil {
+		cblog.Errorf("Failed to set object tagging: %v", err)
+		return false, err
+	}
+
+	cblog.Infof("Successfully set %d tags for object %s/%s", len(tagMap), bucketName, objectName)
+	return true, nil
+}
+
+// GetS3ObjectTagging returns the tags of an object (or an object version).
+func GetS3ObjectTagging(connectionName, bucketName, objectName, versionId string) (map[string]string, error) {
+	cblog.Info("call GetS3ObjectTagging()")
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	if isObjectTaggingUnsupported(connInfo.ProviderName) {
+		return nil, fmt.Errorf("object tagging is not supported by %s:%s", connectionName, connInfo.ProviderName)
+	}
+
+	client, systemId, err := getS3ClientAndBucket(connInfo, connectionName, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 180*time.Second)
+	defer cancel()
+
+	objectTags, err := client.GetObjectTagging(ctx, systemId, objectName, minio.GetObjectTaggingOptions{VersionID: versionId})
+	if err != nil {
+		cblog.Errorf("Failed to get object tagging: %v", err)
+		return nil, err
+	}
+	return objectTags.ToMap(), nil
+}
+
+// DeleteS3ObjectTagging removes all tags of an object (or an object version).
+func DeleteS3ObjectTagging(connectionName, bucketName, objectName, versionId string) (bool, error) {
+	cblog.Info("call DeleteS3ObjectTagging()")
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return false, err
+	}
+
+	if isObjectTaggingUnsupported(connInfo.ProviderName) {
+		return false, fmt.Errorf("object tagging is not supported by %s:%s", connectionName, connInfo.ProviderName)
+	}
+
+	client, systemId, err := getS3ClientAndBucket(connInfo, connectionName, bucketName)
+	if err != nil {
+		return false, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 180*time.Second)
+	defer cancel()
+
+	err = client.RemoveObjectTagging(ctx, systemId, objectName, minio.RemoveObjectTaggingOptions{VersionID: versionId})
+	if err != nil {
+		cblog.Errorf("Failed to delete object tagging: %v", err)
+		return false, err
+	}
+
+	cblog.Infof("Successfully deleted tags for object %s/%s", bucketName, objectName)
+	return true, nil
+}
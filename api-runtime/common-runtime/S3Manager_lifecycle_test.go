@@ -0,0 +1,186 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+)
+
+func TestToGCPLifecycle(t *testing.T) {
+	date := time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC)
+	logPrefix := []string{"logs/"}
+
+	testList := []struct {
+		name     string
+		rule     lifecycle.Rule
+		gcpRules []storage.LifecycleRule
+		isError  bool
+	}{
+		{"expiration days", lifecycle.Rule{ID: "r1", Status: "Enabled", RuleFilter: lifecycle.Filter{Prefix: "logs/"},
+			Expiration: lifecycle.Expiration{Days: 30}},
+			[]storage.LifecycleRule{{Action: storage.LifecycleAction{Type: storage.DeleteAction},
+				Condition: storage.LifecycleCondition{AgeInDays: 30, MatchesPrefix: logPrefix}}}, false},
+		{"expiration date", lifecycle.Rule{ID: "r1", Status: "Enabled",
+			Expiration: lifecycle.Expiration{Date: lifecycle.ExpirationDate{Time: date}}},
+			[]storage.LifecycleRule{{Action: storage.LifecycleAction{Type: storage.DeleteAction},
+				Condition: storage.LifecycleCondition{CreatedBefore: date}}}, false},
+		{"transition days", lifecycle.Rule{ID: "r1", Status: "Enabled", Prefix: "logs/",
+			Transition: lifecycle.Transition{Days: 7, StorageClass: "NEARLINE"}},
+			[]storage.LifecycleRule{{Action: storage.LifecycleAction{Type: storage.SetStorageClassAction, StorageClass: "NEARLINE"},
+				Condition: storage.LifecycleCondition{AgeInDays: 7, MatchesPrefix: logPrefix}}}, false},
+		{"transition in 0 days", lifecycle.Rule{ID: "r1", Status: "Enabled",
+			Transition: lifecycle.Transition{StorageClass: "ARCHIVE"}},
+			[]storage.LifecycleRule{{Action: storage.LifecycleAction{Type: storage.SetStorageClassAction, StorageClass: "ARCHIVE"},
+				Condition: storage.LifecycleCondition{AllObjects: true}}}, false},
+		{"noncurrent days", lifecycle.Rule{ID: "r1", Status: "Enabled",
+			NoncurrentVersionExpiration: lifecycle.NoncurrentVersionExpiration{NoncurrentDays: 10}},
+			[]storage.LifecycleRule{{Action: storage.LifecycleAction{Type: storage.DeleteAction},
+				Condition: storage.LifecycleCondition{DaysSinceNoncurrentTime: 10, Liveness: storage.Archived}}}, false},
+		{"noncurrent days and newer versions", lifecycle.Rule{ID: "r1", Status: "Enabled",
+			NoncurrentVersionExpiration: lifecycle.NoncurrentVersionExpiration{NoncurrentDays: 10, NewerNoncurrentVersions: 3}},
+			[]storage.LifecycleRule{{Action: storage.LifecycleAction{Type: storage.DeleteAction},
+				Condition: storage.LifecycleCondition{DaysSinceNoncurrentTime: 10, Liveness: storage.Archived, NumNewerVersions: 4}}}, false},
+		{"newer versions only", lifecycle.Rule{ID: "r1", Status: "Enabled",
+			NoncurrentVersionExpiration: lifecycle.NoncurrentVersionExpiration{NewerNoncurrentVersions: 2}},
+			[]storage.LifecycleRule{{Action: storage.LifecycleAction{Type: storage.DeleteAction},
+				Condition: storage.LifecycleCondition{Liveness: storage.Archived, NumNewerVersions: 3}}}, false},
+		{"abort multipart upload", lifecycle.Rule{ID: "r1", Status: "Enabled",
+			AbortIncompleteMultipartUpload: lifecycle.AbortIncompleteMultipartUpload{DaysAfterInitiation: 2}},
+			[]storage.LifecycleRule{{Action: storage.LifecycleAction{Type: storage.AbortIncompleteMPUAction},
+				Condition: storage.LifecycleCondition{AgeInDays: 2}}}, false},
+		{"expiration and transition", lifecycle.Rule{ID: "r1", Status: "Enabled",
+			Expiration: lifecycle.Expiration{Days: 90}, Transition: lifecycle.Transition{Days: 30, StorageClass: "COLDLINE"}},
+			[]storage.LifecycleRule{
+				{Action: storage.LifecycleAction{Type: storage.DeleteAction}, Condition: storage.LifecycleCondition{AgeInDays: 90}},
+				{Action: storage.LifecycleAction{Type: storage.SetStorageClassAction, StorageClass: "COLDLINE"}, Condition: storage.LifecycleCondition{AgeInDays: 30}},
+			}, false},
+
+		// errors
+		{"disabled", lifecycle.Rule{ID: "r1", Status: "Disabled", Expiration: lifecycle.Expiration{Days: 30}}, nil, true},
+		{"tag filter", lifecycle.Rule{ID: "r1", Status: "Enabled", RuleFilter: lifecycle.Filter{Tag: lifecycle.Tag{Key: "k", Value: "v"}},
+			Expiration: lifecycle.Expiration{Days: 30}}, nil, true},
+		{"object size filter", lifecycle.Rule{ID: "r1", Status: "Enabled", RuleFilter: lifecycle.Filter{ObjectSizeGreaterThan: 1024},
+			Expiration: lifecycle.Expiration{Days: 30}}, nil, true},
+		{"delete marker only", lifecycle.Rule{ID: "r1", Status: "Enabled",
+			Expiration: lifecycle.Expiration{DeleteMarker: true}}, nil, true},
+		{"delete all only", lifecycle.Rule{ID: "r1", Status: "Enabled",
+			Expiration: lifecycle.Expiration{DeleteAll: true}}, nil, true},
+		{"days and delete marker", lifecycle.Rule{ID: "r1", Status: "Enabled",
+			Expiration: lifecycle.Expiration{Days: 30, DeleteMarker: true}}, nil, true},
+		{"noncurrent transition", lifecycle.Rule{ID: "r1", Status: "Enabled",
+			NoncurrentVersionTransition: lifecycle.NoncurrentVersionTransition{NoncurrentDays: 10, StorageClass: "ARCHIVE"}}, nil, true},
+		{"delete marker expiration", lifecycle.Rule{ID: "r1", Status: "Enabled",
+			DelMarkerExpiration: lifecycle.DelMarkerExpiration{Days: 10}}, nil, true},
+	}
+
+	for _, test := range testList {
+		gcpLifecycle, err := toGCPLifecycle(&lifecycle.Configuration{Rules: []lifecycle.Rule{test.rule}})
+		if (err != nil) != test.isError {
+			t.Errorf("%s: error is %v, expected error: %v", test.name, err, test.isError)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if !reflect.DeepEqual(gcpLifecycle.Rules, test.gcpRules) {
+			t.Errorf("%s: %+v is not same %+v", test.name, gcpLifecycle.Rules, test.gcpRules)
+		}
+		// no Delete rule without the age, the date or the noncurrent conditions: it deletes all the objects
+		for _, gcpRule := range gcpLifecycle.Rules {
+			cond := gcpRule.Condition
+			if gcpRule.Action.Type == storage.DeleteAction && cond.AgeInDays == 0 && cond.CreatedBefore.IsZero() &&
+				cond.DaysSinceNoncurrentTime == 0 && cond.NumNewerVersions == 0 {
+				t.Errorf("%s: Delete rule without a condition: %+v", test.name, cond)
+			}
+		}
+	}
+}
+
+func TestFromGCPLifecycle(t *testing.T) {
+	date := time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	testList := []struct {
+		name    string
+		gcpRule storage.LifecycleRule
+		rule    *lifecycle.Rule // nil: skipped
+	}{
+		{"age", storage.LifecycleRule{Action: storage.LifecycleAction{Type: storage.DeleteAction},
+			Condition: storage.LifecycleCondition{AgeInDays: 30, MatchesPrefix: []string{"logs/"}}},
+			&lifecycle.Rule{RuleFilter: lifecycle.Filter{Prefix: "logs/"}, Expiration: lifecycle.Expiration{Days: 30}}},
+		{"created before", storage.LifecycleRule{Action: storage.LifecycleAction{Type: storage.DeleteAction},
+			Condition: storage.LifecycleCondition{CreatedBefore: date}},
+			&lifecycle.Rule{Expiration: lifecycle.Expiration{Date: lifecycle.ExpirationDate{Time: date}}}},
+		{"noncurrent days", storage.LifecycleRule{Action: storage.LifecycleAction{Type: storage.DeleteAction},
+			Condition: storage.LifecycleCondition{DaysSinceNoncurrentTime: 10, Liveness: storage.Archived}},
+			&lifecycle.Rule{NoncurrentVersionExpiration: lifecycle.NoncurrentVersionExpiration{NoncurrentDays: 10}}},
+		{"noncurrent days and newer versions", storage.LifecycleRule{Action: storage.LifecycleAction{Type: storage.DeleteAction},
+			Condition: storage.LifecycleCondition{DaysSinceNoncurrentTime: 10, Liveness: storage.Archived, NumNewerVersions: 4}},
+			&lifecycle.Rule{NoncurrentVersionExpiration: lifecycle.NoncurrentVersionExpiration{NoncurrentDays: 10, NewerNoncurrentVersions: 3}}},
+		{"newer versions only", storage.LifecycleRule{Action: storage.LifecycleAction{Type: storage.DeleteAction},
+			Condition: storage.LifecycleCondition{NumNewerVersions: 3}},
+			&lifecycle.Rule{NoncurrentVersionExpiration: lifecycle.NoncurrentVersionExpiration{NewerNoncurrentVersions: 2}}},
+		{"set storage class", storage.LifecycleRule{Action: storage.LifecycleAction{Type: storage.SetStorageClassAction, StorageClass: "NEARLINE"},
+			Condition: storage.LifecycleCondition{AgeInDays: 7}},
+			&lifecycle.Rule{Transition: lifecycle.Transition{Days: 7, StorageClass: "NEARLINE"}}},
+		{"abort multipart upload", storage.LifecycleRule{Action: storage.LifecycleAction{Type: storage.AbortIncompleteMPUAction},
+			Condition: storage.LifecycleCondition{AgeInDays: 2}},
+			&lifecycle.Rule{AbortIncompleteMultipartUpload: lifecycle.AbortIncompleteMultipartUpload{DaysAfterInitiation: 2}}},
+
+		// skipped
+		{"storage class condition only", storage.LifecycleRule{Action: storage.LifecycleAction{Type: storage.DeleteAction},
+			Condition: storage.LifecycleCondition{MatchesStorageClasses: []string{"ARCHIVE"}}}, nil},
+		{"unknown action", storage.LifecycleRule{Action: storage.LifecycleAction{Type: "Unknown"},
+			Condition: storage.LifecycleCondition{AgeInDays: 7}}, nil},
+	}
+
+	for _, test := range testList {
+		config := fromGCPLifecycle(storage.Lifecycle{Rules: []storage.LifecycleRule{test.gcpRule}})
+		if test.rule == nil {
+			if len(config.Rules) != 0 {
+				t.Errorf("%s: %+v is not skipped", test.name, config.Rules)
+			}
+			continue
+		}
+		if len(config.Rules) != 1 {
+			t.Errorf("%s: %d rules, expected 1", test.name, len(config.Rules))
+			continue
+		}
+		expected := *test.rule
+		expected.ID = "gcp-rule-1"
+		expected.Status = "Enabled"
+		if !reflect.DeepEqual(config.Rules[0], expected) {
+			t.Errorf("%s: %+v is not same %+v", test.name, config.Rules[0], expected)
+		}
+	}
+
+	// a converted S3 rule is converted back to the same GCP rule
+	for _, test := range testList {
+		if test.rule == nil {
+			continue
+		}
+		config := fromGCPLifecycle(storage.Lifecycle{Rules: []storage.LifecycleRule{test.gcpRule}})
+		gcpLifecycle, err := toGCPLifecycle(config)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		expected := test.gcpRule
+		if expected.Condition.NumNewerVersions > 0 {
+			expected.Condition.Liveness = storage.Archived // only the noncurrent versions have newer versions
+		}
+		if len(gcpLifecycle.Rules) != 1 || !reflect.DeepEqual(gcpLifecycle.Rules[0], expected) {
+			t.Errorf("%s: %+v is not same %+v", test.name, gcpLifecycle.Rules, expected)
+		}
+	}
+}
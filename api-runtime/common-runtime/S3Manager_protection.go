@@ -0,0 +1,480 @@
+// Bucket default encryption and object lock (retention, legal hold) for S3 operations.
+// GCP uses GCP Storage SDK: Google-managed encryption or default Cloud KMS key.
+// by CB-Spider Team
+
+package commonruntime
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/sse"
+)
+
+// S3 default server-side encryption algorithms
+const (
+	S3EncryptionSSES3  = "AES256"
+	S3EncryptionSSEKMS = "aws:kms"
+)
+
+// S3BucketCreateOptions are the optional settings applied when a bucket is created.
+type S3BucketCreateOptions struct {
+	Encryption string // "", AES256 or aws:kms
+	KMSKeyID   string // used with aws:kms (GCP: projects/P/locations/L/keyRings/R/cryptoKeys/K)
+	ObjectLock bool   // object lock can only be enabled at bucket creation
+}
+
+// S3ObjectLockConfig is the object lock configuration of a bucket.
+type S3ObjectLockConfig struct {
+	Enabled  bool
+	Mode     string // GOVERNANCE or COMPLIANCE, empty if no default retention
+	Validity uint
+	Unit     string // DAYS or YEARS
+}
+
+func isEncryptionUnsupported(providerName string) bool {
+	switch providerName {
+	case "AZURE", "OPENSTACK", "NHN", "NCP", "NCPVPC", "KT", "IBM":
+		return true
+	}
+	return false
+}
+
+func isObjectLockSupported(providerName string) bool {
+	return providerName == "AWS" || providerName == "IBM"
+}
+
+func encryptionUnsupportedError(connectionName, providerName string) error {
+	if providerName == "AZURE" {
+		return fmt.Errorf("bucket encryption is not supported by %s:%s (Azure applies encryption at Storage Account level, not per-Container)", connectionName, providerName)
+	}
+	return fmt.Errorf("bucket encryption is not supported by %s:%s", connectionName, providerName)
+}
+
+func objectLockUnsupportedError(connectionName, providerName string) error {
+	return fmt.Errorf("object lock is not supported by %s:%s", connectionName, providerName)
+}
+
+// checkS3BucketCreateOptions rejects create options that the provider cannot apply,
+// before the bucket is created.
+func checkS3BucketCreateOptions(connectionName string, connInfo *S3ConnectionInfo, opts *S3BucketCreateOptions) error {
+	if opts == nil {
+		return nil
+	}
+	if opts.Encryption != "" {
+		if opts.Encryption != S3EncryptionSSES3 && opts.Encryption != S3EncryptionSSEKMS {
+			return fmt.Errorf("invalid encryption algorithm '%s': must be '%s' or '%s'", opts.Encryption, S3EncryptionSSES3, S3EncryptionSSEKMS)
+		}
+		if isEncryptionUnsupported(connInfo.ProviderName) {
+			return encryptionUnsupportedError(connectionName, connInfo.ProviderName)
+		}
+		// GCP has no default KMS key, a Cloud KMS key name is required
+		if connInfo.ProviderName == "GCP" && opts.Encryption == S3EncryptionSSEKMS && opts.KMSKeyID == "" {
+			return fmt.Errorf("KMS key name is required for aws:kms encryption of GCP bucket")
+		}
+	}
+	if opts.ObjectLock && !isObjectLockSupported(connInfo.ProviderName) {
+		return objectLockUnsupportedError(connectionName, connInfo.ProviderName)
+	}
+	return nil
+}
+
+// ============================================================================
+// Bucket Default Encryption
+// ============================================================================
+
+// SetS3BucketEncryption sets the default server-side encryption of a bucket.
+// algorithm is AES256 or aws:kms, kmsKeyID is used only with aws:kms.
+func SetS3BucketEncryption(connectionName, bucketName, algorithm, kmsKeyID string) (bool, error) {
+	cblog.Info("call SetS3BucketEncryption()")
+
+	if algorithm != S3EncryptionSSES3 && algorithm != S3EncryptionSSEKMS {
+		return false, fmt.Errorf("invalid encryption algorithm '%s': must be '%s' or '%s'", algorithm, S3EncryptionSSES3, S3EncryptionSSEKMS)
+	}
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return false, err
+	}
+
+	if isEncryptionUnsupported(connInfo.ProviderName) {
+		return false, encryptionUnsupportedError(connectionName, connInfo.ProviderName)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 180*time.Second)
+	defer cancel()
+
+	// Use GCP Storage SDK for GCP: AES256 is Google-managed encryption, aws:kms is a default Cloud KMS key
+	if connInfo.ProviderName == "GCP" {
+		if algorithm == S3EncryptionSSEKMS && kmsKeyID == "" {
+			return false, fmt.Errorf("KMS key name is required for aws:kms encryption of GCP bucket %s", bucketName)
+		}
+		if algorithm == S3EncryptionSSES3 {
+			kmsKeyID = ""
+		}
+		storageClient, bucket, err := newGCPStorageBucket(ctx, connectionName, bucketName)
+		if err != nil {
+			return false, err
+		}
+		defer storageClient.Close()
+
+		_, err = bucket.Update(ctx, storage.BucketAttrsToUpdate{Encryption: &storage.BucketEncryption{DefaultKMSKeyName: kmsKeyID}})
+		if err != nil {
+			cblog.Errorf("Failed to set GCP bucket encryption: %v", err)
+			return false, fmt.Errorf("failed to set GCP bucket encryption: %w", err)
+		}
+		cblog.Infof("Successfully set %s encryption for GCP bucket %s", algorithm, bucketName)
+		return true, nil
+	}
+
+	client, systemId, err := getS3ClientAndBucket(connInfo, connectionName, bucketName)
+	if err != nil {
+		return false, err
+	}
+
+	config := sse.NewConfigurationSSES3()
+	if algorithm == S3EncryptionSSEKMS {
+		config = sse.NewConfigurationSSEKMS(kmsKeyID)
+	}
+	if err = client.SetBucketEncryption(ctx, systemId, config); err != nil {
+		cblog.Errorf("Failed to set bucket encryption: %v", err)
+		return false, err
+	}
+
+	cblog.Infof("Successfully set %s encryption for bucket %s", algorithm, bucketName)
+	return true, nil
+}
+
+// GetS3BucketEncryption returns the default server-side encryption of a bucket.
+func GetS3BucketEncryption(connectionName, bucketName string) (*sse.Configuration, error) {
+	cblog.Info("call GetS3BucketEncryption()")
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	if isEncryptionUnsupported(connInfo.ProviderName) {
+		return nil, encryptionUnsupportedError(connectionName, connInfo.ProviderName)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 180*time.Second)
+	defer cancel()
+
+	// Use GCP Storage SDK for GCP: objects are always encrypted, with Google-managed keys by default
+	if connInfo.ProviderName == "GCP" {
+		storageClient, bucket, err := newGCPStorageBucket(ctx, connectionName, bucketName)
+		if err != nil {
+			return nil, err
+		}
+		defer storageClient.Close()
+
+		attrs, err := bucket.Attrs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get bucket attributes: %w", err)
+		}
+		if attrs.Encryption != nil && attrs.Encryption.DefaultKMSKeyName != "" {
+			return sse.NewConfigurationSSEKMS(attrs.Encryption.DefaultKMSKeyName), nil
+		}
+		return sse.NewConfigurationSSES3(), nil
+	}
+
+	client, systemId, err := getS3ClientAndBucket(connInfo, connectionName, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := client.GetBucketEncryption(ctx, systemId)
+	if err != nil {
+		cblog.Errorf("Failed to get bucket encryption: %v", err)
+		return nil, err
+	}
+	return config, nil
+}
+
+// DeleteS3BucketEncryption removes the default server-side encryption of a bucket.
+// GCP falls back to Google-managed encryption.
+func DeleteS3BucketEncryption(connectionName, bucketName string) (bool, error) {
+	cblog.Info("call DeleteS3BucketEncryption()")
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return false, err
+	}
+
+	if isEncryptionUnsupported(connInfo.ProviderName) {
+		return false, encryptionUnsupportedError(connectionName, connInfo.ProviderName)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 180*time.Second)
+	defer cancel()
+
+	// Use GCP Storage SDK for GCP
+	if connInfo.ProviderName == "GCP" {
+		storageClient, bucket, err := newGCPStorageBucket(ctx, connectionName, bucketName)
+		if err != nil {
+			return false, err
+		}
+		defer storageClient.Close()
+
+		// Empty DefaultKMSKeyName deletes the default KMS key
+		_, err = bucket.Update(ctx, storage.BucketAttrsToUpdate{Encryption: &storage.BucketEncryption{}})
+		if err != nil {
+			cblog.Errorf("Failed to delete GCP bucket encryption: %v", err)
+			return false, fmt.Errorf("failed to delete GCP bucket encryption: %w", err)
+		}
+		cblog.Infof("Successfully deleted default KMS key for GCP bucket %s", bucketName)
+		return true, nil
+	}
+
+	client, systemId, err := getS3ClientAndBucket(connInfo, connectionName, bucketName)
+	if err != nil {
+		return false, err
+	}
+
+	if err = client.RemoveBucketEncryption(ctx, systemId); err != nil {
+		cblog.Errorf("Failed to delete bucket encryption: %v", err)
+		return false, err
+	}
+
+	cblog.Infof("Successfully deleted encryption for bucket %s", bucketName)
+	return true, nil
+}
+
+// ============================================================================
+// Object Lock, Retention and Legal Hold
+// ============================================================================
+
+// SetS3BucketObjectLock sets the default retention of an object lock enabled bucket.
+// Empty mode removes the default retention.
+func SetS3BucketObjectLock(connectionName, bucketName, mode string, validity uint, unit string) (bool, error) {
+	cblog.Info("call SetS3BucketObjectLock()")
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return false, err
+	}
+
+	if !isObjectLockSupported(connInfo.ProviderName) {
+		return false, objectLockUnsupportedError(connectionName, connInfo.ProviderName)
+	}
+
+	var retentionMode *minio.RetentionMode
+	var validityUnit *minio.ValidityUnit
+	var validityPtr *uint
+	if mode != "" {
+		m := minio.RetentionMode(mode)
+		u := minio.ValidityUnit(unit)
+		if !m.IsValid() {
+			return false, fmt.Errorf("invalid retention mode '%s': must be 'GOVERNANCE' or 'COMPLIANCE'", mode)
+		}
+		if (u != minio.Days && u != minio.Years) || validity == 0 {
+			return false, fmt.Errorf("default retention requires a positive period of DAYS or YEARS")
+		}
+		retentionMode, validityUnit, validityPtr = &m, &u, &validity
+	}
+
+	client, systemId, err := getS3ClientAndBucket(connInfo, connectionName, bucketName)
+	if err != nil {
+		return false, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 180*time.Second)
+	defer cancel()
+
+	if err = client.SetObjectLockConfig(ctx, systemId, retentionMode, validityPtr, validityUnit); err != nil {
+		cblog.Errorf("Failed to set object lock configuration: %v", err)
+		return false, err
+	}
+
+	cblog.Infof("Successfully set object lock configuration for bucket %s", bucketName)
+	return true, nil
+}
+
+// GetS3BucketObjectLock returns the object lock configuration of a bucket.
+func GetS3BucketObjectLock(connectionName, bucketName string) (*S3ObjectLockConfig, error) {
+	cblog.Info("call GetS3BucketObjectLock()")
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isObjectLockSupported(connInfo.ProviderName) {
+		return nil, objectLockUnsupportedError(connectionName, connInfo.ProviderName)
+	}
+
+	client, systemId, err := getS3ClientAndBucket(connInfo, connectionName, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 180*time.Second)
+	defer cancel()
+
+	objectLock, mode, validity, unit, err := client.GetObjectLockConfig(ctx, systemId)
+	if err != nil {
+		cblog.Errorf("Failed to get object lock configuration: %v", err)
+		return nil, err
+	}
+
+	config := &S3ObjectLockConfig{Enabled: objectLock == "Enabled"}
+	if mode != nil {
+		config.Mode = mode.String()
+	}
+	if validity != nil {
+		config.Validity = *validity
+	}
+	if unit != nil {
+		config.Unit = unit.String()
+	}
+	return config, nil
+}
+
+// SetS3ObjectRetention sets the retention of an object (or an object version) in an object lock enabled bucket.
+func SetS3ObjectRetention(connectionName, bucketName, objectName, versionId, mode string, retainUntilDate time.Time, bypassGovernance bool) (bool, error) {
+	cblog.Info("call SetS3ObjectRetention()")
+
+	retentionMode := minio.RetentionMode(mode)
+	if !retentionMode.IsValid() {
+		return false, fmt.Errorf("invalid retention mode '%s': must be 'GOVERNANCE' or 'COMPLIANCE'", mode)
+	}
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return false, err
+	}
+
+	if !isObjectLockSupported(connInfo.ProviderName) {
+		return false, objectLockUnsupportedError(connectionName, connInfo.ProviderName)
+	}
+
+	client, systemId, err := getS3ClientAndBucket(connInfo, connectionName, bucketName)
+	if err != nil {
+		return false, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 180*time.Second)
+	defer cancel()
+
+	err = client.PutObjectRetention(ctx, systemId, objectName, minio.PutObjectRetentionOptions{
+		GovernanceBypass: bypassGovernance,
+		Mode:             &retentionMode,
+		RetainUntilDate:  &retainUntilDate,
+		VersionID:        versionId,
+	})
+	if err != nil {
+		cblog.Errorf("Failed to set object retention: %v", err)
+		return false, err
+	}
+
+	cblog.Infof("Successfully set %s retention until %s for object %s/%s", mode, retainUntilDate.Format(time.RFC3339), bucketName, objectName)
+	return true, nil
+}
+
+// GetS3ObjectRetention returns the retention mode and retain-until date of an object (or an object version).
+func GetS3ObjectRetention(connectionName, bucketName, objectName, versionId string) (string, *time.Time, error) {
+	cblog.Info("call GetS3ObjectRetention()")
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if !isObjectLockSupported(connInfo.ProviderName) {
+		return "", nil, objectLockUnsupportedError(connectionName, connInfo.ProviderName)
+	}
+
+	client, systemId, err := getS3ClientAndBucket(connInfo, connectionName, bucketName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 180*time.Second)
+	defer cancel()
+
+	mode, retainUntilDate, err := client.GetObjectRetention(ctx, systemId, objectName, versionId)
+	if err != nil {
+		cblog.Errorf("Failed to get object retention: %v", err)
+		return "", nil, err
+	}
+
+	modeStr := ""
+	if mode != nil {
+		modeStr = mode.String()
+	}
+	return modeStr, retainUntilDate, nil
+}
+
+// SetS3ObjectLegalHold turns the legal hold of an object (or an object version) on or off.
+func SetS3ObjectLegalHold(connectionName, bucketName, objectName, versionId string, on bool) (bool, error) {
+	cblog.Info("call SetS3ObjectLegalHold()")
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return false, err
+	}
+
+	if !isObjectLockSupported(connInfo.ProviderName) {
+		return false, objectLockUnsupportedError(connectionName, connInfo.ProviderName)
+	}
+
+	client, systemId, err := getS3ClientAndBucket(connInfo, connectionName, bucketName)
+	if err != nil {
+		return false, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 180*time.Second)
+	defer cancel()
+
+	status := minio.LegalHoldDisabled
+	if on {
+		status = minio.LegalHoldEnabled
+	}
+	err = client.PutObjectLegalHold(ctx, systemId, objectName, minio.PutObjectLegalHoldOptions{
+		VersionID: versionId,
+		Status:    &status,
+	})
+	if err != nil {
+		cblog.Errorf("Failed to set object legal hold: %v", err)
+		return false, err
+	}
+
+	cblog.Infof("Successfully set legal hold %s for object %s/%s", status, bucketName, objectName)
+	return true, nil
+}
+
+// GetS3ObjectLegalHold returns the legal hold status (ON or OFF) of an object (or an object version).
+func GetS3ObjectLegalHold(connectionName, bucketName, objectName, versionId string) (string, error) {
+	cblog.Info("call GetS3ObjectLegalHold()")
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return "", err
+	}
+
+	if !isObjectLockSupported(connInfo.ProviderName) {
+		return "", objectLockUnsupportedError(connectionName, connInfo.ProviderName)
+	}
+
+	client, systemId, err := getS3ClientAndBucket(connInfo, connectionName, bucketName)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 180*time.Second)
+	defer cancel()
+
+	status, err := client.GetObjectLegalHold(ctx, systemId, objectName, minio.GetObjectLegalHoldOptions{VersionID: versionId})
+	if err != nil {
+		cblog.Errorf("Failed to get object legal hold: %v", err)
+		return "", err
+	}
+	if status == nil {
+		return string(minio.LegalHoldDisabled), nil
+	}
+	return status.String(), nil
+}
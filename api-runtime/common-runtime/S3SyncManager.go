@@ -0,0 +1,777 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// S3 Sync Manager — mirrors objects from a source bucket to a target bucket,
+// possibly of another connection(CSP), in the background.
+// The progress is kept in Spider MetaDB and the job resumes after a server restart.
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/rs/xid"
+
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// ====================================================================
+// type for GORM
+
+const (
+	S3SYNC_DEFAULT_PARALLELISM = 4
+	S3SYNC_MAX_PARALLELISM     = 16
+
+	// interval to save the progress of a running job
+	s3SyncSaveInterval = 3 * time.Second
+
+	// attempts to copy or delete an object before it is counted as failed
+	s3SyncMaxAttempts = 3
+	// max number of failed object keys kept in a job
+	s3SyncMaxFailedObjectList = 100
+)
+
+// ErrInvalidS3SyncRequest is returned when an S3 sync request is not valid.
+var ErrInvalidS3SyncRequest = fmt.Errorf("invalid S3 sync request")
+
+// ErrS3SyncTargetBusy is returned when the target bucket is busy with another S3 sync job.
+var ErrS3SyncTargetBusy = fmt.Errorf("S3 sync target conflict")
+
+// ErrS3SyncJobNotFound is returned when the S3 sync job does not exist.
+var ErrS3SyncJobNotFound = fmt.Errorf("S3 sync job not found")
+
+// ErrS3SyncJobStatus is returned when the S3 sync job cannot be canceled or deleted in its status,
+// ex) canceling a finished job or deleting a running job.
+var ErrS3SyncJobStatus = fmt.Errorf("S3 sync job status conflict")
+
+// S3 sync job phases
+const (
+	S3SyncPhaseListing  = "Listing"
+	S3SyncPhaseCopying  = "Copying"
+	S3SyncPhaseDeleting = "Deleting"
+	S3SyncPhaseDone     = "Done"
+)
+
+// S3SyncReqInfo is the request of an S3 sync job.
+type S3SyncReqInfo struct {
+	SourceConnectionName string `json:"SourceConnectionName" validate:"required" example:"aws-connection"`
+	SourceBucket         string `json:"SourceBucket" validate:"required" example:"log-bucket"`
+	SourcePrefix         string `json:"SourcePrefix,omitempty" example:"2026/"`
+	TargetConnectionName string `json:"TargetConnectionName" validate:"required" example:"ncp-connection"`
+	TargetBucket         string `json:"TargetBucket" validate:"required" example:"log-bucket-mirror"`
+	TargetPrefix         string `json:"TargetPrefix,omitempty" example:"2026/"` // replaces SourcePrefix in target keys
+	Incremental          bool   `json:"Incremental" example:"true"`             // skip objects with the same size and ETag
+	DeleteExtraneous     bool   `json:"DeleteExtraneous" example:"false"`       // delete target objects not in the source
+	Parallelism          int    `json:"Parallelism,omitempty" example:"4"`      // parallel transfers, default 4, max 16
+}
+
+// S3SyncJobInfo represents an S3 sync job and its progress.
+type S3SyncJobInfo struct {
+	JobId                string        `gorm:"primaryKey" json:"JobId" example:"cs6q5h2jpnmc73c0bfo0"`
+	SourceConnectionName string        `gorm:"index" json:"SourceConnectionName" example:"aws-connection"`
+	SourceBucket         string        `json:"SourceBucket" example:"log-bucket"`
+	SourcePrefix         string        `json:"SourcePrefix" example:"2026/"`
+	TargetConnectionName string        `gorm:"index" json:"TargetConnectionName" example:"ncp-connection"`
+	TargetBucket         string        `json:"TargetBucket" example:"log-bucket-mirror"`
+	TargetPrefix         string        `json:"TargetPrefix" example:"2026/"`
+	Incremental          bool          `json:"Incremental" example:"true"`
+	DeleteExtraneous     bool          `json:"DeleteExtraneous" example:"false"`
+	Parallelism          int           `json:"Parallelism" example:"4"`
+	Status               JobStatus     `gorm:"index" json:"Status" example:"Running"`             // Pending | Running | Succeeded | Failed
+	Phase                string        `json:"Phase" example:"Copying"`                           // Listing | Copying | Deleting | Done
+	Checkpoint           string        `json:"Checkpoint,omitempty" example:"2026/10/01/app.log"` // all source keys up to Checkpoint are copied or skipped
+	TotalObjects         int64         `json:"TotalObjects" example:"1000"`
+	TotalBytes           int64         `json:"TotalBytes" example:"1048576000"`
+	CopiedObjects        int64         `json:"CopiedObjects" example:"500"`
+	CopiedBytes          int64         `json:"CopiedBytes" example:"524288000"`
+	SkippedObjects       int64         `json:"SkippedObjects" example:"100"`
+	FailedObjects        int64         `json:"FailedObjects" example:"0"`
+	DeletedObjects       int64         `json:"DeletedObjects" example:"0"`
+	FailedObjectList     S3SyncKeyList `gorm:"type:text" json:"FailedObjectList,omitempty" swaggertype:"array,string"` // keys of the failed objects, up to 100
+	Error                string        `json:"Error,omitempty" example:""`                                             // error message if Failed, or the last object error
+	CreatedAt            time.Time     `json:"CreatedAt" example:"2026-10-01T12:00:00Z"`
+	StartedAt            *time.Time    `json:"StartedAt,omitempty"`
+	UpdatedAt            time.Time     `json:"UpdatedAt" example:"2026-10-01T12:05:00Z"`
+	FinishedAt           *time.Time    `json:"FinishedAt,omitempty"`
+}
+
+func (S3SyncJobInfo) TableName() string {
+	return "s3sync_job_infos"
+}
+
+// S3SyncKeyList represents a list of object keys.
+type S3SyncKeyList []string
+
+func (o *S3SyncKeyList) Scan(src any) error {
+	bytes := []byte(src.(string))
+	return json.Unmarshal(bytes, o)
+}
+
+func (o S3SyncKeyList) Value() (driver.Value, error) {
+	if len(o) == 0 {
+		return nil, nil
+	}
+	jsonData, err := json.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return string(jsonData), nil
+}
+
+//====================================================================
+
+func init() {
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	db.AutoMigrate(&S3SyncJobInfo{})
+	infostore.Close(db)
+}
+
+// s3SyncSubmitLock serializes the active job check and the job insertion.
+var s3SyncSubmitLock sync.Mutex
+
+// cancel functions of the jobs running in this server
+var s3SyncCancels = struct {
+	sync.Mutex
+	m map[string]context.CancelFunc
+}{m: map[string]context.CancelFunc{}}
+
+//================ S3 Sync Handler
+
+// StartS3Sync saves a new S3 sync job and runs it in the background.
+func StartS3Sync(reqInfo S3SyncReqInfo) (*S3SyncJobInfo, error) {
+	cblog.Info("call StartS3Sync()")
+
+	var err error
+	// check empty and trim user inputs
+	reqInfo.SourceConnectionName, err = EmptyCheckAndTrim("SourceConnectionName", reqInfo.SourceConnectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, fmt.Errorf("%w: %v", ErrInvalidS3SyncRequest, err)
+	}
+	reqInfo.SourceBucket, err = EmptyCheckAndTrim("SourceBucket", reqInfo.SourceBucket)
+	if err != nil {
+		cblog.Error(err)
+		return nil, fmt.Errorf("%w: %v", ErrInvalidS3SyncRequest, err)
+	}
+	reqInfo.TargetConnectionName, err = EmptyCheckAndTrim("TargetConnectionName", reqInfo.TargetConnectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, fmt.Errorf("%w: %v", ErrInvalidS3SyncRequest, err)
+	}
+	reqInfo.TargetBucket, err = EmptyCheckAndTrim("TargetBucket", reqInfo.TargetBucket)
+	if err != nil {
+		cblog.Error(err)
+		return nil, fmt.Errorf("%w: %v", ErrInvalidS3SyncRequest, err)
+	}
+
+	if reqInfo.Parallelism == 0 {
+		reqInfo.Parallelism = S3SYNC_DEFAULT_PARALLELISM
+	}
+	if reqInfo.Parallelism < 1 || reqInfo.Parallelism > S3SYNC_MAX_PARALLELISM {
+		err := fmt.Errorf("%w: Parallelism must be between 1 and %d", ErrInvalidS3SyncRequest, S3SYNC_MAX_PARALLELISM)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if reqInfo.SourceConnectionName == reqInfo.TargetConnectionName && reqInfo.SourceBucket == reqInfo.TargetBucket &&
+		(strings.HasPrefix(reqInfo.SourcePrefix, reqInfo.TargetPrefix) || strings.HasPrefix(reqInfo.TargetPrefix, reqInfo.SourcePrefix)) {
+		err := fmt.Errorf("%w: the source '%s/%s' and the target '%s/%s' of the same bucket overlap",
+			ErrInvalidS3SyncRequest, reqInfo.SourceBucket, reqInfo.SourcePrefix, reqInfo.TargetBucket, reqInfo.TargetPrefix)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// check both buckets before submitting the job, a bucket not registered is ErrNoSuchS3Bucket
+	if _, err := getS3BucketIIDInfo(reqInfo.SourceConnectionName, reqInfo.SourceBucket); err != nil {
+		cblog.Error(err)
+		return nil, fmt.Errorf("source bucket: %w", err)
+	}
+	if _, err := getS3BucketIIDInfo(reqInfo.TargetConnectionName, reqInfo.TargetBucket); err != nil {
+		cblog.Error(err)
+		return nil, fmt.Errorf("target bucket: %w", err)
+	}
+	if _, err := GetS3Bucket(reqInfo.SourceConnectionName, reqInfo.SourceBucket); err != nil {
+		cblog.Error(err)
+		return nil, fmt.Errorf("source bucket: %w", err)
+	}
+	if _, err := GetS3Bucket(reqInfo.TargetConnectionName, reqInfo.TargetBucket); err != nil {
+		cblog.Error(err)
+		return nil, fmt.Errorf("target bucket: %w", err)
+	}
+
+	s3SyncSubmitLock.Lock()
+	defer s3SyncSubmitLock.Unlock()
+
+	// only one job writes to a target bucket at a time
+	activeJob, err := getActiveS3SyncJob(reqInfo.TargetConnectionName, reqInfo.TargetBucket)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if activeJob != nil {
+		err := fmt.Errorf("%w: target bucket '%s' is busy with the S3 sync job '%s'", ErrS3SyncTargetBusy, reqInfo.TargetBucket, activeJob.JobId)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	jobInfo := S3SyncJobInfo{
+		JobId:                xid.New().String(),
+		SourceConnectionName: reqInfo.SourceConnectionName,
+		SourceBucket:         reqInfo.SourceBucket,
+		SourcePrefix:         reqInfo.SourcePrefix,
+		TargetConnectionName: reqInfo.TargetConnectionName,
+		TargetBucket:         reqInfo.TargetBucket,
+		TargetPrefix:         reqInfo.TargetPrefix,
+		Incremental:          reqInfo.Incremental,
+		DeleteExtraneous:     reqInfo.DeleteExtraneous,
+		Parallelism:          reqInfo.Parallelism,
+		Status:               JobPending,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+	err = infostore.Insert(&jobInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	ret := jobInfo
+	startS3SyncJob(jobInfo)
+
+	return &ret, nil
+}
+
+// ResumeS3SyncJobs restarts the S3 sync jobs interrupted by a server restart.
+// Objects up to the checkpoint of each job are not processed again.
+func ResumeS3SyncJobs() {
+	cblog.Info("call ResumeS3SyncJobs()")
+
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	defer infostore.Close(db)
+
+	jobInfoList := []S3SyncJobInfo{}
+	err = db.Where("status IN ?", []JobStatus{JobPending, JobRunning}).Order("created_at").Find(&jobInfoList).Error
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+
+	for _, jobInfo := range jobInfoList {
+		cblog.Infof("Resuming S3 sync job '%s' (%s/%s -> %s/%s) from checkpoint '%s'", jobInfo.JobId,
+			jobInfo.SourceConnectionName, jobInfo.SourceBucket, jobInfo.TargetConnectionName, jobInfo.TargetBucket, jobInfo.Checkpoint)
+		startS3SyncJob(jobInfo)
+	}
+}
+
+func startS3SyncJob(jobInfo S3SyncJobInfo) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s3SyncCancels.Lock()
+	s3SyncCancels.m[jobInfo.JobId] = cancel
+	s3SyncCancels.Unlock()
+
+	go func() {
+		defer func() {
+			s3SyncCancels.Lock()
+			delete(s3SyncCancels.m, jobInfo.JobId)
+			s3SyncCancels.Unlock()
+			cancel()
+		}()
+		runS3Sync(ctx, jobInfo)
+	}()
+}
+
+// getActiveS3SyncJob returns the Pending or Running job of the target bucket, or nil if none.
+func getActiveS3SyncJob(targetConnectionName, targetBucket string) (*S3SyncJobInfo, error) {
+	db, err := infostore.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer infostore.Close(db)
+
+	jobInfoList := []*S3SyncJobInfo{}
+	err = db.Where("target_connection_name = ? AND target_bucket = ? AND status IN ?",
+		targetConnectionName, targetBucket, []JobStatus{JobPending, JobRunning}).Limit(1).Find(&jobInfoList).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(jobInfoList) == 0 {
+		return nil, nil
+	}
+	return jobInfoList[0], nil
+}
+
+// s3SyncRun keeps the progress of a running job.
+type s3SyncRun struct {
+	mutex   sync.Mutex
+	jobInfo S3SyncJobInfo
+}
+
+func (r *s3SyncRun) update(f func(jobInfo *S3SyncJobInfo)) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	f(&r.jobInfo)
+}
+
+func (r *s3SyncRun) save() {
+	r.mutex.Lock()
+	r.jobInfo.UpdatedAt = time.Now().UTC()
+	jobInfo := r.jobInfo
+	r.mutex.Unlock()
+
+	if err := infostore.Insert(&jobInfo); err != nil {
+		cblog.Error(err)
+	}
+}
+
+func runS3Sync(ctx context.Context, jobInfo S3SyncJobInfo) {
+	run := &s3SyncRun{jobInfo: jobInfo}
+	run.update(func(j *S3SyncJobInfo) {
+		if j.StartedAt == nil {
+			startedAt := time.Now().UTC()
+			j.StartedAt = &startedAt
+		}
+		j.Status = JobRunning
+		j.Phase = S3SyncPhaseListing
+	})
+	run.save()
+
+	// save the progress periodically
+	saverDone := make(chan struct{})
+	saverStopped := make(chan struct{})
+	go func() {
+		defer close(saverStopped)
+		ticker := time.NewTicker(s3SyncSaveInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				run.save()
+			case <-saverDone:
+				return
+			}
+		}
+	}()
+
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("S3 sync job panicked: %v", r)
+			}
+		}()
+		return syncS3Objects(ctx, run)
+	}()
+
+	close(saverDone)
+	<-saverStopped
+
+	run.update(func(j *S3SyncJobInfo) {
+		finishedAt := time.Now().UTC()
+		j.FinishedAt = &finishedAt
+		switch {
+		case err != nil:
+			cblog.Error(err)
+			j.Status = JobFailed
+			j.Error = err.Error()
+		case j.FailedObjects > 0:
+			j.Status = JobFailed
+			j.Error = fmt.Sprintf("%d objects failed, last error: %s", j.FailedObjects, j.Error)
+		default:
+			j.Status = JobSucceeded
+			j.Phase = S3SyncPhaseDone
+		}
+	})
+	run.save()
+}
+
+// syncS3Objects copies the source objects after the checkpoint and deletes the extraneous target objects.
+func syncS3Objects(ctx context.Context, run *s3SyncRun) error {
+	jobInfo := run.jobInfo
+
+	srcObjects, err := listAllS3Objects(ctx, jobInfo.SourceConnectionName, jobInfo.SourceBucket, jobInfo.SourcePrefix)
+	if err != nil {
+		return fmt.Errorf("failed to list source objects: %w", err)
+	}
+	sort.Slice(srcObjects, func(i, j int) bool { return srcObjects[i].Key < srcObjects[j].Key })
+
+	tgtObjects := map[string]minio.ObjectInfo{}
+	if jobInfo.Incremental || jobInfo.DeleteExtraneous {
+		tgtList, err := listAllS3Objects(ctx, jobInfo.TargetConnectionName, jobInfo.TargetBucket, jobInfo.TargetPrefix)
+		if err != nil {
+			return fmt.Errorf("failed to list target objects: %w", err)
+		}
+		for _, obj := range tgtList {
+			tgtObjects[obj.Key] = obj
+		}
+	}
+
+	var totalBytes int64
+	for _, obj := range srcObjects {
+		totalBytes += obj.Size
+	}
+
+	// objects up to the checkpoint were processed before the restart
+	todo := s3SyncObjectsAfter(srcObjects, jobInfo.Checkpoint)
+
+	run.update(func(j *S3SyncJobInfo) {
+		j.TotalObjects = int64(len(srcObjects))
+		j.TotalBytes = totalBytes
+		j.Phase = S3SyncPhaseCopying
+		// the failed objects are after the checkpoint and are tried again
+		j.FailedObjects = 0
+		j.FailedObjectList = nil
+	})
+
+	markDone := run.checkpointMarker(todo)
+
+	runS3SyncWorkers(ctx, jobInfo.Parallelism, len(todo), func(i int) {
+		src := todo[i]
+		tgtKey := jobInfo.TargetPrefix + strings.TrimPrefix(src.Key, jobInfo.SourcePrefix)
+		if tgt, ok := tgtObjects[tgtKey]; ok && jobInfo.Incremental && isSameS3Object(src, tgt) {
+			run.update(func(j *S3SyncJobInfo) { j.SkippedObjects++ })
+			markDone(i)
+			return
+		}
+
+		err := retryS3SyncObject(ctx, func() error {
+			_, err := CopyS3ObjectAcrossConnections(jobInfo.SourceConnectionName, jobInfo.SourceBucket, src.Key, "",
+				jobInfo.TargetConnectionName, jobInfo.TargetBucket, tgtKey, nil)
+			return err
+		})
+		if err != nil {
+			cblog.Errorf("S3 sync job '%s': failed to copy '%s': %v", jobInfo.JobId, src.Key, err)
+			run.update(func(j *S3SyncJobInfo) { j.addFailedObject(src.Key, err) })
+			return
+		}
+		run.update(func(j *S3SyncJobInfo) {
+			j.CopiedObjects++
+			j.CopiedBytes += src.Size
+		})
+		markDone(i)
+	})
+	if ctx.Err() != nil {
+		return fmt.Errorf("S3 sync job was canceled")
+	}
+
+	if !jobInfo.DeleteExtraneous {
+		return nil
+	}
+
+	run.update(func(j *S3SyncJobInfo) { j.Phase = S3SyncPhaseDeleting })
+
+	srcKeys := make(map[string]bool, len(srcObjects))
+	for _, obj := range srcObjects {
+		srcKeys[jobInfo.TargetPrefix+strings.TrimPrefix(obj.Key, jobInfo.SourcePrefix)] = true
+	}
+	var extraneous []string
+	for key := range tgtObjects {
+		if !srcKeys[key] {
+			extraneous = append(extraneous, key)
+		}
+	}
+	sort.Strings(extraneous)
+
+	runS3SyncWorkers(ctx, jobInfo.Parallelism, len(extraneous), func(i int) {
+		err := retryS3SyncObject(ctx, func() error {
+			_, err := DeleteS3Object(jobInfo.TargetConnectionName, jobInfo.TargetBucket, extraneous[i])
+			return err
+		})
+		if err != nil {
+			cblog.Errorf("S3 sync job '%s': failed to delete '%s': %v", jobInfo.JobId, extraneous[i], err)
+			run.update(func(j *S3SyncJobInfo) { j.addFailedObject(extraneous[i], err) })
+			return
+		}
+		run.update(func(j *S3SyncJobInfo) { j.DeletedObjects++ })
+	})
+	if ctx.Err() != nil {
+		return fmt.Errorf("S3 sync job was canceled")
+	}
+
+	return nil
+}
+
+// s3SyncObjectsAfter returns the objects after the checkpoint from the objects sorted by the key.
+func s3SyncObjectsAfter(srcObjects []minio.ObjectInfo, checkpoint string) []minio.ObjectInfo {
+	if checkpoint == "" {
+		return srcObjects
+	}
+	start := sort.Search(len(srcObjects), func(i int) bool { return srcObjects[i].Key > checkpoint })
+	return srcObjects[start:]
+}
+
+// checkpointMarker returns the function to mark todo[i] as copied or skipped.
+// The checkpoint moves forward only over contiguous marked objects,
+// so a failed object is tried again when the job resumes.
+func (r *s3SyncRun) checkpointMarker(todo []minio.ObjectInfo) func(i int) {
+	done := make([]bool, len(todo))
+	next := 0
+	return func(i int) {
+		r.update(func(j *S3SyncJobInfo) {
+			done[i] = true
+			for next < len(todo) && done[next] {
+				j.Checkpoint = todo[next].Key
+				next++
+			}
+		})
+	}
+}
+
+// retryS3SyncObject calls f up to s3SyncMaxAttempts times until it succeeds or ctx is canceled.
+func retryS3SyncObject(ctx context.Context, f func() error) error {
+	var err error
+	for attempt := 1; attempt <= s3SyncMaxAttempts; attempt++ {
+		if err = f(); err == nil {
+			return nil
+		}
+		if attempt == s3SyncMaxAttempts {
+			break
+		}
+		select {
+		case <-time.After(time.Duration(attempt) * time.Second):
+		case <-ctx.Done():
+			return err
+		}
+	}
+	return err
+}
+
+// addFailedObject counts a failed object and keeps its key and the last error.
+func (j *S3SyncJobInfo) addFailedObject(key string, err error) {
+	j.FailedObjects++
+	if len(j.FailedObjectList) < s3SyncMaxFailedObjectList {
+		j.FailedObjectList = append(j.FailedObjectList, key)
+	}
+	j.Error = fmt.Sprintf("%s: %v", key, err)
+}
+
+// runS3SyncWorkers calls work(0..count-1) with at most parallelism goroutines until ctx is canceled.
+func runS3SyncWorkers(ctx context.Context, parallelism int, count int, work func(i int)) {
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < parallelism; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				work(i)
+			}
+		}()
+	}
+
+	for i := 0; i < count; i++ {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(indexes)
+	wg.Wait()
+}
+
+var md5ETagPattern = regexp.MustCompile(`^[0-9a-fA-F]{32}$`)
+
+// isSameS3Object compares the size, and the ETag if both are MD5 digests.
+// ETags of multipart uploads and of some CSPs(ex: Azure) are not MD5 digests,
+// so the source must not be modified after the target was written.
+func isSameS3Object(src, tgt minio.ObjectInfo) bool {
+	if src.Size != tgt.Size {
+		return false
+	}
+	srcETag := strings.Trim(src.ETag, `"`)
+	tgtETag := strings.Trim(tgt.ETag, `"`)
+	if md5ETagPattern.MatchString(srcETag) && md5ETagPattern.MatchString(tgtETag) {
+		return strings.EqualFold(srcETag, tgtETag)
+	}
+	return !src.LastModified.After(tgt.LastModified)
+}
+
+// listAllS3Objects lists all objects under the prefix.
+// Unlike ListS3Objects, it fails on a listing error, since a partial list can delete valid target objects.
+func listAllS3Objects(ctx context.Context, connectionName, bucketName, prefix string) ([]minio.ObjectInfo, error) {
+	var iidInfo S3BucketIIDInfo
+	err := infostore.GetByConditions(&iidInfo, "connection_name", connectionName, "name_id", bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	connInfo, err := GetS3ConnectionInfo(connectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Azure: use Azure Blob SDK
+	if connInfo.ProviderName == "AZURE" {
+		return listAzureObjects(connInfo, iidInfo.SystemId, prefix)
+	}
+
+	client, err := NewS3Client(connInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []minio.ObjectInfo
+	for obj := range client.ListObjects(ctx, iidInfo.SystemId, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		out = append(out, obj)
+	}
+	return out, nil
+}
+
+// GetS3SyncJob returns the S3 sync job info of the given job ID.
+func GetS3SyncJob(jobID string) (*S3SyncJobInfo, error) {
+	cblog.Info("call GetS3SyncJob()")
+
+	// check empty and trim user inputs
+	jobID, err := EmptyCheckAndTrim("jobID", jobID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var jobInfo S3SyncJobInfo
+	err = infostore.Get(&jobInfo, JOB_ID_COLUMN, jobID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, fmt.Errorf("%w: S3 sync job '%s' does not exist", ErrS3SyncJobNotFound, jobID)
+	}
+
+	return &jobInfo, nil
+}
+
+// ListS3SyncJob returns the S3 sync job list, newest first.
+// If connectionName is empty, jobs of all connections are returned,
+// otherwise the jobs whose source or target is the connection.
+func ListS3SyncJob(connectionName string) ([]*S3SyncJobInfo, error) {
+	cblog.Info("call ListS3SyncJob()")
+
+	connectionName = strings.TrimSpace(connectionName)
+
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	defer infostore.Close(db)
+
+	query := db.Order("created_at desc")
+	if connectionName != "" {
+		query = query.Where("source_connection_name = ? OR target_connection_name = ?", connectionName, connectionName)
+	}
+
+	jobInfoList := []*S3SyncJobInfo{}
+	if err := query.Find(&jobInfoList).Error; err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return jobInfoList, nil
+}
+
+// CancelS3SyncJob stops a Pending or Running S3 sync job.
+// The objects in transfer are completed, and the job becomes Failed.
+func CancelS3SyncJob(jobID string) (bool, error) {
+	cblog.Info("call CancelS3SyncJob()")
+
+	jobInfo, err := GetS3SyncJob(jobID)
+	if err != nil {
+		return false, err
+	}
+
+	if jobInfo.Status != JobPending && jobInfo.Status != JobRunning {
+		err := fmt.Errorf("%w: S3 sync job '%s' is already %s", ErrS3SyncJobStatus, jobInfo.JobId, jobInfo.Status)
+		cblog.Error(err)
+		return false, err
+	}
+
+	s3SyncCancels.Lock()
+	cancel, ok := s3SyncCancels.m[jobInfo.JobId]
+	s3SyncCancels.Unlock()
+	if ok {
+		cancel()
+		return true, nil
+	}
+
+	// not running in this server: update only if the job is still Pending or Running,
+	// since the job can be finished or resumed after it was read
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	defer infostore.Close(db)
+
+	finishedAt := time.Now().UTC()
+	result := db.Model(&S3SyncJobInfo{}).
+		Where(JOB_ID_COLUMN+" = ? AND status IN ?", jobInfo.JobId, []JobStatus{JobPending, JobRunning}).
+		Updates(map[string]interface{}{
+			"status":      JobFailed,
+			"error":       "S3 sync job was canceled",
+			"finished_at": &finishedAt,
+			"updated_at":  finishedAt,
+		})
+	if result.Error != nil {
+		cblog.Error(result.Error)
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		latest, err := GetS3SyncJob(jobInfo.JobId)
+		if err != nil {
+			return false, err
+		}
+		err = fmt.Errorf("%w: S3 sync job '%s' is already %s", ErrS3SyncJobStatus, latest.JobId, latest.Status)
+		cblog.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// DeleteS3SyncJob removes a finished S3 sync job from the job list.
+func DeleteS3SyncJob(jobID string) (bool, error) {
+	cblog.Info("call DeleteS3SyncJob()")
+
+	jobInfo, err := GetS3SyncJob(jobID)
+	if err != nil {
+		return false, err
+	}
+
+	if jobInfo.Status == JobPending || jobInfo.Status == JobRunning {
+		err := fmt.Errorf("%w: S3 sync job '%s' is %s. Cancel it before deleting", ErrS3SyncJobStatus, jobInfo.JobId, jobInfo.Status)
+		cblog.Error(err)
+		return false, err
+	}
+
+	_, err = infostore.DeleteByCondition(&S3SyncJobInfo{}, JOB_ID_COLUMN, jobInfo.JobId)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	return true, nil
+}
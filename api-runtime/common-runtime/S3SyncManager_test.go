@@ -0,0 +1,178 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+func TestIsSameS3Object(t *testing.T) {
+	written := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	md5A := "\"d8e8fca2dc0f896fd7cb4cb0031ba249\""
+	md5B := "\"5d41402abc4b2a76b9719d911017c592\""
+	multipart := "\"3858f62230ac3c915f300c664312c11f-2\""
+
+	testList := []struct {
+		name string
+		src  minio.ObjectInfo
+		tgt  minio.ObjectInfo
+		same bool
+	}{
+		{"same MD5",
+			minio.ObjectInfo{Size: 10, ETag: md5A, LastModified: written.Add(time.Hour)},
+			minio.ObjectInfo{Size: 10, ETag: md5A, LastModified: written}, true},
+		{"different MD5",
+			minio.ObjectInfo{Size: 10, ETag: md5A, LastModified: written},
+			minio.ObjectInfo{Size: 10, ETag: md5B, LastModified: written}, false},
+		{"different size",
+			minio.ObjectInfo{Size: 10, ETag: md5A, LastModified: written},
+			minio.ObjectInfo{Size: 11, ETag: md5A, LastModified: written}, false},
+		{"multipart not modified",
+			minio.ObjectInfo{Size: 10, ETag: multipart, LastModified: written.Add(-time.Hour)},
+			minio.ObjectInfo{Size: 10, ETag: md5A, LastModified: written}, true},
+		{"multipart modified after the copy",
+			minio.ObjectInfo{Size: 10, ETag: multipart, LastModified: written.Add(time.Hour)},
+			minio.ObjectInfo{Size: 10, ETag: md5A, LastModified: written}, false},
+	}
+
+	for _, test := range testList {
+		if same := isSameS3Object(test.src, test.tgt); same != test.same {
+			t.Errorf("%s: expected %v, got %v", test.name, test.same, same)
+		}
+	}
+}
+
+func TestAddFailedObject(t *testing.T) {
+	var jobInfo S3SyncJobInfo
+	for i := 0; i < s3SyncMaxFailedObjectList+10; i++ {
+		jobInfo.addFailedObject("key", ErrNoSuchS3Bucket)
+	}
+	if jobInfo.FailedObjects != s3SyncMaxFailedObjectList+10 {
+		t.Errorf("expected %d failed objects, got %d", s3SyncMaxFailedObjectList+10, jobInfo.FailedObjects)
+	}
+	if len(jobInfo.FailedObjectList) != s3SyncMaxFailedObjectList {
+		t.Errorf("expected %d failed object keys, got %d", s3SyncMaxFailedObjectList, len(jobInfo.FailedObjectList))
+	}
+}
+
+func TestRunS3SyncWorkers(t *testing.T) {
+	testList := []struct {
+		parallelism int
+		count       int
+	}{
+		{1, 0},
+		{1, 5},
+		{3, 10},
+		{8, 3},
+	}
+
+	for _, test := range testList {
+		var running, maxRunning int32
+		var lock sync.Mutex
+		calledMap := map[int]int{}
+
+		runS3SyncWorkers(context.Background(), test.parallelism, test.count, func(i int) {
+			now := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				old := atomic.LoadInt32(&maxRunning)
+				if now <= old || atomic.CompareAndSwapInt32(&maxRunning, old, now) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+
+			lock.Lock()
+			calledMap[i]++
+			lock.Unlock()
+		})
+
+		if int(maxRunning) > test.parallelism {
+			t.Errorf("parallelism %d: %d concurrent calls", test.parallelism, maxRunning)
+		}
+		for i := 0; i < test.count; i++ {
+			if calledMap[i] != 1 {
+				t.Errorf("parallelism %d, count %d: work(%d) is called %d times", test.parallelism, test.count, i, calledMap[i])
+			}
+		}
+	}
+
+	// no more work after the cancel
+	ctx, cancel := context.WithCancel(context.Background())
+	var called int32
+	runS3SyncWorkers(ctx, 2, 100, func(i int) {
+		if atomic.AddInt32(&called, 1) == 4 {
+			cancel()
+		}
+	})
+	if called >= 100 {
+		t.Errorf("%d works are called after the cancel", called)
+	}
+}
+
+func TestS3SyncCheckpointResume(t *testing.T) {
+	srcObjects := []minio.ObjectInfo{}
+	for i := 0; i < 10; i++ {
+		srcObjects = append(srcObjects, minio.ObjectInfo{Key: fmt.Sprintf("obj-%02d", i)})
+	}
+
+	// (1) the first run: obj-04 fails, the others are done in random order
+	run := &s3SyncRun{}
+	todo := s3SyncObjectsAfter(srcObjects, run.jobInfo.Checkpoint)
+	if len(todo) != len(srcObjects) {
+		t.Fatalf("%d objects to do without the checkpoint, expected %d", len(todo), len(srcObjects))
+	}
+	markDone := run.checkpointMarker(todo)
+	runS3SyncWorkers(context.Background(), 4, len(todo), func(i int) {
+		if todo[i].Key == "obj-04" {
+			return
+		}
+		markDone(i)
+	})
+	if run.jobInfo.Checkpoint != "obj-03" {
+		t.Errorf("checkpoint %q is not before the failed object obj-04", run.jobInfo.Checkpoint)
+	}
+
+	// (2) the resumed run starts at the failed object and all objects are done
+	todo = s3SyncObjectsAfter(srcObjects, run.jobInfo.Checkpoint)
+	if len(todo) != 6 || todo[0].Key != "obj-04" {
+		t.Fatalf("%d objects to do after %q, first %q", len(todo), run.jobInfo.Checkpoint, todo[0].Key)
+	}
+	var lock sync.Mutex
+	doneKeys := []string{}
+	markDone = run.checkpointMarker(todo)
+	runS3SyncWorkers(context.Background(), 4, len(todo), func(i int) {
+		lock.Lock()
+		doneKeys = append(doneKeys, todo[i].Key)
+		lock.Unlock()
+		markDone(i)
+	})
+	if len(doneKeys) != 6 {
+		t.Errorf("%d objects are done in the resumed run: %v", len(doneKeys), doneKeys)
+	}
+	if run.jobInfo.Checkpoint != "obj-09" {
+		t.Errorf("checkpoint %q is not the last object", run.jobInfo.Checkpoint)
+	}
+
+	// (3) nothing to do after the last object
+	if todo = s3SyncObjectsAfter(srcObjects, run.jobInfo.Checkpoint); len(todo) != 0 {
+		t.Errorf("%d objects to do after the last object", len(todo))
+	}
+	// a deleted checkpoint object: the next objects are done
+	if todo = s3SyncObjectsAfter(srcObjects, "obj-05x"); len(todo) != 4 || todo[0].Key != "obj-06" {
+		t.Errorf("%d objects to do after the deleted checkpoint object", len(todo))
+	}
+}
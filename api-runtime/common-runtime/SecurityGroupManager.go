@@ -10,8 +10,10 @@ package commonruntime
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"strings"
+	"unicode"
 
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
@@ -281,6 +283,7 @@ func RegisterSecurity(connectionName string, vpcUserID string, userIID cres.IID)
 	// IPProtocol: to upper
 	// no CIDR: "0.0.0.0/0"
 	transformArgs(getInfo.SecurityRules)
+	setSourceSecurityGroupUserIID(connectionName, getInfo.SecurityRules)
 
 	// (3) create spiderIID: {UserID, SP-XID:CSP-ID}
 	//     ex) spiderIID {"vpc-01", "vpc-01-9m4e2mr0ui3e8a215n4g:i-0bc7123b7e5cbf79d"}
@@ -394,6 +397,18 @@ func CreateSecurity(connectionName string, rsType string, reqInfo cres.SecurityR
 		return nil, err
 	}
 
+	// check the rule options, and set the driver IID of the source SecurityGroups
+	err = validateSecurityRules(connectionName, reqInfo.SecurityRules)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	err = setSourceSecurityGroupDriverIID(connectionName, reqInfo.SecurityRules)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	// Direction: to lower
 	// IPProtocol: to upper
 	// no CIDR: "0.0.0.0/0"
@@ -464,6 +479,7 @@ func CreateSecurity(connectionName string, rsType string, reqInfo cres.SecurityR
 	// IPProtocol: to upper
 	// no CIDR: "0.0.0.0/0"
 	transformArgs(info.SecurityRules)
+	setSourceSecurityGroupUserIID(connectionName, info.SecurityRules)
 
 	// set VPC NameId
 	info.VpcIID.NameId = reqInfo.VpcIID.NameId
@@ -504,13 +520,160 @@ func transformArgs(ruleList *[]cres.SecurityRuleInfo) {
 		(*ruleList)[n].Direction = strings.ToLower((*ruleList)[n].Direction)
 		// IPProtocol: to upper => ALL | TCP | UDP | ICMP
 		(*ruleList)[n].IPProtocol = strings.ToUpper((*ruleList)[n].IPProtocol)
-		// no CIDR, set default ("0.0.0.0/0")
-		if (*ruleList)[n].CIDR == "" {
+		// no CIDR, CIDRv6 and source SG, set default ("0.0.0.0/0")
+		if (*ruleList)[n].CIDR == "" && (*ruleList)[n].CIDRv6 == "" && (*ruleList)[n].SourceSecurityGroupIID == nil {
 			(*ruleList)[n].CIDR = "0.0.0.0/0"
 		}
 	}
 }
 
+const maxSecurityRuleDescriptionLength = 255
+
+// validateSecurityRules checks the optional fields of the rules: CIDR, CIDRv6, SourceSecurityGroupIID and Description,
+// and checks if the driver of the connection supports the fields in use.
+func validateSecurityRules(connectionName string, ruleList *[]cres.SecurityRuleInfo) error {
+	if ruleList == nil {
+		return nil
+	}
+
+	checkedCapabilities := map[CapabilityType]bool{}
+	checkRuleCapability := func(capability CapabilityType) error {
+		if checkedCapabilities[capability] {
+			return nil
+		}
+		if err := checkCapability(connectionName, capability); err != nil {
+			return err
+		}
+		checkedCapabilities[capability] = true
+		return nil
+	}
+
+	for _, rule := range *ruleList {
+		targetCount := 0
+		// CIDR is passed to the driver as before, it can be an IPv6 CIDR for the drivers which accept it.
+		// SG_RULE_IPV6_CIDR is for the separate CIDRv6 field.
+		if rule.CIDR != "" {
+			targetCount++
+		}
+		if rule.CIDRv6 != "" {
+			targetCount++
+			ip, _, err := net.ParseCIDR(rule.CIDRv6)
+			if err != nil || ip.To4() != nil {
+				return fmt.Errorf("CIDRv6 '%s' is not a valid IPv6 CIDR", rule.CIDRv6)
+			}
+			if err := checkRuleCapability(SG_RULE_IPV6_CIDR); err != nil {
+				return err
+			}
+		}
+		if rule.SourceSecurityGroupIID != nil {
+			targetCount++
+			if strings.TrimSpace(rule.SourceSecurityGroupIID.NameId) == "" {
+				return fmt.Errorf("SourceSecurityGroupIID.NameId is empty")
+			}
+			if err := checkRuleCapability(SG_RULE_SOURCE_SG); err != nil {
+				return err
+			}
+		}
+		if targetCount > 1 {
+			return fmt.Errorf("only one of CIDR, CIDRv6 and SourceSecurityGroupIID can be set in a rule: %v", rule)
+		}
+
+		if rule.Description != "" {
+			if len([]rune(rule.Description)) > maxSecurityRuleDescriptionLength {
+				return fmt.Errorf("the Description of a rule is longer than %d characters: %s", maxSecurityRuleDescriptionLength, rule.Description)
+			}
+			if strings.IndexFunc(rule.Description, unicode.IsControl) >= 0 {
+				return fmt.Errorf("the Description of a rule has a control character: %q", rule.Description)
+			}
+			if err := checkRuleCapability(SG_RULE_DESCRIPTION); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setSourceSecurityGroupDriverIID replaces the SourceSecurityGroupIID(user NameId) of the rules with the driver IID.
+func setSourceSecurityGroupDriverIID(connectionName string, ruleList *[]cres.SecurityRuleInfo) error {
+	if ruleList == nil {
+		return nil
+	}
+	for n := range *ruleList {
+		sourceIID := (*ruleList)[n].SourceSecurityGroupIID
+		if sourceIID == nil {
+			continue
+		}
+		nameId := strings.TrimSpace(sourceIID.NameId)
+
+		var iidInfo SGIIDInfo
+		if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+			var iidInfoList []*SGIIDInfo
+			err := getAuthIIDInfoList(connectionName, &iidInfoList)
+			if err != nil {
+				cblog.Error(err)
+				return err
+			}
+			castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, nameId)
+			if err != nil {
+				cblog.Error(err)
+				return err
+			}
+			iidInfo = *castedIIDInfo.(*SGIIDInfo)
+		} else {
+			err := infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, nameId)
+			if err != nil {
+				cblog.Error(err)
+				return fmt.Errorf("the source %s '%s' of a rule does not exist: %v", RSTypeString(SG), nameId, err)
+			}
+		}
+
+		driverIID := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+		(*ruleList)[n].SourceSecurityGroupIID = &driverIID
+	}
+	return nil
+}
+
+// setSourceSecurityGroupUserIID sets the NameId of the SourceSecurityGroupIID(CSP ID) of the rules.
+// The NameId is empty if the source SecurityGroup is not managed by Spider.
+func setSourceSecurityGroupUserIID(connectionName string, ruleList *[]cres.SecurityRuleInfo) {
+	if ruleList == nil {
+		return
+	}
+	for n := range *ruleList {
+		sourceIID := (*ruleList)[n].SourceSecurityGroupIID
+		if sourceIID == nil || sourceIID.SystemId == "" {
+			continue
+		}
+
+		var iidInfo SGIIDInfo
+		if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+			var iidInfoList []*SGIIDInfo
+			err := getAuthIIDInfoList(connectionName, &iidInfoList)
+			if err != nil {
+				cblog.Error(err)
+			}
+			castedIIDInfo, err := getAuthIIDInfoBySystemIdContain(&iidInfoList, sourceIID.SystemId)
+			if err != nil && !checkNotFoundError(err) {
+				cblog.Error(err)
+			}
+			if castedIIDInfo != nil {
+				iidInfo = *castedIIDInfo.(*SGIIDInfo)
+			}
+		} else {
+			err := infostore.GetByContain(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, SYSTEM_ID_COLUMN, sourceIID.SystemId)
+			if err != nil && !checkNotFoundError(err) {
+				cblog.Error(err)
+			}
+		}
+
+		userIID := cres.IID{SystemId: sourceIID.SystemId}
+		if iidInfo.NameId != "" {
+			userIID = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+		}
+		(*ruleList)[n].SourceSecurityGroupIID = &userIID
+	}
+}
+
 // (1) get IID:list
 // (2) get SecurityInfo:list
 // (3) set userIID, and ...
@@ -581,6 +744,7 @@ func ListSecurity(connectionName string, rsType string) ([]*cres.SecurityInfo, e
 		// IPProtocol: to upper
 		// no CIDR: "0.0.0.0/0"
 		transformArgs(info.SecurityRules)
+		setSourceSecurityGroupUserIID(connectionName, info.SecurityRules)
 
 		// (3) set ResourceInfo(IID.NameId)
 		// set ResourceInfo
@@ -710,6 +874,7 @@ func ListVpcSecurity(connectionName, rsType, vpcName string) ([]*cres.SecurityIn
 
 		//Transform security rules
 		transformArgs(info.SecurityRules)
+		setSourceSecurityGroupUserIID(connectionName, info.SecurityRules)
 
 		// Set resource info
 		info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
@@ -804,6 +969,7 @@ func GetSecurity(connectionName string, rsType string, nameID string) (*cres.Sec
 	// IPProtocol: to upper
 	// no CIDR: "0.0.0.0/0"
 	transformArgs(info.SecurityRules)
+	setSourceSecurityGroupUserIID(connectionName, info.SecurityRules)
 
 	// (3) set ResourceInfo(IID.NameId)
 	// set ResourceInfo
@@ -866,6 +1032,18 @@ func AddRules(connectionName string, sgName string, reqInfoList []cres.SecurityR
 		return nil, err
 	}
 
+	// check the rule options, and set the driver IID of the source SecurityGroups
+	err = validateSecurityRules(connectionName, &reqInfoList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	err = setSourceSecurityGroupDriverIID(connectionName, &reqInfoList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	// Direction: to lower
 	// IPProtocol: to upper
 	// no CIDR: "0.0.0.0/0"
@@ -934,6 +1112,7 @@ func AddRules(connectionName string, sgName string, reqInfoList []cres.SecurityR
 	// IPProtocol: to upper
 	// no CIDR: "0.0.0.0/0"
 	transformArgs(info.SecurityRules)
+	setSourceSecurityGroupUserIID(connectionName, info.SecurityRules)
 
 	// (3) set ResourceInfo(userIID)
 	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
@@ -995,6 +1174,18 @@ func RemoveRules(connectionName string, sgName string, reqRuleInfoList []cres.Se
 		return false, err
 	}
 
+	// check the rule options, and set the driver IID of the source SecurityGroups
+	err = validateSecurityRules(connectionName, &reqRuleInfoList)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	err = setSourceSecurityGroupDriverIID(connectionName, &reqRuleInfoList)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
 	// Direction: to lower
 	// IPProtocol: to upper
 	// no CIDR: "0.0.0.0/0"
@@ -0,0 +1,313 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Security Rule Sync Manager — applies the desired rule set of a SecurityGroup with the diff of the current rules.
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// SecurityRuleDiffInfo: the diff between the current rules and the desired rules of a SecurityGroup.
+type SecurityRuleDiffInfo struct {
+	SGName         string `json:"SGName" example:"sg-01"`
+	DryRun         bool   `json:"DryRun" example:"false"`
+	Applied        bool   `json:"Applied" example:"true"` // false on dry-run or when there is no diff
+	UnchangedCount int    `json:"UnchangedCount" example:"3"`
+
+	AddedRuleList   []cres.SecurityRuleInfo `json:"AddedRuleList"`   // rules to add(dry-run) or added
+	RemovedRuleList []cres.SecurityRuleInfo `json:"RemovedRuleList"` // rules to remove(dry-run) or removed
+	SkippedRuleList []cres.SecurityRuleInfo `json:"SkippedRuleList"` // current rules not comparable(ex: Azure service tag), kept as they are
+}
+
+// securityRuleKey: a rule normalized across the CSP conventions, the Description is not compared.
+type securityRuleKey struct {
+	Direction  string
+	IPProtocol string
+	FromPort   string
+	ToPort     string
+	Target     string // "cidr:0.0.0.0/0", "cidr6:::/0" or "sg:<CSP ID>"
+}
+
+// SyncRules makes the rules of a SecurityGroup the same as the desired rules.
+// The missing rules are added before the extra rules are removed not to break the connections in use.
+// If removing fails, the added rules are removed again.
+// The current rules with a target that is not a CIDR(ex: Azure service tag) are skipped and kept as they are.
+// (1) check the desired rules
+// (2) get the current rules and compute the diff
+// (3) add and remove the rules
+func SyncRules(connectionName string, sgName string, desiredRuleList []cres.SecurityRuleInfo, dryRun bool) (*SecurityRuleDiffInfo, error) {
+	cblog.Info("call SyncRules()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	sgName, err = EmptyCheckAndTrim("sgName", sgName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateSecurityHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (1) check the desired rules
+	err = validateSecurityRules(connectionName, &desiredRuleList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	err = setSourceSecurityGroupDriverIID(connectionName, &desiredRuleList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	// Direction: to lower
+	// IPProtocol: to upper
+	// no CIDR: "0.0.0.0/0"
+	transformArgs(&desiredRuleList)
+
+	if dryRun {
+		sgSPLock.RLock(connectionName, sgName)
+		defer sgSPLock.RUnlock(connectionName, sgName)
+	} else {
+		sgSPLock.Lock(connectionName, sgName)
+		defer sgSPLock.Unlock(connectionName, sgName)
+	}
+
+	var iidInfo SGIIDInfo
+	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+		var iidInfoList []*SGIIDInfo
+		err = getAuthIIDInfoList(connectionName, &iidInfoList)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, sgName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		iidInfo = *castedIIDInfo.(*SGIIDInfo)
+	} else {
+		err = infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, sgName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+	driverIID := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+
+	// (2) get the current rules and compute the diff
+	info, err := handler.GetSecurity(driverIID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	currentRuleList := []cres.SecurityRuleInfo{}
+	if info.SecurityRules != nil {
+		currentRuleList = *info.SecurityRules
+	}
+	transformArgs(&currentRuleList)
+
+	addRuleList, removeRuleList, skippedRuleList, unchangedCount, err := diffSecurityRules(currentRuleList, desiredRuleList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	for _, rule := range skippedRuleList {
+		cblog.Infof("SyncRules(): the rule %v of the SecurityGroup '%s' is skipped, not comparable", rule, sgName)
+	}
+
+	diffInfo := &SecurityRuleDiffInfo{
+		SGName:          sgName,
+		DryRun:          dryRun,
+		UnchangedCount:  unchangedCount,
+		AddedRuleList:   addRuleList,
+		RemovedRuleList: removeRuleList,
+		SkippedRuleList: skippedRuleList,
+	}
+
+	// (3) add and remove the rules
+	if !dryRun && (len(addRuleList) > 0 || len(removeRuleList) > 0) {
+		if len(addRuleList) > 0 {
+			_, err = handler.AddRules(driverIID, &addRuleList)
+			if err != nil {
+				cblog.Error(err)
+				return nil, fmt.Errorf("failed to add the rules, no rule is removed: %v", err)
+			}
+		}
+		if len(removeRuleList) > 0 {
+			_, err = handler.RemoveRules(driverIID, &removeRuleList)
+			if err != nil {
+				cblog.Error(err)
+				// rollback
+				if len(addRuleList) > 0 {
+					_, err2 := handler.RemoveRules(driverIID, &addRuleList)
+					if err2 != nil {
+						cblog.Error(err2)
+						return nil, fmt.Errorf("failed to remove the rules: %v, and failed to remove the added rules: %v", err, err2)
+					}
+				}
+				return nil, fmt.Errorf("failed to remove the rules, the added rules are removed: %v", err)
+			}
+		}
+		diffInfo.Applied = true
+	}
+
+	setSourceSecurityGroupUserIID(connectionName, &diffInfo.AddedRuleList)
+	setSourceSecurityGroupUserIID(connectionName, &diffInfo.RemovedRuleList)
+
+	return diffInfo, nil
+}
+
+// diffSecurityRules returns the rules to add(desired only), the rules to remove(current only),
+// the current rules not comparable and the number of the same rules.
+// The rules to remove keep the form of the current rules given by the driver.
+func diffSecurityRules(currentRuleList []cres.SecurityRuleInfo, desiredRuleList []cres.SecurityRuleInfo) ([]cres.SecurityRuleInfo, []cres.SecurityRuleInfo, []cres.SecurityRuleInfo, int, error) {
+	currentKeyMap := map[securityRuleKey]bool{}
+	skippedRuleList := []cres.SecurityRuleInfo{}
+	comparableRuleList := []cres.SecurityRuleInfo{}
+	for _, rule := range currentRuleList {
+		key, err := normalizeSecurityRule(rule)
+		if err != nil {
+			// ex) Azure service tag: "VirtualNetwork", "Internet"
+			skippedRuleList = append(skippedRuleList, rule)
+			continue
+		}
+		currentKeyMap[key] = true
+		comparableRuleList = append(comparableRuleList, rule)
+	}
+
+	addRuleList := []cres.SecurityRuleInfo{}
+	desiredKeyMap := map[securityRuleKey]bool{}
+	for _, rule := range desiredRuleList {
+		key, err := normalizeSecurityRule(rule)
+		if err != nil {
+			return nil, nil, nil, 0, fmt.Errorf("desired rule %v: %v", rule, err)
+		}
+		if desiredKeyMap[key] { // duplicated in the desired rules
+			continue
+		}
+		desiredKeyMap[key] = true
+		if !currentKeyMap[key] {
+			addRuleList = append(addRuleList, rule)
+		}
+	}
+
+	removeRuleList := []cres.SecurityRuleInfo{}
+	removedKeyMap := map[securityRuleKey]bool{}
+	unchangedCount := 0
+	for _, rule := range comparableRuleList {
+		key, _ := normalizeSecurityRule(rule)
+		if desiredKeyMap[key] {
+			unchangedCount++
+			continue
+		}
+		if removedKeyMap[key] {
+			continue
+		}
+		removedKeyMap[key] = true
+		removeRuleList = append(removeRuleList, rule)
+	}
+
+	return addRuleList, removeRuleList, skippedRuleList, unchangedCount, nil
+}
+
+// normalizeSecurityRule normalizes a rule across the CSP conventions.
+//
+//	ex) protocol "-1", "ANY" => "ALL", ports of ALL and ICMP => "-1"~"-1",
+//	    TCP/UDP ports "-1", "" or "0"~"65535" => "1"~"65535", CIDR "10.0.0.1/16" => "10.0.0.0/16"
+func normalizeSecurityRule(rule cres.SecurityRuleInfo) (securityRuleKey, error) {
+	key := securityRuleKey{
+		Direction:  strings.ToLower(strings.TrimSpace(rule.Direction)),
+		IPProtocol: strings.ToUpper(strings.TrimSpace(rule.IPProtocol)),
+	}
+
+	switch key.IPProtocol {
+	case "-1", "ANY", "*":
+		key.IPProtocol = "ALL"
+	}
+
+	switch key.IPProtocol {
+	case "ALL", "ICMP":
+		key.FromPort, key.ToPort = "-1", "-1"
+	default:
+		fromPort, err := normalizePort(rule.FromPort, 1)
+		if err != nil {
+			return securityRuleKey{}, err
+		}
+		toPort, err := normalizePort(rule.ToPort, 65535)
+		if err != nil {
+			return securityRuleKey{}, err
+		}
+		if fromPort == 0 {
+			fromPort = 1
+		}
+		key.FromPort, key.ToPort = strconv.Itoa(fromPort), strconv.Itoa(toPort)
+	}
+
+	switch {
+	case rule.SourceSecurityGroupIID != nil:
+		key.Target = "sg:" + rule.SourceSecurityGroupIID.SystemId
+	case rule.CIDRv6 != "":
+		_, ipNet, err := net.ParseCIDR(strings.TrimSpace(rule.CIDRv6))
+		if err != nil {
+			return securityRuleKey{}, err
+		}
+		key.Target = "cidr6:" + ipNet.String()
+	default:
+		cidr := strings.TrimSpace(rule.CIDR)
+		if cidr == "" {
+			cidr = "0.0.0.0/0"
+		}
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return securityRuleKey{}, err
+		}
+		key.Target = "cidr:" + ipNet.String()
+	}
+
+	return key, nil
+}
+
+// normalizePort returns allPortsValue for "", "-1" and "*", and the number for the others.
+func normalizePort(port string, allPortsValue int) (int, error) {
+	port = strings.TrimSpace(port)
+	switch port {
+	case "", "-1", "*":
+		return allPortsValue, nil
+	}
+	n, err := strconv.Atoi(port)
+	if err != nil || n < 0 || n > 65535 {
+		return 0, fmt.Errorf("invalid port: %s", port)
+	}
+	return n, nil
+}
@@ -0,0 +1,168 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"testing"
+
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// The rules written in the conventions of the CSPs are normalized to the same key.
+func TestNormalizeSecurityRule(t *testing.T) {
+	testList := []struct {
+		name string
+		a    cres.SecurityRuleInfo
+		b    cres.SecurityRuleInfo
+	}{
+		{"protocol case",
+			cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "tcp", FromPort: "22", ToPort: "22", CIDR: "0.0.0.0/0"},
+			cres.SecurityRuleInfo{Direction: "Inbound", IPProtocol: "TCP", FromPort: "22", ToPort: "22", CIDR: "0.0.0.0/0"}},
+		{"all protocols",
+			cres.SecurityRuleInfo{Direction: "outbound", IPProtocol: "-1", FromPort: "0", ToPort: "65535", CIDR: "0.0.0.0/0"},
+			cres.SecurityRuleInfo{Direction: "outbound", IPProtocol: "ALL", FromPort: "-1", ToPort: "-1", CIDR: "0.0.0.0/0"}},
+		{"icmp ports",
+			cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "ICMP", FromPort: "8", ToPort: "0", CIDR: "0.0.0.0/0"},
+			cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "icmp", FromPort: "-1", ToPort: "-1", CIDR: "0.0.0.0/0"}},
+		{"all tcp ports",
+			cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "TCP", FromPort: "-1", ToPort: "-1", CIDR: "0.0.0.0/0"},
+			cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "TCP", FromPort: "0", ToPort: "65535", CIDR: "0.0.0.0/0"}},
+		{"empty ports",
+			cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "UDP", FromPort: "", ToPort: "", CIDR: "0.0.0.0/0"},
+			cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "UDP", FromPort: "1", ToPort: "65535", CIDR: "0.0.0.0/0"}},
+		{"no CIDR",
+			cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "TCP", FromPort: "80", ToPort: "80"},
+			cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "TCP", FromPort: "80", ToPort: "80", CIDR: "0.0.0.0/0"}},
+		{"CIDR host bits",
+			cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "TCP", FromPort: "80", ToPort: "80", CIDR: "10.0.0.1/16"},
+			cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "TCP", FromPort: "80", ToPort: "80", CIDR: "10.0.0.0/16"}},
+		{"IPv6 CIDR",
+			cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "TCP", FromPort: "443", ToPort: "443", CIDRv6: "2001:DB8::1/32"},
+			cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "TCP", FromPort: "443", ToPort: "443", CIDRv6: "2001:db8::/32"}},
+		{"Description",
+			cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "TCP", FromPort: "22", ToPort: "22", CIDR: "0.0.0.0/0", Description: "ssh"},
+			cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "TCP", FromPort: "22", ToPort: "22", CIDR: "0.0.0.0/0"}},
+	}
+
+	for _, test := range testList {
+		keyA, err := normalizeSecurityRule(test.a)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		keyB, err := normalizeSecurityRule(test.b)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if keyA != keyB {
+			t.Errorf("%s: expected the same key, got %v and %v", test.name, keyA, keyB)
+		}
+	}
+}
+
+// The rules with a different target, direction or port are normalized to different keys.
+func TestNormalizeSecurityRuleDifferent(t *testing.T) {
+	base := cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "TCP", FromPort: "22", ToPort: "22", CIDR: "0.0.0.0/0"}
+	baseKey, err := normalizeSecurityRule(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ipv6 := base
+	ipv6.CIDR, ipv6.CIDRv6 = "", "::/0"
+	sg := base
+	sg.CIDR, sg.SourceSecurityGroupIID = "", &cres.IID{NameId: "sg-01", SystemId: "sg-01-csp"}
+	outbound := base
+	outbound.Direction = "outbound"
+	port := base
+	port.ToPort = "23"
+
+	for _, rule := range []cres.SecurityRuleInfo{ipv6, sg, outbound, port} {
+		key, err := normalizeSecurityRule(rule)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if key == baseKey {
+			t.Errorf("expected a different key for %v", rule)
+		}
+	}
+
+	for _, rule := range []cres.SecurityRuleInfo{
+		{Direction: "inbound", IPProtocol: "TCP", FromPort: "22", ToPort: "22", CIDR: "VirtualNetwork"},
+		{Direction: "inbound", IPProtocol: "TCP", FromPort: "ssh", ToPort: "22", CIDR: "0.0.0.0/0"},
+		{Direction: "inbound", IPProtocol: "TCP", FromPort: "22", ToPort: "70000", CIDR: "0.0.0.0/0"},
+	} {
+		if _, err := normalizeSecurityRule(rule); err == nil {
+			t.Errorf("expected an error for %v", rule)
+		}
+	}
+}
+
+func TestDiffSecurityRules(t *testing.T) {
+	currentRuleList := []cres.SecurityRuleInfo{
+		{Direction: "inbound", IPProtocol: "tcp", FromPort: "22", ToPort: "22", CIDR: "0.0.0.0/0"},
+		{Direction: "inbound", IPProtocol: "TCP", FromPort: "80", ToPort: "80", CIDR: "0.0.0.0/0"},
+		{Direction: "outbound", IPProtocol: "-1", FromPort: "0", ToPort: "65535", CIDR: "0.0.0.0/0"},
+	}
+	desiredRuleList := []cres.SecurityRuleInfo{
+		{Direction: "inbound", IPProtocol: "TCP", FromPort: "22", ToPort: "22", CIDR: "0.0.0.0/0", Description: "ssh"},
+		{Direction: "inbound", IPProtocol: "TCP", FromPort: "443", ToPort: "443", CIDR: "0.0.0.0/0"},
+		{Direction: "inbound", IPProtocol: "TCP", FromPort: "443", ToPort: "443", CIDR: "0.0.0.0/0"}, // duplicated
+		{Direction: "outbound", IPProtocol: "ALL", FromPort: "-1", ToPort: "-1", CIDR: "0.0.0.0/0"},
+	}
+
+	addRuleList, removeRuleList, skippedRuleList, unchangedCount, err := diffSecurityRules(currentRuleList, desiredRuleList)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unchangedCount != 2 {
+		t.Errorf("expected 2 unchanged rules, got %d", unchangedCount)
+	}
+	if len(addRuleList) != 1 || addRuleList[0].FromPort != "443" {
+		t.Errorf("expected the 443 rule to be added, got %v", addRuleList)
+	}
+	if len(removeRuleList) != 1 || removeRuleList[0].FromPort != "80" {
+		t.Errorf("expected the 80 rule to be removed, got %v", removeRuleList)
+	}
+	if len(skippedRuleList) != 0 {
+		t.Errorf("expected no skipped rules, got %v", skippedRuleList)
+	}
+}
+
+// A current rule not comparable is kept, not removed and not failing the sync.
+func TestDiffSecurityRulesSkipped(t *testing.T) {
+	currentRuleList := []cres.SecurityRuleInfo{
+		{Direction: "inbound", IPProtocol: "TCP", FromPort: "22", ToPort: "22", CIDR: "0.0.0.0/0"},
+		{Direction: "inbound", IPProtocol: "ALL", FromPort: "-1", ToPort: "-1", CIDR: "VirtualNetwork"},
+	}
+	desiredRuleList := []cres.SecurityRuleInfo{
+		{Direction: "inbound", IPProtocol: "TCP", FromPort: "443", ToPort: "443", CIDR: "0.0.0.0/0"},
+	}
+
+	addRuleList, removeRuleList, skippedRuleList, unchangedCount, err := diffSecurityRules(currentRuleList, desiredRuleList)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(skippedRuleList) != 1 || skippedRuleList[0].CIDR != "VirtualNetwork" {
+		t.Errorf("expected the service tag rule to be skipped, got %v", skippedRuleList)
+	}
+	if len(removeRuleList) != 1 || removeRuleList[0].FromPort != "22" {
+		t.Errorf("expected only the 22 rule to be removed, got %v", removeRuleList)
+	}
+	if len(addRuleList) != 1 || unchangedCount != 0 {
+		t.Errorf("expected 1 rule to be added and no unchanged rule, got %v and %d", addRuleList, unchangedCount)
+	}
+
+	// a desired rule not comparable is an error
+	desiredRuleList = append(desiredRuleList, cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "TCP", FromPort: "80", ToPort: "80", CIDR: "Internet"})
+	if _, _, _, _, err := diffSecurityRules(currentRuleList, desiredRuleList); err == nil {
+		t.Errorf("expected an error for the desired rule not comparable")
+	}
+}
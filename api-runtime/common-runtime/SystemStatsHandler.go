@@ -9,6 +9,7 @@ import (
 	"syscall"
 	"time"
 
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
 	"github.com/olekukonko/tablewriter"
 	"github.com/shirou/gopsutil/cpu"
 	"github.com/shirou/gopsutil/disk"
@@ -118,6 +119,20 @@ func FetchResourceUsage() (ResourceUsage, error) {
 	return resourceUsage, nil
 }
 
+// FetchConnectionCacheStats returns the usage of the CloudConnection cache
+func FetchConnectionCacheStats() ccm.CloudConnectionCacheStats {
+	cblog.Info("Collecting connection cache stats")
+
+	return ccm.GetCloudConnectionCacheStats()
+}
+
+// ClearConnectionCache removes all cached CloudConnections
+func ClearConnectionCache() {
+	cblog.Info("Clearing connection cache")
+
+	ccm.ClearCloudConnectionCache()
+}
+
 // DisplaySystemInfo prints the collected system information
 func DisplaySystemInfo(sysInfo *SystemInfo) {
 	if nil == sysInfo {
@@ -18,7 +18,6 @@ import (
 	"os"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
@@ -30,6 +29,7 @@ import (
 	infostore "github.com/cloud-barista/cb-spider/info-store"
 
 	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
+	cdcom "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/common"
 	awsprofile "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/aws/profile"
 	azureprofile "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/azure/profile"
 	gcpprofile "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/gcp/profile"
@@ -578,6 +578,30 @@ func RegisterVM(connectionName string, userIID cres.IID) (*cres.VMInfo, error) {
 	return &getInfo, nil
 }
 
+// checkPurchaseOption validates the PurchaseOption and SpotMaxPrice of VMReqInfo.
+func checkPurchaseOption(connectionName string, reqInfo cres.VMReqInfo) error {
+	switch reqInfo.PurchaseOption {
+	case "", cres.OnDemandVM:
+		if reqInfo.SpotMaxPrice != "" {
+			return fmt.Errorf("SpotMaxPrice can be set only with PurchaseOption '%s'", cres.SpotVM)
+		}
+	case cres.SpotVM:
+		err := checkCapability(connectionName, SPOT_VM)
+		if err != nil {
+			return err
+		}
+		if reqInfo.SpotMaxPrice != "" {
+			price, err := strconv.ParseFloat(reqInfo.SpotMaxPrice, 64)
+			if err != nil || price <= 0 {
+				return fmt.Errorf("invalid SpotMaxPrice '%s': must be a positive number", reqInfo.SpotMaxPrice)
+			}
+		}
+	default:
+		return fmt.Errorf("invalid PurchaseOption '%s': must be one of '%s', '%s'", reqInfo.PurchaseOption, cres.OnDemandVM, cres.SpotVM)
+	}
+	return nil
+}
+
 // (1) check exist(NameID)
 // (2) generate SP-XID and create reqIID, driverIID
 // (3) clone the reqInfo with DriverIID
@@ -607,8 +631,11 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo, IDTra
 		"resources.VMReqInfo:RootDiskSize", // because can be set without disk size
 		// "resources.VMReqInfo:KeyPairName",  // because can be set without KeyPair for Windows
 		//	"resources.IID:NameId",
-		"resources.VMReqInfo:VMUserId",     // because can be set without VM User
-		"resources.VMReqInfo:VMUserPasswd", // because can be set without VM PW
+		"resources.VMReqInfo:VMUserId",       // because can be set without VM User
+		"resources.VMReqInfo:VMUserPasswd",   // because can be set without VM PW
+		"resources.VMReqInfo:UserData",       // because can be set without UserData
+		"resources.VMReqInfo:PurchaseOption", // because can be set without PurchaseOption(default: OnDemand)
+		"resources.VMReqInfo:SpotMaxPrice",   // because can be set without SpotMaxPrice
 	}
 
 	err = ValidateStruct(reqInfo, emptyPermissionList)
@@ -617,6 +644,25 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo, IDTra
 		return nil, err
 	}
 
+	if reqInfo.UserData != "" {
+		err = checkCapability(connectionName, VM_USER_DATA)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		err = cdcom.ValidateUserData(reqInfo.UserData)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
+	err = checkPurchaseOption(connectionName, reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	err = checkImageType(&reqInfo)
 	if err != nil {
 		cblog.Error(err)
@@ -1495,11 +1541,6 @@ func setNameId(ConnectionName string, vmInfo *cres.VMInfo, reqInfo *cres.VMReqIn
 	return nil
 }
 
-type ResultVMInfo struct {
-	vmInfo cres.VMInfo
-	err    error
-}
-
 // (1) get IID:list
 // (2) get VMInfo:list
 func ListVM(connectionName string, rsType string) ([]*cres.VMInfo, error) {
@@ -1535,53 +1576,39 @@ func ListVM(connectionName string, rsType string) ([]*cres.VMInfo, error) {
 	}
 
 	// (2) get VMInfo:list
-	wg := new(sync.WaitGroup)
-	infoList2 := []*cres.VMInfo{}
-	var retChanInfos []chan ResultVMInfo
-	for i := 0; i < len(iidInfoList); i++ {
-		retChanInfos = append(retChanInfos, make(chan ResultVMInfo))
-	}
-
-	for idx, iidInfo := range iidInfoList {
-
-		wg.Add(1)
-
-		go getVMInfo(iidInfo.ConnectionName, iidInfo.ZoneId, cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}, retChanInfos[idx])
-
-		wg.Done()
-
-	}
-	wg.Wait()
-
-	for idx, retChanInfo := range retChanInfos {
-		chanInfo := <-retChanInfo
-
-		if chanInfo.err != nil {
-			cblog.Error(chanInfo.err)
-			info := cres.VMInfo{IId: cres.IID{NameId: iidInfoList[idx].NameId, SystemId: iidInfoList[idx].SystemId}}
-			infoList2 = append(infoList2, &info)
-		} else {
-			infoList2 = append(infoList2, &chanInfo.vmInfo)
+	infoList2 := make([]*cres.VMInfo, len(iidInfoList))
+	errList := runFanOut(connectionName, len(iidInfoList), func(idx int) error {
+		iidInfo := iidInfoList[idx]
+		info, err := getVMInfo(iidInfo.ConnectionName, iidInfo.ZoneId, cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+		if err != nil {
+			return err
 		}
+		infoList2[idx] = &info
+		return nil
+	})
 
-		close(retChanInfo)
+	for idx, err := range errList {
+		if err != nil {
+			cblog.Error(err)
+			infoList2[idx] = &cres.VMInfo{IId: cres.IID{NameId: iidInfoList[idx].NameId, SystemId: iidInfoList[idx].SystemId}}
+		}
 	}
 
 	return infoList2, nil
 }
 
-func getVMInfo(connectionName string, zoneId string, iid cres.IID, retInfo chan ResultVMInfo) {
+func getVMInfo(connectionName string, zoneId string, iid cres.IID) (cres.VMInfo, error) {
 
 	cldConn, err := ccm.GetZoneLevelCloudConnection(connectionName, zoneId)
 	if err != nil {
 		cblog.Error(err)
-		return
+		return cres.VMInfo{}, err
 	}
 
 	handler, err := cldConn.CreateVMHandler()
 	if err != nil {
 		cblog.Error(err)
-		return
+		return cres.VMInfo{}, err
 	}
 
 	vmSPLock.RLock(connectionName, iid.NameId)
@@ -1590,8 +1617,7 @@ func getVMInfo(connectionName string, zoneId string, iid cres.IID, retInfo chan
 	if err != nil {
 		vmSPLock.RUnlock(connectionName, iid.NameId)
 		cblog.Error(err)
-		retInfo <- ResultVMInfo{cres.VMInfo{}, err}
-		return
+		return cres.VMInfo{}, err
 	}
 
 	// set ResourceInfo(IID.NameId)
@@ -1601,8 +1627,7 @@ func getVMInfo(connectionName string, zoneId string, iid cres.IID, retInfo chan
 	if err != nil {
 		vmSPLock.RUnlock(connectionName, iid.NameId)
 		cblog.Error(err)
-		retInfo <- ResultVMInfo{cres.VMInfo{}, err}
-		return
+		return cres.VMInfo{}, err
 	}
 	vmSPLock.RUnlock(connectionName, iid.NameId)
 
@@ -1634,7 +1659,7 @@ func getVMInfo(connectionName string, zoneId string, iid cres.IID, retInfo chan
 	}
 	// }
 
-	retInfo <- ResultVMInfo{info, nil}
+	return info, nil
 }
 
 func getSetNameId(ConnectionName string, vmInfo *cres.VMInfo) error {
@@ -2077,7 +2102,7 @@ func ListVMStatus(connectionName string, rsType string) ([]*cres.VMStatusInfo, e
 
 			if statusInfo == cres.Creating || statusInfo == cres.Running || statusInfo == cres.Suspending || statusInfo == cres.Suspended ||
 				statusInfo == cres.Resuming || statusInfo == cres.Rebooting || statusInfo == cres.Terminating || statusInfo == cres.Terminated ||
-				statusInfo == cres.NotExist || statusInfo == cres.Failed {
+				statusInfo == cres.NotExist || statusInfo == cres.Interrupted || statusInfo == cres.Failed {
 				break
 			}
 
@@ -2170,7 +2195,7 @@ func GetVMStatus(connectionName string, rsType string, nameID string) (cres.VMSt
 
 		if info == cres.Creating || info == cres.Running || info == cres.Suspending || info == cres.Suspended ||
 			info == cres.Resuming || info == cres.Rebooting || info == cres.Terminating || info == cres.Terminated ||
-			info == cres.NotExist || info == cres.Failed {
+			info == cres.NotExist || info == cres.Interrupted || info == cres.Failed {
 			return info, nil
 		}
 
@@ -2247,6 +2272,202 @@ func ControlVM(connectionName string, rsType string, nameID string, action strin
 	return info, nil
 }
 
+// ChangeVMSpec changes the VMSpec of the VM.
+// If the CSP requires a stopped VM, the running VM is suspended before the change
+// and resumed after the change. vmSPLock is held for the whole sequence.
+func ChangeVMSpec(connectionName string, rsType string, nameID string, vmSpecName string) (*cres.VMInfo, error) {
+	cblog.Info("call ChangeVMSpec()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	nameID, err = EmptyCheckAndTrim("nameID", nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	vmSpecName, err = EmptyCheckAndTrim("vmSpecName", vmSpecName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	err = checkCapability(connectionName, VM_SPEC_CHANGE)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	drvCapabilityInfo, err := GetDriverCapabilityInfo(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	vmSPLock.Lock(connectionName, nameID)
+	defer vmSPLock.Unlock(connectionName, nameID)
+
+	// (1) get IID(NameId)
+	var iidInfo VMIIDInfo
+	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+		var iidInfoList []*VMIIDInfo
+		err = getAuthIIDInfoList(connectionName, &iidInfoList)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, nameID)
+		if err != nil {
+			err = vmNotFoundError(connectionName, rsType, nameID, err)
+			cblog.Error(err)
+			return nil, err
+		}
+		iidInfo = *castedIIDInfo.(*VMIIDInfo)
+	} else {
+		err = infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, nameID)
+		if err != nil {
+			err = vmNotFoundError(connectionName, rsType, nameID, err)
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
+	cldConn, err := ccm.GetZoneLevelCloudConnection(connectionName, iidInfo.ZoneId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateVMHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	driverIID := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+
+	// (2) suspend the running VM if the CSP requires
+	needResume := false
+	if drvCapabilityInfo.VM_SPEC_CHANGE_NEEDS_STOP {
+		status, err := handler.GetVMStatus(driverIID)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+
+		switch status {
+		case cres.Suspended:
+			// nothing to do
+		case cres.Running:
+			_, err = handler.SuspendVM(driverIID)
+			if err != nil {
+				cblog.Error(err)
+				return nil, err
+			}
+			needResume = true
+
+			err = waitForVMStatus(handler, driverIID, cres.Suspended)
+			if err != nil {
+				cblog.Error(err)
+				return nil, err
+			}
+		default:
+			err = fmt.Errorf("VMSpec of VM '%s' can not be changed in '%s' status", nameID, status)
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
+	// (3) change VMSpec
+	info, err := handler.ChangeVMSpec(driverIID, vmSpecName)
+	if err != nil {
+		cblog.Error(err)
+		if needResume {
+			// restore the prior running state
+			if _, resumeErr := handler.ResumeVM(driverIID); resumeErr != nil {
+				cblog.Error(resumeErr)
+			} else if waitErr := waitForVMStatus(handler, driverIID, cres.Running); waitErr != nil {
+				cblog.Error(waitErr)
+			}
+		}
+		return nil, err
+	}
+
+	// (4) restore the prior running state
+	if needResume {
+		_, err = handler.ResumeVM(driverIID)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+
+		err = waitForVMStatus(handler, driverIID, cres.Running)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+
+		info, err = handler.GetVM(driverIID)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
+	// (5) set ResourceInfo(IID.NameId)
+	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+
+	err = getSetNameId(iidInfo.ConnectionName, &info)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// Resolve NetworkInterfaces SystemId → Spider NameId
+	resolveNICNameIds(connectionName, &info)
+
+	return &info, nil
+}
+
+// ErrVMNotFound is returned when the VM does not exist in the connection.
+var ErrVMNotFound = fmt.Errorf("VM not found")
+
+// vmNotFoundError wraps the error of the VM IID lookup with ErrVMNotFound if the VM does not exist.
+func vmNotFoundError(connectionName string, rsType string, nameID string, err error) error {
+	if !checkNotFoundError(err) {
+		return err
+	}
+	return fmt.Errorf("%s '%s' does not exist in connection '%s': %w", RSTypeString(rsType), nameID, connectionName, ErrVMNotFound)
+}
+
+// waitForVMStatus waits until the VM reaches the target status.
+func waitForVMStatus(handler cres.VMHandler, driverIID cres.IID, targetStatus cres.VMStatus) error {
+	waiter := NewWaiter(5, 600) // 5 seconds sleep, 600 seconds timeout
+
+	for {
+		status, err := handler.GetVMStatus(driverIID)
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		if status == targetStatus {
+			return nil
+		}
+		if status == cres.NotExist || status == cres.Failed || status == cres.Terminated {
+			return fmt.Errorf("VM '%s' is in '%s' status while waiting for '%s' status", driverIID.NameId, status, targetStatus)
+		}
+
+		if !waiter.Wait() {
+			return fmt.Errorf("VM '%s' did not reach '%s' status. Timeout after %v seconds", driverIID.NameId, targetStatus, waiter.Timeout)
+		}
+	}
+}
+
 func DeleteVM(connectionName string, rsType string, nameID string, force string) (bool, cres.VMStatus, error) {
 	cblog.Info("call DeleteVM()")
 
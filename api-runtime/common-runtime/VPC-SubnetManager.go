@@ -14,7 +14,6 @@ import (
 	"os"
 	"strconv"
 	"strings"
-	"sync"
 
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
@@ -714,11 +713,6 @@ func getSubnetReqZoneId(reqIIdZoneList []SubnetReqZoneInfo, driverNameId string)
 	return ""
 }
 
-type ResultVPCInfo struct {
-	vpcInfo cres.VPCInfo
-	err     error
-}
-
 // (1) get IID:list
 // (2) get VPCInfo:list
 // (3) set userIID, and...
@@ -767,41 +761,28 @@ func ListVPC(connectionName string, rsType string) ([]*cres.VPCInfo, error) {
 	}
 
 	// (2) Get VPCInfo-list with IID-list
-	wg := new(sync.WaitGroup)
-	resultInfoList := []*cres.VPCInfo{}
-	var retChanInfos []chan ResultVPCInfo
-	for i := 0; i < len(iidInfoList); i++ {
-		retChanInfos = append(retChanInfos, make(chan ResultVPCInfo))
-	}
-
-	for idx, iidInfo := range iidInfoList {
-
-		wg.Add(1)
-
-		go getVPCInfo(iidInfo.ConnectionName, handler, cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}, retChanInfos[idx])
-
-		wg.Done()
-
-	}
-	wg.Wait()
+	resultInfoList := make([]*cres.VPCInfo, len(iidInfoList))
+	getErrList := runFanOut(connectionName, len(iidInfoList), func(idx int) error {
+		iidInfo := iidInfoList[idx]
+		info, err := getVPCInfo(iidInfo.ConnectionName, handler, cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+		if err != nil {
+			return err
+		}
+		resultInfoList[idx] = &info
+		return nil
+	})
 
 	var errList []string
-	for idx, retChanInfo := range retChanInfos {
-		chanInfo := <-retChanInfo
-
-		if chanInfo.err != nil {
-			if checkNotFoundError(chanInfo.err) {
-				cblog.Error(chanInfo.err)
-				info := cres.VPCInfo{IId: cres.IID{NameId: iidInfoList[idx].NameId, SystemId: iidInfoList[idx].SystemId}}
-				resultInfoList = append(resultInfoList, &info)
-			} else {
-				errList = append(errList, connectionName+":VPC:"+iidInfoList[idx].NameId+" # "+chanInfo.err.Error())
-			}
+	for idx, err := range getErrList {
+		if err == nil {
+			continue
+		}
+		if checkNotFoundError(err) {
+			cblog.Error(err)
+			resultInfoList[idx] = &cres.VPCInfo{IId: cres.IID{NameId: iidInfoList[idx].NameId, SystemId: iidInfoList[idx].SystemId}}
 		} else {
-			resultInfoList = append(resultInfoList, &chanInfo.vpcInfo)
+			errList = append(errList, connectionName+":VPC:"+iidInfoList[idx].NameId+" # "+err.Error())
 		}
-
-		close(retChanInfo)
 	}
 
 	if len(errList) > 0 {
@@ -812,7 +793,7 @@ func ListVPC(connectionName string, rsType string) ([]*cres.VPCInfo, error) {
 	return resultInfoList, nil
 }
 
-func getVPCInfo(connectionName string, handler cres.VPCHandler, iid cres.IID, retInfo chan ResultVPCInfo) {
+func getVPCInfo(connectionName string, handler cres.VPCHandler, iid cres.IID) (cres.VPCInfo, error) {
 
 	vpcSPLock.RLock(connectionName, iid.NameId)
 	// get resource(SystemId)
@@ -820,8 +801,7 @@ func getVPCInfo(connectionName string, handler cres.VPCHandler, iid cres.IID, re
 	if err != nil {
 		vpcSPLock.RUnlock(connectionName, iid.NameId)
 		cblog.Error(err)
-		retInfo <- ResultVPCInfo{cres.VPCInfo{}, err}
-		return
+		return cres.VPCInfo{}, err
 	}
 
 	// set ResourceInfo(IID.NameId)
@@ -842,8 +822,7 @@ func getVPCInfo(connectionName string, handler cres.VPCHandler, iid cres.IID, re
 			}
 			vpcSPLock.RUnlock(connectionName, iid.NameId)
 			cblog.Error(err)
-			retInfo <- ResultVPCInfo{cres.VPCInfo{}, err}
-			return
+			return cres.VPCInfo{}, err
 		}
 		if subnetIIDInfo.NameId != "" { // insert only this user created.
 			subnetInfo.IId = getUserIID(cres.IID{NameId: subnetIIDInfo.NameId, SystemId: subnetIIDInfo.SystemId})
@@ -857,7 +836,7 @@ func getVPCInfo(connectionName string, handler cres.VPCHandler, iid cres.IID, re
 
 	info.SubnetInfoList = subnetInfoList
 
-	retInfo <- ResultVPCInfo{info, nil}
+	return info, nil
 }
 
 // (1) get spiderIID(NameId)
@@ -1509,6 +1488,18 @@ func DeleteVPC(connectionName string, rsType string, nameID string, force string
 		}
 	}
 
+	// the VPC peerings must be deleted before the VPC
+	peeringNameList, err := getVPCPeeringNamesUsingVPC(connectionName, iidInfo.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	if len(peeringNameList) > 0 {
+		err := fmt.Errorf("%s '%s' is used by the %s(s): %s", RSTypeString(rsType), iidInfo.NameId, RSTypeString(VPCPEERING), strings.Join(peeringNameList, ", "))
+		cblog.Error(err)
+		return false, err
+	}
+
 	// (2) delete Resource(SystemId)
 	driverIId := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
 	result := false
@@ -0,0 +1,617 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// ====================================================================
+// type for GORM
+
+type VPCPeeringIIDInfo struct {
+	ConnectionName   string `gorm:"primaryKey"` // ex) "aws-seoul-config"
+	NameId           string `gorm:"primaryKey"` // ex) "peering-01"
+	SystemId         string // ID in CSP, ex) "pcx-0bc7123b7e5cbf79d"
+	RequesterVpcName string // ex) "vpc-01" - NOT primaryKey, to refuse deleting the VPC in use
+	AccepterVpcName  string // ex) "vpc-02" - NOT primaryKey, to refuse deleting the VPC in use
+}
+
+func (VPCPeeringIIDInfo) TableName() string {
+	return "vpcpeering_iid_infos"
+}
+
+//====================================================================
+
+func init() {
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	db.AutoMigrate(&VPCPeeringIIDInfo{})
+	infostore.Close(db)
+}
+
+//================ VPC Peering Handler
+
+// (1) check capability and exist
+// (2) get the VPCs of both sides and check the CIDR overlap
+// (3) generate SP-XID
+// (4) create Resource
+// (5) insert spiderIID
+// (6) return userIID
+func CreateVPCPeering(connectionName string, rsType string, reqInfo cres.VPCPeeringReqInfo, IDTransformMode string) (*cres.VPCPeeringInfo, error) {
+	cblog.Info("call CreateVPCPeering()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	emptyPermissionList := []string{
+		"resources.IID:SystemId",
+	}
+	if err = ValidateStruct(reqInfo, emptyPermissionList); err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	requesterVPCName := reqInfo.RequesterVpcIID.NameId
+	accepterVPCName := reqInfo.AccepterVpcIID.NameId
+	if requesterVPCName == accepterVPCName {
+		err := fmt.Errorf("the requester VPC and the accepter VPC are the same: %s", requesterVPCName)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (1) check capability and exist
+	if err := checkCapability(connectionName, VPC_PEERING_HANDLER); err != nil {
+		return nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateVPCPeeringHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	vpcHandler, err := cldConn.CreateVPCHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	vpcPeeringSPLock.Lock(connectionName, reqInfo.IId.NameId)
+	defer vpcPeeringSPLock.Unlock(connectionName, reqInfo.IId.NameId)
+
+	// the VPCs are not changed while peering
+	vpcSPLock.RLock(connectionName, requesterVPCName)
+	defer vpcSPLock.RUnlock(connectionName, requesterVPCName)
+	vpcSPLock.RLock(connectionName, accepterVPCName)
+	defer vpcSPLock.RUnlock(connectionName, accepterVPCName)
+
+	var bool_ret bool
+	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+		bool_ret, err = infostore.HasByCondition(&VPCPeeringIIDInfo{}, NAME_ID_COLUMN, reqInfo.IId.NameId)
+	} else {
+		bool_ret, err = infostore.HasByConditions(&VPCPeeringIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, reqInfo.IId.NameId)
+	}
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if bool_ret {
+		err := fmt.Errorf("%s '%s' already exists in connection '%s'", RSTypeString(rsType), reqInfo.IId.NameId, connectionName)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) get the VPCs of both sides and check the CIDR overlap
+	var requesterVPCIIDInfo, accepterVPCIIDInfo VPCIIDInfo
+	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+		var vpcIIDInfoList []*VPCIIDInfo
+		err = getAuthIIDInfoList(connectionName, &vpcIIDInfoList)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		castedIIDInfo, err := getAuthIIDInfo(&vpcIIDInfoList, requesterVPCName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		requesterVPCIIDInfo = *castedIIDInfo.(*VPCIIDInfo)
+		castedIIDInfo, err = getAuthIIDInfo(&vpcIIDInfoList, accepterVPCName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		accepterVPCIIDInfo = *castedIIDInfo.(*VPCIIDInfo)
+	} else {
+		if err = infostore.GetByConditions(&requesterVPCIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, requesterVPCName); err != nil {
+			cblog.Error(err)
+			return nil, fmt.Errorf("VPC '%s' not found in connection '%s'", requesterVPCName, connectionName)
+		}
+		if err = infostore.GetByConditions(&accepterVPCIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, accepterVPCName); err != nil {
+			cblog.Error(err)
+			return nil, fmt.Errorf("VPC '%s' not found in connection '%s'", accepterVPCName, connectionName)
+		}
+	}
+	reqInfo.RequesterVpcIID = getDriverIID(cres.IID{NameId: requesterVPCIIDInfo.NameId, SystemId: requesterVPCIIDInfo.SystemId})
+	reqInfo.AccepterVpcIID = getDriverIID(cres.IID{NameId: accepterVPCIIDInfo.NameId, SystemId: accepterVPCIIDInfo.SystemId})
+
+	requesterVPCInfo, err := vpcHandler.GetVPC(reqInfo.RequesterVpcIID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	accepterVPCInfo, err := vpcHandler.GetVPC(reqInfo.AccepterVpcIID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	err = checkVPCPeeringCIDROverlap(requesterVPCName, requesterVPCInfo, accepterVPCName, accepterVPCInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) generate SP-XID
+	spUUID := ""
+	if GetID_MGMT(IDTransformMode) == "ON" {
+		spUUID, err = iidm.New(connectionName, rsType, reqInfo.IId.NameId)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	} else {
+		spUUID = reqInfo.IId.NameId
+	}
+
+	reqIId := cres.IID{NameId: reqInfo.IId.NameId, SystemId: spUUID}
+	reqInfo.IId = cres.IID{NameId: spUUID, SystemId: ""}
+
+	// (4) create Resource
+	info, err := handler.CreateVPCPeering(reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (5) insert spiderIID
+	spiderIId := cres.IID{NameId: reqIId.NameId, SystemId: spUUID + ":" + info.IId.SystemId}
+	err = infostore.Insert(&VPCPeeringIIDInfo{ConnectionName: connectionName, NameId: spiderIId.NameId, SystemId: spiderIId.SystemId,
+		RequesterVpcName: requesterVPCName, AccepterVpcName: accepterVPCName})
+	if err != nil {
+		cblog.Error(err)
+		// rollback
+		_, err2 := handler.DeleteVPCPeering(info.IId)
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf("%v, %v", err, err2)
+		}
+		return nil, err
+	}
+
+	// (6) return userIID
+	info.IId = getUserIID(spiderIId)
+	resolveVPCPeeringRelatedIIDs(connectionName, &info)
+	return &info, nil
+}
+
+// checkVPCPeeringCIDROverlap returns an error if the address ranges of the two VPCs overlap.
+// The subnet CIDRs are used for a VPC without its own CIDR, ex) GCP.
+func checkVPCPeeringCIDROverlap(requesterName string, requesterVPC cres.VPCInfo, accepterName string, accepterVPC cres.VPCInfo) error {
+	requesterNets, err := getVPCCIDRNets(requesterName, requesterVPC)
+	if err != nil {
+		return err
+	}
+	accepterNets, err := getVPCCIDRNets(accepterName, accepterVPC)
+	if err != nil {
+		return err
+	}
+
+	for _, rNet := range requesterNets {
+		for _, aNet := range accepterNets {
+			if rNet.Contains(aNet.IP) || aNet.Contains(rNet.IP) {
+				return fmt.Errorf("the CIDR of VPC '%s'(%s) overlaps the CIDR of VPC '%s'(%s)",
+					requesterName, rNet.String(), accepterName, aNet.String())
+			}
+		}
+	}
+	return nil
+}
+
+func getVPCCIDRNets(vpcName string, vpcInfo cres.VPCInfo) ([]*net.IPNet, error) {
+	cidrList := []string{}
+	if strings.TrimSpace(vpcInfo.IPv4_CIDR) != "" {
+		cidrList = append(cidrList, vpcInfo.IPv4_CIDR)
+	} else {
+		for _, subnetInfo := range vpcInfo.SubnetInfoList {
+			cidrList = append(cidrList, subnetInfo.IPv4_CIDR)
+		}
+	}
+
+	netList := []*net.IPNet{}
+	for _, cidr := range cidrList {
+		_, ipNet, err := net.ParseCIDR(strings.TrimSpace(cidr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR of VPC '%s': %v", vpcName, err)
+		}
+		netList = append(netList, ipNet)
+	}
+	return netList, nil
+}
+
+// AcceptVPCPeering accepts a PendingAcceptance peering.
+func AcceptVPCPeering(connectionName string, rsType string, nameID string) (*cres.VPCPeeringInfo, error) {
+	cblog.Info("call AcceptVPCPeering()")
+
+	handler, iidInfo, err := getVPCPeeringHandlerAndIIDInfo(connectionName, nameID)
+	if err != nil {
+		return nil, err
+	}
+
+	vpcPeeringSPLock.Lock(connectionName, nameID)
+	defer vpcPeeringSPLock.Unlock(connectionName, nameID)
+
+	info, err := handler.AcceptVPCPeering(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	resolveVPCPeeringRelatedIIDs(connectionName, &info)
+	return &info, nil
+}
+
+func ListVPCPeering(connectionName string, rsType string) ([]*cres.VPCPeeringInfo, error) {
+	cblog.Info("call ListVPCPeering()")
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if err := checkCapability(connectionName, VPC_PEERING_HANDLER); err != nil {
+		return nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateVPCPeeringHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var iidInfoList []*VPCPeeringIIDInfo
+	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+		err = getAuthIIDInfoList(connectionName, &iidInfoList)
+	} else {
+		err = infostore.ListByCondition(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName)
+	}
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	infoList := []*cres.VPCPeeringInfo{}
+	for _, iidInfo := range iidInfoList {
+		vpcPeeringSPLock.RLock(connectionName, iidInfo.NameId)
+		info, err := handler.GetVPCPeering(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+		vpcPeeringSPLock.RUnlock(connectionName, iidInfo.NameId)
+		if err != nil {
+			cblog.Error(err)
+			info = cres.VPCPeeringInfo{IId: getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}), Status: cres.VPCPeeringNotFound}
+			infoList = append(infoList, &info)
+			continue
+		}
+		info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+		resolveVPCPeeringRelatedIIDs(connectionName, &info)
+		infoList = append(infoList, &info)
+	}
+	return infoList, nil
+}
+
+func GetVPCPeering(connectionName string, rsType string, nameID string) (*cres.VPCPeeringInfo, error) {
+	cblog.Info("call GetVPCPeering()")
+
+	handler, iidInfo, err := getVPCPeeringHandlerAndIIDInfo(connectionName, nameID)
+	if err != nil {
+		return nil, err
+	}
+
+	vpcPeeringSPLock.RLock(connectionName, nameID)
+	defer vpcPeeringSPLock.RUnlock(connectionName, nameID)
+
+	info, err := handler.GetVPCPeering(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	resolveVPCPeeringRelatedIIDs(connectionName, &info)
+	return &info, nil
+}
+
+func DeleteVPCPeering(connectionName string, rsType string, nameID string, force string) (bool, error) {
+	cblog.Info("call DeleteVPCPeering()")
+
+	handler, iidInfo, err := getVPCPeeringHandlerAndIIDInfo(connectionName, nameID)
+	if err != nil {
+		return false, err
+	}
+
+	vpcPeeringSPLock.Lock(connectionName, nameID)
+	defer vpcPeeringSPLock.Unlock(connectionName, nameID)
+
+	result, err := handler.DeleteVPCPeering(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	if err != nil {
+		cblog.Error(err)
+		// already deleted in the CSP, ex) by the other side of the peering
+		if checkNotFoundError(err) {
+			force = "true"
+		} else if force != "true" {
+			return false, err
+		}
+	}
+	if force != "true" && !result {
+		return false, nil
+	}
+
+	_, err = infostore.DeleteByConditions(&VPCPeeringIIDInfo{}, CONNECTION_NAME_COLUMN, iidInfo.ConnectionName, NAME_ID_COLUMN, iidInfo.NameId)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// AddVPCPeeringRoutes adds the routes to the peer VPC into the route tables of the subnets.
+// A subnet name is looked up in the requester VPC and the accepter VPC of the peering.
+func AddVPCPeeringRoutes(connectionName string, nameID string, subnetNames []string) (*cres.VPCPeeringInfo, error) {
+	cblog.Info("call AddVPCPeeringRoutes()")
+
+	handler, iidInfo, err := getVPCPeeringHandlerAndIIDInfo(connectionName, nameID)
+	if err != nil {
+		return nil, err
+	}
+
+	vpcPeeringSPLock.Lock(connectionName, nameID)
+	defer vpcPeeringSPLock.Unlock(connectionName, nameID)
+
+	driverIID := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	subnetIIDs, err := getVPCPeeringSubnetDriverIIDs(connectionName, handler, driverIID, subnetNames)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	info, err := handler.AddPeeringRoutes(driverIID, subnetIIDs)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	resolveVPCPeeringRelatedIIDs(connectionName, &info)
+	return &info, nil
+}
+
+// RemoveVPCPeeringRoutes removes the routes added by AddVPCPeeringRoutes.
+func RemoveVPCPeeringRoutes(connectionName string, nameID string, subnetNames []string) (bool, error) {
+	cblog.Info("call RemoveVPCPeeringRoutes()")
+
+	handler, iidInfo, err := getVPCPeeringHandlerAndIIDInfo(connectionName, nameID)
+	if err != nil {
+		return false, err
+	}
+
+	vpcPeeringSPLock.Lock(connectionName, nameID)
+	defer vpcPeeringSPLock.Unlock(connectionName, nameID)
+
+	driverIID := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+	subnetIIDs, err := getVPCPeeringSubnetDriverIIDs(connectionName, handler, driverIID, subnetNames)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	result, err := handler.RemovePeeringRoutes(driverIID, subnetIIDs)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	return result, nil
+}
+
+func CountAllVPCPeerings() (int64, error) {
+	var info VPCPeeringIIDInfo
+	count, err := infostore.CountAllNameIDs(&info)
+	if err != nil {
+		cblog.Error(err)
+		return count, err
+	}
+	return count, nil
+}
+
+func CountVPCPeeringsByConnection(connectionName string) (int64, error) {
+	var info VPCPeeringIIDInfo
+	count, err := infostore.CountNameIDsByConnection(&info, connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return count, err
+	}
+	return count, nil
+}
+
+func getVPCPeeringHandlerAndIIDInfo(connectionName string, nameID string) (cres.VPCPeeringHandler, *VPCPeeringIIDInfo, error) {
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, nil, err
+	}
+
+	nameID, err = EmptyCheckAndTrim("nameID", nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, nil, err
+	}
+
+	if err := checkCapability(connectionName, VPC_PEERING_HANDLER); err != nil {
+		return nil, nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, nil, err
+	}
+
+	handler, err := cldConn.CreateVPCPeeringHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, nil, err
+	}
+
+	var iidInfo VPCPeeringIIDInfo
+	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+		var iidInfoList []*VPCPeeringIIDInfo
+		err = getAuthIIDInfoList(connectionName, &iidInfoList)
+		if err != nil {
+			cblog.Error(err)
+			return nil, nil, err
+		}
+		castedIIDInfo, err := getAuthIIDInfo(&iidInfoList, nameID)
+		if err != nil {
+			cblog.Error(err)
+			return nil, nil, err
+		}
+		iidInfo = *castedIIDInfo.(*VPCPeeringIIDInfo)
+	} else {
+		err = infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, nameID)
+		if err != nil {
+			cblog.Error(err)
+			return nil, nil, fmt.Errorf("%s '%s' not found in connection '%s': %v", RSTypeString(VPCPEERING), nameID, connectionName, err)
+		}
+	}
+	return handler, &iidInfo, nil
+}
+
+// getVPCPeeringNamesUsingVPC returns the names of the VPC peerings with the VPC as the requester or the accepter.
+func getVPCPeeringNamesUsingVPC(connectionName string, vpcName string) ([]string, error) {
+	var iidInfoList []*VPCPeeringIIDInfo
+	var err error
+	if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+		// the VPC is shared by the connections of the same account
+		err = infostore.List(&iidInfoList)
+	} else {
+		err = infostore.ListByCondition(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	nameList := []string{}
+	for _, iidInfo := range iidInfoList {
+		if iidInfo.RequesterVpcName == vpcName || iidInfo.AccepterVpcName == vpcName {
+			nameList = append(nameList, iidInfo.NameId)
+		}
+	}
+	return nameList, nil
+}
+
+// getVPCPeeringSubnetDriverIIDs returns the driver IIDs of the subnets in the peered VPCs.
+// A subnet name existing in both VPCs is rejected as ambiguous.
+func getVPCPeeringSubnetDriverIIDs(connectionName string, handler cres.VPCPeeringHandler, peeringIID cres.IID, subnetNames []string) ([]cres.IID, error) {
+	if len(subnetNames) == 0 {
+		return nil, fmt.Errorf("the Subnet list is empty")
+	}
+
+	info, err := handler.GetVPCPeering(peeringIID)
+	if err != nil {
+		return nil, err
+	}
+	resolveVPCPeeringRelatedIIDs(connectionName, &info)
+	vpcNames := []string{info.RequesterVpcIID.NameId, info.AccepterVpcIID.NameId}
+
+	subnetIIDs := []cres.IID{}
+	for _, subnetName := range subnetNames {
+		subnetName = strings.TrimSpace(subnetName)
+		var found *SubnetIIDInfo
+		for _, vpcName := range vpcNames {
+			var subnetIIDInfo SubnetIIDInfo
+			var err error
+			if os.Getenv("PERMISSION_BASED_CONTROL_MODE") != "" {
+				err = infostore.GetByConditions(&subnetIIDInfo, OWNER_VPC_NAME_COLUMN, vpcName, NAME_ID_COLUMN, subnetName)
+			} else {
+				err = infostore.GetBy3Conditions(&subnetIIDInfo, CONNECTION_NAME_COLUMN, connectionName, OWNER_VPC_NAME_COLUMN, vpcName, NAME_ID_COLUMN, subnetName)
+			}
+			if err != nil {
+				continue
+			}
+			if found != nil {
+				return nil, fmt.Errorf("Subnet '%s' is ambiguous, it exists in both VPC '%s' and VPC '%s'", subnetName, vpcNames[0], vpcNames[1])
+			}
+			found = &subnetIIDInfo
+		}
+		if found == nil {
+			return nil, fmt.Errorf("Subnet '%s' not found in the peered VPCs '%s' and '%s'", subnetName, vpcNames[0], vpcNames[1])
+		}
+		subnetIIDs = append(subnetIIDs, getDriverIID(cres.IID{NameId: found.NameId, SystemId: found.SystemId}))
+	}
+	return subnetIIDs, nil
+}
+
+// resolveVPCPeeringRelatedIIDs resolves the NameIds of the VPCs and the Subnets from the CSP SystemIds.
+func resolveVPCPeeringRelatedIIDs(connectionName string, info *cres.VPCPeeringInfo) {
+	for _, vpcIID := range []*cres.IID{&info.RequesterVpcIID, &info.AccepterVpcIID} {
+		if vpcIID.SystemId == "" {
+			continue
+		}
+		var vpcInfo VPCIIDInfo
+		if err := infostore.GetByContain(&vpcInfo, CONNECTION_NAME_COLUMN, connectionName, SYSTEM_ID_COLUMN, vpcIID.SystemId); err == nil {
+			vpcIID.NameId = vpcInfo.NameId
+		}
+	}
+
+	for i, subnetIID := range info.RoutedSubnetIIDs {
+		if subnetIID.SystemId == "" {
+			continue
+		}
+		var subnetInfo SubnetIIDInfo
+		if err := infostore.GetByContain(&subnetInfo, CONNECTION_NAME_COLUMN, connectionName, SYSTEM_ID_COLUMN, subnetIID.SystemId); err == nil {
+			info.RoutedSubnetIIDs[i].NameId = subnetInfo.NameId
+		}
+	}
+}
@@ -0,0 +1,277 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Worker Pool Manager — bounds the concurrency and the request rate of CSP API calls per provider.
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"math/rand"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+)
+
+// default limits of a provider, 0 means unlimited.
+// The CSP API calls are not limited unless configured, the throttled calls are retried with backoff anyway.
+//
+// The limits can be set with the environment variables, a provider-specific one takes precedence:
+//
+//	SPIDER_RATE_LIMIT_RPS, SPIDER_RATE_LIMIT_RPS_<PROVIDER>                   ex) SPIDER_RATE_LIMIT_RPS_AWS=20
+//	SPIDER_RATE_LIMIT_MAX_INFLIGHT, SPIDER_RATE_LIMIT_MAX_INFLIGHT_<PROVIDER> ex) SPIDER_RATE_LIMIT_MAX_INFLIGHT_AZURE=5
+const (
+	DefaultRequestsPerSec = 0
+	DefaultMaxInFlight    = 0
+)
+
+// retry of a throttled call: 0.5s, 1s, 2s, 4s, 8s (+ jitter)
+const (
+	throttleMaxRetry    = 5
+	throttleBaseBackoff = 500 * time.Millisecond
+	throttleMaxBackoff  = 16 * time.Second
+)
+
+// providerLimitInfo: the limits of the CSP API calls of a provider, 0 means unlimited.
+type providerLimitInfo struct {
+	ProviderName   string
+	RequestsPerSec float64
+	MaxInFlight    int
+}
+
+type providerLimiter struct {
+	info     providerLimitInfo
+	interval time.Duration // 0: unlimited
+	inFlight chan struct{} // nil: unlimited
+
+	mutex    sync.Mutex
+	nextSlot time.Time
+}
+
+var providerLimiterMap = map[string]*providerLimiter{}
+var providerLimiterMutex sync.Mutex
+
+func newProviderLimiter(info providerLimitInfo) *providerLimiter {
+	limiter := &providerLimiter{info: info}
+	if info.RequestsPerSec > 0 {
+		limiter.interval = time.Duration(float64(time.Second) / info.RequestsPerSec)
+	}
+	if info.MaxInFlight > 0 {
+		limiter.inFlight = make(chan struct{}, info.MaxInFlight)
+	}
+	return limiter
+}
+
+// acquire waits for an in-flight slot and for the next request slot.
+func (limiter *providerLimiter) acquire() {
+	if limiter.inFlight != nil {
+		limiter.inFlight <- struct{}{}
+	}
+	limiter.waitRate()
+}
+
+// waitRate waits for the next request slot.
+func (limiter *providerLimiter) waitRate() {
+	if limiter.interval <= 0 {
+		return
+	}
+
+	limiter.mutex.Lock()
+	now := time.Now()
+	if limiter.nextSlot.Before(now) {
+		limiter.nextSlot = now
+	}
+	wait := limiter.nextSlot.Sub(now)
+	limiter.nextSlot = limiter.nextSlot.Add(limiter.interval)
+	limiter.mutex.Unlock()
+
+	if wait > 0 {
+		time.Sleep(wait)
+	}
+}
+
+func (limiter *providerLimiter) release() {
+	if limiter.inFlight != nil {
+		<-limiter.inFlight
+	}
+}
+
+func getProviderLimiter(providerName string) *providerLimiter {
+	providerName = strings.ToUpper(providerName)
+
+	providerLimiterMutex.Lock()
+	defer providerLimiterMutex.Unlock()
+	limiter, ok := providerLimiterMap[providerName]
+	if !ok {
+		limiter = newProviderLimiter(providerLimitInfo{
+			ProviderName:   providerName,
+			RequestsPerSec: getLimitEnv("SPIDER_RATE_LIMIT_RPS", providerName, DefaultRequestsPerSec),
+			MaxInFlight:    int(getLimitEnv("SPIDER_RATE_LIMIT_MAX_INFLIGHT", providerName, DefaultMaxInFlight)),
+		})
+		providerLimiterMap[providerName] = limiter
+	}
+	return limiter
+}
+
+func getLimitEnv(envName string, providerName string, defaultValue float64) float64 {
+	for _, name := range []string{envName + "_" + providerName, envName} {
+		strValue := strings.TrimSpace(os.Getenv(name))
+		if strValue == "" {
+			continue
+		}
+		value, err := strconv.ParseFloat(strValue, 64)
+		if err != nil || value < 0 {
+			cblog.Errorf("%s=%s: invalid value, ignored", name, strValue)
+			continue
+		}
+		return value
+	}
+	return defaultValue
+}
+
+// getConnectionLimiter returns the limiter of the connection's provider.
+func getConnectionLimiter(connectionName string) *providerLimiter {
+	providerName, err := ccm.GetProviderNameByConnectionName(connectionName)
+	if err != nil {
+		// limit with the default limits of an unknown provider
+		cblog.Error(err)
+	}
+	return getProviderLimiter(providerName)
+}
+
+// callWithLimit calls fn with the limits of the connection's provider
+// and retries it with backoff while the CSP throttles the requests.
+func callWithLimit(connectionName string, fn func() error) error {
+	return getConnectionLimiter(connectionName).call(fn)
+}
+
+func (limiter *providerLimiter) call(fn func() error) error {
+	return limiter.callWithRetry(func() error {
+		limiter.acquire()
+		defer limiter.release()
+		return fn()
+	})
+}
+
+// callLongRunning calls a long-running fn, like a deletion waiting for the CSP, with the request rate
+// of the provider but without an in-flight slot, so that it does not starve the other calls of the provider.
+func (limiter *providerLimiter) callLongRunning(fn func() error) error {
+	return limiter.callWithRetry(func() error {
+		limiter.waitRate()
+		return fn()
+	})
+}
+
+// callWithRetry retries fn with backoff while the CSP throttles the requests.
+func (limiter *providerLimiter) callWithRetry(fn func() error) error {
+	var err error
+	for retry := 0; ; retry++ {
+		err = fn()
+		if err == nil || !isThrottlingError(err) || retry >= throttleMaxRetry {
+			return err
+		}
+
+		backoff := throttleBackoff(retry)
+		cblog.Infof("%s: throttled, retry(%d/%d) after %v: %v", limiter.info.ProviderName, retry+1, throttleMaxRetry, backoff, err)
+		throttleSleep(backoff)
+	}
+}
+
+// sleep between the retries of a throttled call, replaced in the tests
+var throttleSleep = time.Sleep
+
+// throttleBackoff returns the backoff before the retry(0..): base * 2^retry up to the max, plus a jitter of up to 50%.
+func throttleBackoff(retry int) time.Duration {
+	backoff := throttleMaxBackoff
+	if retry < 16 {
+		backoff = throttleBaseBackoff << retry
+	}
+	if backoff > throttleMaxBackoff {
+		backoff = throttleMaxBackoff
+	}
+	return backoff + time.Duration(rand.Int63n(int64(backoff)/2))
+}
+
+// runFanOut runs fn(0..n-1) with the limits of the connection's provider and returns the error of each call.
+// Each fn holds an in-flight slot of the provider, so fn must be a short CSP call.
+// fn must not call runFanOut or callWithLimit of the same provider, the nested call can wait for a slot forever.
+func runFanOut(connectionName string, n int, fn func(idx int) error) []error {
+	limiter := getConnectionLimiter(connectionName)
+	return runWorkers(n, limiter.info.MaxInFlight, func(idx int) error {
+		return limiter.call(func() error { return fn(idx) })
+	})
+}
+
+// runLongRunningFanOut runs long-running fn(0..n-1), like the deletions waiting for the CSP,
+// with the request rate of the connection's provider and returns the error of each call.
+// The calls use their own workers, up to MaxInFlight of the provider, instead of the in-flight slots
+// shared with the other calls of the provider. fn can call the Spider APIs using callWithLimit.
+func runLongRunningFanOut(connectionName string, n int, fn func(idx int) error) []error {
+	limiter := getConnectionLimiter(connectionName)
+	return runWorkers(n, limiter.info.MaxInFlight, func(idx int) error {
+		return limiter.callLongRunning(func() error { return fn(idx) })
+	})
+}
+
+// runWorkers runs call(0..n-1) with up to maxWorkers(0: n) goroutines and returns the error of each call.
+func runWorkers(n int, maxWorkers int, call func(idx int) error) []error {
+	errList := make([]error, n)
+	if n <= 0 {
+		return errList
+	}
+
+	workerNum := n
+	if maxWorkers > 0 && maxWorkers < workerNum {
+		workerNum = maxWorkers
+	}
+
+	idxCh := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < workerNum; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range idxCh {
+				errList[idx] = call(idx)
+			}
+		}()
+	}
+	for idx := 0; idx < n; idx++ {
+		idxCh <- idx
+	}
+	close(idxCh)
+	wg.Wait()
+
+	return errList
+}
+
+// firstError returns the first non-nil error of errList.
+func firstError(errList []error) error {
+	for _, err := range errList {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ex) AWS: Throttling, RequestLimitExceeded, Azure: 429 Too Many Requests, GCP: rateLimitExceeded,
+// Alibaba: Throttling.User, Tencent: RequestLimitExceeded, NCP: too many requests, OpenStack: 429
+var throttlingErrorRegexp = regexp.MustCompile(`(?i)(throttl|rate ?limit|rate exceeded|too ?many ?requests|request ?limit|\b429\b)`)
+
+// isThrottlingError checks if a driver error is caused by the rate limit of the CSP API.
+func isThrottlingError(err error) bool {
+	if err == nil {
+		return false
+	}
+	return throttlingErrorRegexp.MatchString(err.Error())
+}
@@ -0,0 +1,210 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package commonruntime
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunWorkers(t *testing.T) {
+	testList := []struct {
+		n          int
+		maxWorkers int
+		maxRunning int32 // expected max number of the concurrent calls
+	}{
+		{0, 0, 0},
+		{5, 0, 5}, // 0: n workers
+		{5, 10, 5},
+		{10, 3, 3},
+		{7, 1, 1},
+	}
+
+	for _, test := range testList {
+		var running, maxRunning int32
+		var lock sync.Mutex
+		calledMap := map[int]int{}
+
+		errList := runWorkers(test.n, test.maxWorkers, func(idx int) error {
+			now := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				old := atomic.LoadInt32(&maxRunning)
+				if now <= old || atomic.CompareAndSwapInt32(&maxRunning, old, now) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond) // keep the workers busy together
+
+			lock.Lock()
+			calledMap[idx]++
+			lock.Unlock()
+			if idx%2 == 1 {
+				return fmt.Errorf("error of %d", idx)
+			}
+			return nil
+		})
+
+		if len(errList) != test.n {
+			t.Errorf("n %d: %d errors", test.n, len(errList))
+		}
+		if maxRunning != test.maxRunning {
+			t.Errorf("n %d, maxWorkers %d: %d concurrent calls, expected %d", test.n, test.maxWorkers, maxRunning, test.maxRunning)
+		}
+		for idx := 0; idx < test.n; idx++ {
+			if calledMap[idx] != 1 {
+				t.Errorf("n %d: call(%d) is called %d times", test.n, idx, calledMap[idx])
+			}
+			// the error is kept at the index of the call
+			if (errList[idx] != nil) != (idx%2 == 1) {
+				t.Errorf("n %d: error of call(%d) is %v", test.n, idx, errList[idx])
+			}
+		}
+		if test.n > 1 && firstError(errList).Error() != "error of 1" {
+			t.Errorf("n %d: first error is %v", test.n, firstError(errList))
+		}
+	}
+}
+
+func TestIsThrottlingError(t *testing.T) {
+	testList := []struct {
+		err        error
+		throttling bool
+	}{
+		{nil, false},
+		{errors.New("Throttling: Rate exceeded"), true},                                     // AWS
+		{errors.New("RequestLimitExceeded: Request limit exceeded."), true},                 // AWS, Tencent
+		{errors.New("Status=429 Code=\"TooManyRequests\""), true},                           // Azure
+		{errors.New("googleapi: Error 403: Rate Limit Exceeded, rateLimitExceeded"), true},  // GCP
+		{errors.New("Throttling.User: Request was denied due to user flow control."), true}, // Alibaba
+		{errors.New("too many requests"), true},                                             // NCP
+		{errors.New("Expected HTTP response code [200] but got 429"), true},                 // OpenStack
+		{errors.New("InvalidInstanceID.NotFound: The instance ID 'i-0429' does not exist"), false},
+		{errors.New("port 4290 is not allowed"), false},
+		{errors.New("UnauthorizedOperation: You are not authorized"), false},
+	}
+
+	for _, test := range testList {
+		if throttling := isThrottlingError(test.err); throttling != test.throttling {
+			t.Errorf("%v: throttling %v is not %v", test.err, throttling, test.throttling)
+		}
+	}
+}
+
+func TestThrottleBackoff(t *testing.T) {
+	for retry := 0; retry <= 20; retry++ {
+		base := throttleMaxBackoff
+		if retry < 5 {
+			base = throttleBaseBackoff << retry // 0.5s, 1s, 2s, 4s, 8s
+		}
+		for i := 0; i < 10; i++ {
+			backoff := throttleBackoff(retry)
+			if backoff < base || backoff >= base+base/2 {
+				t.Errorf("retry %d: backoff %v is out of [%v, %v)", retry, backoff, base, base+base/2)
+			}
+		}
+	}
+}
+
+func TestCallWithRetry(t *testing.T) {
+	var sleepList []time.Duration
+	throttleSleep = func(d time.Duration) { sleepList = append(sleepList, d) }
+	defer func() { throttleSleep = time.Sleep }()
+
+	throttled := errors.New("Throttling: Rate exceeded")
+	otherErr := errors.New("InvalidParameterValue")
+
+	testList := []struct {
+		name      string
+		errList   []error // errors of the calls in order, nil after the list
+		calls     int
+		expectErr error
+	}{
+		{"success", nil, 1, nil},
+		{"throttled twice", []error{throttled, throttled}, 3, nil},
+		{"not throttling error", []error{otherErr}, 1, otherErr},
+		{"throttled then other error", []error{throttled, otherErr}, 2, otherErr},
+		{"always throttled", []error{throttled, throttled, throttled, throttled, throttled, throttled, throttled}, throttleMaxRetry + 1, throttled},
+	}
+
+	limiter := newProviderLimiter(providerLimitInfo{ProviderName: "MOCK"})
+	for _, test := range testList {
+		sleepList = nil
+		calls := 0
+		err := limiter.call(func() error {
+			calls++
+			if calls <= len(test.errList) {
+				return test.errList[calls-1]
+			}
+			return nil
+		})
+		if err != test.expectErr {
+			t.Errorf("%s: error %v is not %v", test.name, err, test.expectErr)
+		}
+		if calls != test.calls {
+			t.Errorf("%s: called %d times, expected %d", test.name, calls, test.calls)
+		}
+		// a backoff before each retry, increasing
+		if len(sleepList) != calls-1 {
+			t.Errorf("%s: %d backoffs for %d calls", test.name, len(sleepList), calls)
+		}
+		for i := 1; i < len(sleepList); i++ {
+			if sleepList[i] <= sleepList[i-1] {
+				t.Errorf("%s: backoff %v is not longer than %v", test.name, sleepList[i], sleepList[i-1])
+			}
+		}
+	}
+}
+
+func TestProviderLimiter(t *testing.T) {
+	// unlimited unless configured
+	limiter := newProviderLimiter(providerLimitInfo{
+		ProviderName:   "MOCK",
+		RequestsPerSec: getLimitEnv("SPIDER_RATE_LIMIT_RPS_TEST", "MOCK", DefaultRequestsPerSec),
+		MaxInFlight:    int(getLimitEnv("SPIDER_RATE_LIMIT_MAX_INFLIGHT_TEST", "MOCK", DefaultMaxInFlight)),
+	})
+	if limiter.interval != 0 || limiter.inFlight != nil {
+		t.Errorf("the default limiter is not unlimited: %+v", limiter.info)
+	}
+
+	// a provider-specific value takes precedence, an invalid value is ignored
+	t.Setenv("SPIDER_RATE_LIMIT_RPS_TEST", "20")
+	t.Setenv("SPIDER_RATE_LIMIT_RPS_TEST_AWS", "5")
+	t.Setenv("SPIDER_RATE_LIMIT_RPS_TEST_AZURE", "-1")
+	testList := []struct {
+		providerName string
+		value        float64
+	}{
+		{"AWS", 5},
+		{"AZURE", 20},
+		{"GCP", 20},
+	}
+	for _, test := range testList {
+		if value := getLimitEnv("SPIDER_RATE_LIMIT_RPS_TEST", test.providerName, DefaultRequestsPerSec); value != test.value {
+			t.Errorf("%s: %v is not %v", test.providerName, value, test.value)
+		}
+	}
+
+	// the requests are spread with the rate: 5 calls at 100 rps take 40ms or more
+	limiter = newProviderLimiter(providerLimitInfo{ProviderName: "MOCK", RequestsPerSec: 100, MaxInFlight: 2})
+	start := time.Now()
+	errList := runWorkers(5, limiter.info.MaxInFlight, func(idx int) error {
+		return limiter.call(func() error { return nil })
+	})
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("5 calls at 100 rps take %v", elapsed)
+	}
+	if err := firstError(errList); err != nil {
+		t.Error(err)
+	}
+}
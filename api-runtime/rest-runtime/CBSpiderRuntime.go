@@ -215,6 +215,8 @@ func getRoutes() []route {
 		//----------SystemStatsInfo Handler
 		{"GET", "/sysstats/system", FetchSystemInfo},
 		{"GET", "/sysstats/usage", FetchResourceUsage},
+		{"GET", "/sysstats/connectioncache", FetchConnectionCacheStats},
+		{"DELETE", "/sysstats/connectioncache", ClearConnectionCache},
 
 		//----------CloudOS
 		{"GET", "/cloudos", ListCloudOS},
@@ -236,19 +238,26 @@ func getRoutes() []route {
 		{"POST", "/credential", RegisterCredential},
 		{"GET", "/credential", ListCredential},
 		{"GET", "/credential/:CredentialName", GetCredential},
+		{"PUT", "/credential/:CredentialName", UpdateCredential},
 		{"DELETE", "/credential/:CredentialName", UnRegisterCredential},
+		//-- for master key of credential encryption
+		{"GET", "/masterkey", GetMasterKeyInfo},
+		{"POST", "/masterkey/rotate", RotateMasterKey},
 
 		//----------RegionInfo
 		{"POST", "/region", RegisterRegion},
 		{"GET", "/region", ListRegion},
 		{"GET", "/region/:RegionName", GetRegion},
+		{"PUT", "/region/:RegionName", UpdateRegion},
 		{"DELETE", "/region/:RegionName", UnRegisterRegion},
 
 		//----------ConnectionConfigInfo
 		{"POST", "/connectionconfig", CreateConnectionConfig},
 		{"GET", "/connectionconfig", ListConnectionConfig},
 		{"GET", "/connectionconfig/:ConfigName", GetConnectionConfig},
+		{"PUT", "/connectionconfig/:ConfigName", UpdateConnectionConfig},
 		{"DELETE", "/connectionconfig/:ConfigName", DeleteConnectionConfig},
+		{"POST", "/connectionconfig/:ConfigName/verify", VerifyConnectionConfig},
 		//-- for dashboard
 		{"GET", "/countconnectionconfig", CountAllConnections},
 		{"GET", "/countconnectionconfig/:ProviderName", CountConnectionsByProvider},
@@ -333,6 +342,7 @@ func getRoutes() []route {
 		//-- for rule
 		{"POST", "/securitygroup/:SGName/rules", AddRules},
 		{"DELETE", "/securitygroup/:SGName/rules", RemoveRules}, // no force option
+		{"PUT", "/securitygroup/:SGName/rules", SyncRules},      // ?dryRun=true: diff only
 		// no CSP Option, {"DELETE", "/securitygroup/:SGName/csprules", RemoveCSPRules},
 		//-- for management
 		{"GET", "/allsecuritygroup", ListAllSecurity},
@@ -397,6 +407,44 @@ func getRoutes() []route {
 		{"GET", "/countnic", CountAllNICs},
 		{"GET", "/countnic/:ConnectionName", CountNICsByConnection},
 
+		//----------VPC Peering Handler
+		{"POST", "/vpcpeering", CreateVPCPeering},
+		{"GET", "/vpcpeering", ListVPCPeering},
+		{"GET", "/vpcpeering/:Name", GetVPCPeering},
+		{"DELETE", "/vpcpeering/:Name", DeleteVPCPeering},
+
+		{"PUT", "/vpcpeering/:Name/accept", AcceptVPCPeering},
+
+		{"POST", "/vpcpeering/:Name/routes", AddVPCPeeringRoutes},
+		{"DELETE", "/vpcpeering/:Name/routes", RemoveVPCPeeringRoutes},
+
+		{"GET", "/countvpcpeering", CountAllVPCPeerings},
+		{"GET", "/countvpcpeering/:ConnectionName", CountVPCPeeringsByConnection},
+
+		//----------Route Table Handler
+		{"POST", "/routetable", CreateRouteTable},
+		{"GET", "/routetable", ListRouteTable},
+		{"GET", "/routetable/:Name", GetRouteTable},
+		{"DELETE", "/routetable/:Name", DeleteRouteTable},
+
+		{"POST", "/routetable/:Name/routes", AddRoutes},
+		{"DELETE", "/routetable/:Name/routes", RemoveRoutes},
+
+		{"PUT", "/routetable/:Name/associate", AssociateRouteTableSubnet},
+		{"PUT", "/routetable/:Name/disassociate", DisassociateRouteTableSubnet},
+
+		{"GET", "/countroutetable", CountAllRouteTables},
+		{"GET", "/countroutetable/:ConnectionName", CountRouteTablesByConnection},
+
+		//----------NAT Gateway Handler
+		{"POST", "/natgateway", CreateNATGateway},
+		{"GET", "/natgateway", ListNATGateway},
+		{"GET", "/natgateway/:Name", GetNATGateway},
+		{"DELETE", "/natgateway/:Name", DeleteNATGateway},
+
+		{"GET", "/countnatgateway", CountAllNATGateways},
+		{"GET", "/countnatgateway/:ConnectionName", CountNATGatewaysByConnection},
+
 		{"GET", "/countpublicip", CountAllPublicIPs},
 		{"GET", "/countpublicip/:ConnectionName", CountPublicIPsByConnection},
 
@@ -418,6 +466,8 @@ func getRoutes() []route {
 		// only for AdminWeb
 		{"PUT", "/controlvm/:Name", ControlVM}, // suspend, resume, reboot
 
+		{"PUT", "/vm/:Name/spec", ChangeVMSpec},
+
 		//-- for management
 		{"GET", "/allvm", ListAllVM},
 		{"GET", "/allvminfo", ListAllVMInfo},
@@ -473,6 +523,11 @@ func getRoutes() []route {
 		//-- for vm
 		{"PUT", "/disk/:Name/attach", AttachDisk},
 		{"PUT", "/disk/:Name/detach", DetachDisk},
+		//-- for snapshot
+		{"POST", "/disk/:Name/snapshot", CreateDiskSnapshot},
+		{"GET", "/disk/:Name/snapshot", ListDiskSnapshot},
+		{"GET", "/disk/:Name/snapshot/:SnapshotName", GetDiskSnapshot},
+		{"DELETE", "/disk/:Name/snapshot/:SnapshotName", DeleteDiskSnapshot},
 
 		//-- for management
 		{"GET", "/alldisk", ListAllDisk},
@@ -549,6 +604,18 @@ func getRoutes() []route {
 		{"GET", "/monitoring/vm/:VMName/:MetricType", GetVMMetricData},
 		{"GET", "/monitoring/clusternode/:ClusterName/:NodeGroupName/:NodeNumber/:MetricType", GetClusterNodeMetricData},
 
+		//----------Async Job Handler
+		{"GET", "/job", ListJob},
+		{"GET", "/job/:Id", GetJob},
+		{"DELETE", "/job/:Id", DeleteJob},
+
+		//----------S3 Sync Handler
+		{"POST", "/s3sync", StartS3Sync},
+		{"GET", "/s3sync", ListS3Sync},
+		{"GET", "/s3sync/:Id", GetS3Sync},
+		{"PUT", "/s3sync/:Id/cancel", CancelS3Sync},
+		{"DELETE", "/s3sync/:Id", DeleteS3Sync},
+
 		//----------Destory All Resources in a Connection
 		{"DELETE", "/destroy", Destroy},
 
@@ -562,6 +629,14 @@ func getRoutes() []route {
 		{"GET", "/rdbms", ListRDBMS},
 		{"GET", "/rdbms/:Name", GetRDBMS},
 		{"DELETE", "/rdbms/:Name", DeleteRDBMS},
+		{"PUT", "/rdbms/:Name/spec", ChangeRDBMSSpec},
+		{"PUT", "/rdbms/:Name/storage", ChangeRDBMSStorageSize},
+		{"PUT", "/rdbms/:Name/start", StartRDBMS},
+		{"PUT", "/rdbms/:Name/stop", StopRDBMS},
+		{"POST", "/rdbms/:Name/snapshot", CreateRDBMSSnapshot},
+		{"GET", "/rdbms/:Name/snapshot", ListRDBMSSnapshot},
+		{"DELETE", "/rdbms/:Name/snapshot/:SnapshotName", DeleteRDBMSSnapshot},
+		{"POST", "/rdbms/:Name/snapshot/:SnapshotName/restore", RestoreRDBMSSnapshot},
 
 		//-- RDBMS database management (CSP-native API; drivers that support RDBMSDatabaseManager)
 		{"POST", "/rdbms/:Name/databases", CreateRDBMSDatabase},
@@ -963,6 +1038,12 @@ func ApiServer(routes []route) {
 	// AdminWeb session-based auth middleware (protects adminweb pages after BasicAuth skip)
 	e.Use(aw.AdminWebSessionMiddleware)
 
+	// dryRun only for the APIs that support it
+	e.Use(DryRunMiddleware)
+
+	// Idempotency-Key for resource-creating APIs
+	e.Use(IdempotencyMiddleware)
+
 	for _, route := range routes {
 		spiderPath := "/spider" + route.path
 		switch route.method {
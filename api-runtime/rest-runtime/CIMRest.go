@@ -10,7 +10,9 @@ package restruntime
 
 import (
 	"strconv"
+	"time"
 
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
 	im "github.com/cloud-barista/cb-spider/cloud-info-manager"
 	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
 	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
@@ -342,6 +344,35 @@ func GetCredential(c echo.Context) error {
 	return c.JSON(http.StatusOK, &crdinfo)
 }
 
+// updateCredential godoc
+// @ID update-credential
+// @Summary Update Credential
+// @Description Update a specific Credential. The connection configs using the Credential are updated together. <br> A key with the value 'Hidden for security.' keeps the current value.
+// @Tags [Cloud Info Management] Credential Info
+// @Accept  json
+// @Produce  json
+// @Param CredentialName path string true "The name of the Credential"
+// @Param CredentialInfo body cim.CredentialInfo true "Request body for updating a Credential"
+// @Success 200 {object} cim.CredentialInfo "Details of the updated Credential"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /credential/{CredentialName} [put]
+func UpdateCredential(c echo.Context) error {
+	cblog.Info("call UpdateCredential()")
+
+	req := &cim.CredentialInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	crdinfo, err := cim.UpdateCredentialInfo(c.Param("CredentialName"), *req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &crdinfo)
+}
+
 // unregisterCredential godoc
 // @ID unregister-credential
 // @Summary Unregister Credential
@@ -368,6 +399,59 @@ func UnRegisterCredential(c echo.Context) error {
 	return c.JSON(http.StatusOK, &resultInfo)
 }
 
+// MasterKeyRotationRequest represents the request body for rotating the master key.
+type MasterKeyRotationRequest struct {
+	NewMasterKey string `json:"NewMasterKey" example:"6f1c...(64 hex chars)"` // 32 bytes in raw, hex or base64. If empty, a random key is made(only with the key file).
+}
+
+// getMasterKeyInfo godoc
+// @ID get-master-key-info
+// @Summary Get Master Key Info
+// @Description Retrieve the ID and source of the master key for the credential encryption. The key itself is not returned.
+// @Tags [Cloud Info Management] Credential Info
+// @Produce  json
+// @Success 200 {object} cim.MasterKeyInfo "Master Key Info"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /masterkey [get]
+func GetMasterKeyInfo(c echo.Context) error {
+	cblog.Info("call GetMasterKeyInfo()")
+
+	info, err := cim.GetMasterKeyInfo()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, info)
+}
+
+// rotateMasterKey godoc
+// @ID rotate-master-key
+// @Summary Rotate Master Key
+// @Description Re-encrypt all Credentials and VM KeyPair private keys in Spider MetaDB with a new master key. <br> With the key file, the new key is saved into the file. With SPIDER_MASTER_KEY, set the new key to SPIDER_MASTER_KEY and the old key to SPIDER_MASTER_KEY_PREVIOUS before the next start.
+// @Tags [Cloud Info Management] Credential Info
+// @Accept  json
+// @Produce  json
+// @Param MasterKeyRotationRequest body restruntime.MasterKeyRotationRequest false "Request body for rotating the master key"
+// @Success 200 {object} cim.MasterKeyRotationInfo "Result of the rotation"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /masterkey/rotate [post]
+func RotateMasterKey(c echo.Context) error {
+	cblog.Info("call RotateMasterKey()")
+
+	req := MasterKeyRotationRequest{}
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	result, err := cim.RotateMasterKey(req.NewMasterKey)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
 // ================ Region Handler
 
 // registerRegion godoc
@@ -466,6 +550,35 @@ func GetRegion(c echo.Context) error {
 	return c.JSON(http.StatusOK, &crdinfo)
 }
 
+// updateRegion godoc
+// @ID update-region
+// @Summary Update Region
+// @Description Update a specific Region. The connection configs using the Region are updated together.
+// @Tags [Cloud Info Management] Region Info
+// @Accept  json
+// @Produce  json
+// @Param RegionName path string true "The name of the Region"
+// @Param RegionInfo body rim.RegionInfo true "Request body for updating a Region"
+// @Success 200 {object} rim.RegionInfo "Details of the updated Region"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /region/{RegionName} [put]
+func UpdateRegion(c echo.Context) error {
+	cblog.Info("call UpdateRegion()")
+
+	req := &rim.RegionInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	rgninfo, err := rim.UpdateRegionInfo(c.Param("RegionName"), *req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &rgninfo)
+}
+
 // unregisterRegion godoc
 // @ID unregister-region
 // @Summary Unregister Region
@@ -574,6 +687,67 @@ func GetConnectionConfig(c echo.Context) error {
 	return c.JSON(http.StatusOK, &crdinfo)
 }
 
+// updateConnectionConfig godoc
+// @ID update-connection-config
+// @Summary Update Connection Config
+// @Description Update the Driver, Credential and Region of a specific Connection Config. <br> The ConfigName and ProviderName can not be changed.
+// @Tags [Cloud Info Management] Connection Info
+// @Accept  json
+// @Produce  json
+// @Param ConfigName path string true "The name of the Connection Config"
+// @Param ConnectionConfigInfo body ccim.ConnectionConfigInfo true "Request body for updating a Connection Config"
+// @Success 200 {object} ccim.ConnectionConfigInfo "Details of the updated Connection Config"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /connectionconfig/{ConfigName} [put]
+func UpdateConnectionConfig(c echo.Context) error {
+	cblog.Info("call UpdateConnectionConfig()")
+
+	req := &ccim.ConnectionConfigInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	configinfo, err := ccim.UpdateConnectionConfigInfo(c.Param("ConfigName"), *req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &configinfo)
+}
+
+// verifyConnectionConfig godoc
+// @ID verify-connection-config
+// @Summary Verify Connection Config
+// @Description Verify a specific Connection Config with a cheap read to the CSP. <br> It returns whether the credential is accepted(AuthOK), the region is reachable(RegionReachable), and which handler factories of the driver succeed.
+// @Tags [Cloud Info Management] Connection Info
+// @Produce  json
+// @Param ConfigName path string true "The name of the Connection Config"
+// @Param timeout query int false "Timeout in seconds (default: 30)"
+// @Success 200 {object} cmrt.ConnectionVerifyInfo "Result of the verification"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid query parameter"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /connectionconfig/{ConfigName}/verify [post]
+func VerifyConnectionConfig(c echo.Context) error {
+	cblog.Info("call VerifyConnectionConfig()")
+
+	timeout := cmrt.DefaultConnectionVerifyTimeout
+	if timeoutStr := c.QueryParam("timeout"); timeoutStr != "" {
+		seconds, err := strconv.Atoi(timeoutStr)
+		if err != nil || seconds <= 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "timeout must be a positive number of seconds")
+		}
+		timeout = time.Duration(seconds) * time.Second
+	}
+
+	result, err := cmrt.VerifyConnectionConfig(c.Param("ConfigName"), timeout)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
 // deleteConnectionConfig godoc
 // @ID delete-connection-config
 // @Summary Delete Connection Config
@@ -178,7 +178,10 @@ type ClusterNodeGroupRequest struct {
 // @Accept  json
 // @Produce  json
 // @Param ClusterCreateRequest body restruntime.ClusterCreateRequest true "Request body for creating a Cluster"
-// @Success 200 {object} cres.ClusterInfo "Details of the created Cluster"
+// @Param async query string false "Run as an asynchronous Job and return the Job info(202) at once. ex) true or false(default: false)"
+// @Param dryRun query string false "Validate the request without creating the resource and return the plan(cmrt.CreatePlanInfo) with the blocking problems. The quota headroom check is best-effort and reported as a warning. ex) true or false(default: false)"
+// @Success 200 {object} cres.ClusterInfo "Details of the created Cluster, or the plan(cmrt.CreatePlanInfo) with '?dryRun=true'"
+// @Success 202 {object} cmrt.JobInfo "Job info of the asynchronous operation, poll it with GET /job/{Id}"
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
 // @Failure 404 {object} SimpleMsg "Resource Not Found"
 // @Failure 500 {object} SimpleMsg "Internal Server Error"
@@ -205,6 +208,20 @@ func CreateCluster(c echo.Context) error {
 		TagList:       req.ReqInfo.TagList,
 	}
 
+	if isDryRunRequest(c) {
+		result, err := cmrt.PlanCreateCluster(req.ConnectionName, CLUSTER, reqInfo)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, result)
+	}
+
+	if isAsyncRequest(c) {
+		return submitJob(c, req.ConnectionName, CLUSTER, "CreateCluster", reqInfo.IId.NameId, func() (interface{}, error) {
+			return cmrt.CreateCluster(req.ConnectionName, CLUSTER, reqInfo, req.IDTransformMode)
+		})
+	}
+
 	// Call common-runtime API
 	result, err := cmrt.CreateCluster(req.ConnectionName, CLUSTER, reqInfo, req.IDTransformMode)
 	if err != nil {
@@ -556,7 +573,9 @@ func ChangeNodeGroupScaling(c echo.Context) error {
 // @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body for deleting a Cluster"
 // @Param Name path string true "The name of the Cluster to delete"
 // @Param force query string false "Force delete the Cluster. ex) true or false(default: false)"
+// @Param async query string false "Run as an asynchronous Job and return the Job info(202) at once. ex) true or false(default: false)"
 // @Success 200 {object} BooleanInfo "Result of the delete operation"
+// @Success 202 {object} cmrt.JobInfo "Job info of the asynchronous operation, poll it with GET /job/{Id}"
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
 // @Failure 404 {object} SimpleMsg "Resource Not Found"
 // @Failure 500 {object} SimpleMsg "Internal Server Error"
@@ -572,6 +591,17 @@ func DeleteCluster(c echo.Context) error {
 
 	clusterName := c.Param("Name")
 
+	if isAsyncRequest(c) {
+		force := c.QueryParam("force")
+		return submitJob(c, req.ConnectionName, CLUSTER, "DeleteCluster", clusterName, func() (interface{}, error) {
+			result, err := cmrt.DeleteCluster(req.ConnectionName, CLUSTER, clusterName, force)
+			if err != nil {
+				return nil, err
+			}
+			return BooleanInfo{Result: strconv.FormatBool(result)}, nil
+		})
+	}
+
 	// Call common-runtime API
 	result, err := cmrt.DeleteCluster(req.ConnectionName, CLUSTER, clusterName, c.QueryParam("force"))
 	if err != nil {
@@ -11,6 +11,7 @@ package restruntime
 import (
 	"net"
 	"strconv"
+	"strings"
 	"time"
 
 	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
@@ -41,6 +42,13 @@ const (
 	RDBMS     string = string(cres.RDBMS)
 	PUBLICIP  string = string(cres.PUBLICIP)
 	NIC       string = string(cres.NIC)
+
+	VPCPEERING string = string(cres.VPCPEERING)
+	ROUTETABLE string = string(cres.ROUTETABLE)
+	NATGATEWAY string = string(cres.NATGATEWAY)
+
+	DISKSNAPSHOT  string = string(cres.DISKSNAPSHOT)
+	RDBMSSNAPSHOT string = string(cres.RDBMSSNAPSHOT)
 )
 
 //================ Common Request & Response
@@ -187,12 +195,15 @@ func GetCSPResourceInfo(c echo.Context) error {
 // Destroy godoc
 // @ID destroy-all-resource
 // @Summary Destroy all resources in a connection
-// @Description Deletes all resources associated with a specific cloud connection. This action is irreversible.
+// @Description Deletes the resources associated with a specific cloud connection in the order of the resource dependencies. This action is irreversible. <br> * A resource is deleted after the resources using it, ex) VM => NIC => PublicIP, VM => SecurityGroup => VPC. <br> * With 'prefix' or 'tag', only the matched resources are deleted, and a resource used by the resources not matched is not deleted. <br> * A resource whose info can not be retrieved is not deleted, and the other resources are deleted without it. <br> * With 'preview=true', the resources to be deleted are returned in order(cmrt.DestroyPlanInfo) without deletion.
 // @Tags [Utility]
 // @Accept  json
 // @Produce  json
 // @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body for deleting all resources"
-// @Success 200 {object} cmrt.DestroyedInfo "Details of the destroyed resources"
+// @Param preview query string false "Return the resources to be deleted in order without deletion. ex) true or false(default: false)"
+// @Param prefix query string false "Delete only the resources whose name starts with the prefix. ex) env1-"
+// @Param tag query string false "Delete only the resources with the tag. 'key=value' or 'key'(any value). ex) env=env1"
+// @Success 200 {object} cmrt.DestroyedInfo "Details of the destroyed resources, or the plan(cmrt.DestroyPlanInfo) with 'preview=true'"
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to missing parameters"
 // @Failure 500 {object} SimpleMsg "Internal Server Error"
 // @Router /destroy [delete]
@@ -205,8 +216,26 @@ func Destroy(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
+	}
+
+	filter := cmrt.DestroyFilter{
+		NamePrefix: c.QueryParam("prefix"),
+		Tag:        c.QueryParam("tag"),
+	}
+
+	if strings.EqualFold(strings.TrimSpace(c.QueryParam("preview")), "true") {
+		plan, err := cmrt.PreviewDestroy(req.ConnectionName, filter)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, plan)
+	}
+
 	// Call common-runtime API
-	result, err := cmrt.Destroy(req.ConnectionName)
+	result, err := cmrt.Destroy(req.ConnectionName, filter)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
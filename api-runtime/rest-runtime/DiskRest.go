@@ -112,6 +112,8 @@ type DiskCreateRequest struct {
 		DiskType string          `json:"DiskType" validate:"required" example:"gp2"`               // gp2 or default, if not specified, default is used
 		DiskSize string          `json:"DiskSize" validate:"required" example:"100"`               // 100 or default, if not specified, default is used (unit is GB)
 		TagList  []cres.KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+
+		SourceSnapshotName string `json:"SourceSnapshotName,omitempty" validate:"omitempty" example:"disk-01-snapshot"` // create the disk from this Disk Snapshot
 	} `json:"ReqInfo" validate:"required"`
 }
 
@@ -144,6 +146,8 @@ func CreateDisk(c echo.Context) error {
 		DiskType: req.ReqInfo.DiskType,
 		DiskSize: req.ReqInfo.DiskSize,
 		TagList:  req.ReqInfo.TagList,
+
+		SourceSnapshotIID: cres.IID{NameId: req.ReqInfo.SourceSnapshotName},
 	}
 
 	// Call common-runtime API
@@ -157,7 +161,8 @@ func CreateDisk(c echo.Context) error {
 
 // DiskListResponse represents the response body for listing Disks.
 type DiskListResponse struct {
-	Result []*cres.DiskInfo `json:"disk" validate:"required" description:"A list of Disk information"`
+	Result    []*cres.DiskInfo `json:"disk" validate:"required" description:"A list of Disk information"`
+	NextToken string           `json:"NextToken,omitempty" validate:"omitempty" description:"The token of the next page, empty at the last page"`
 }
 
 // listDisk godoc
@@ -168,6 +173,13 @@ type DiskListResponse struct {
 // @Accept  json
 // @Produce  json
 // @Param ConnectionName query string true "The name of the Connection to list Disks for"
+// @Param limit query int false "Max number of the items of a page(1~1000). ex) 50"
+// @Param nextToken query string false "The token of the next page returned by the previous page"
+// @Param sort query string false "Sort field: name(default), zone, status, created, with optional '-' prefix for the descending order. ex) -created"
+// @Param prefix query string false "Filter by the name prefix. ex) web-"
+// @Param zone query string false "Filter by the zone. ex) ap-northeast-2a"
+// @Param status query string false "Filter by the Disk status. ex) Available"
+// @Param tag query string false "Filter by the tag, 'key=value' or 'key'(any value). ex) env=prod"
 // @Success 200 {object} DiskListResponse "List of Disks"
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid query parameter"
 // @Failure 404 {object} SimpleMsg "Resource Not Found"
@@ -187,14 +199,26 @@ func ListDisk(c echo.Context) error {
 		req.ConnectionName = c.QueryParam("ConnectionName")
 	}
 
+	opt, err := getListOption(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
 	// Call common-runtime API
-	result, err := cmrt.ListDisk(req.ConnectionName, DISK)
+	var result []*cres.DiskInfo
+	nextToken := ""
+	if opt.IsEmpty() {
+		result, err = cmrt.ListDisk(req.ConnectionName, DISK)
+	} else {
+		result, nextToken, err = cmrt.ListDiskWithOption(req.ConnectionName, DISK, opt)
+	}
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(listErrorStatus(err), err.Error())
 	}
 
 	jsonResult := DiskListResponse{
-		Result: result,
+		Result:    result,
+		NextToken: nextToken,
 	}
 
 	return c.JSON(http.StatusOK, &jsonResult)
@@ -208,6 +232,10 @@ func ListDisk(c echo.Context) error {
 // @Accept  json
 // @Produce  json
 // @Param ConnectionName query string true "The name of the Connection to list Disks for"
+// @Param limit query int false "Max number of the items of a page(1~1000). The items are ordered by MappedList, OnlySpiderList and OnlyCSPList. ex) 50"
+// @Param nextToken query string false "The token of the next page returned by the previous page"
+// @Param sort query string false "Sort by the name: name(default) or -name"
+// @Param prefix query string false "Filter by the name prefix, the CSP ID for OnlyCSPList. ex) web-"
 // @Success 200 {object} AllResourceListResponse "List of all Disks within the specified connection, including Disks in CB-Spider only, CSP only, and mapped between both."
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
 // @Failure 404 {object} SimpleMsg "Resource Not Found"
@@ -227,12 +255,25 @@ func ListAllDisk(c echo.Context) error {
 		req.ConnectionName = c.QueryParam("ConnectionName")
 	}
 
+	opt, err := getListOption(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
 	// Call common-runtime API
 	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, DISK)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
+	if !opt.IsEmpty() {
+		page, nextToken, err := cmrt.PageAllResourceList(allResourceList, opt)
+		if err != nil {
+			return echo.NewHTTPError(listErrorStatus(err), err.Error())
+		}
+		return c.JSON(http.StatusOK, &AllResourceListPageResponse{AllResourceList: page, NextToken: nextToken})
+	}
+
 	return c.JSON(http.StatusOK, &allResourceList)
 }
 
@@ -0,0 +1,201 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package restruntime
+
+import (
+	"errors"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	// REST API (echo)
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"strconv"
+)
+
+//================ Disk Snapshot Handler
+
+// DiskSnapshotCreateRequest represents the request body for creating a snapshot of a Disk.
+type DiskSnapshotCreateRequest struct {
+	ConnectionName  string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	IDTransformMode string `json:"IDTransformMode,omitempty" validate:"omitempty" example:"ON"` // ON: transform CSP ID, OFF: no-transform CSP ID
+	ReqInfo         struct {
+		Name    string          `json:"Name" validate:"required" example:"disk-01-snapshot"`
+		TagList []cres.KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// createDiskSnapshot godoc
+// @ID create-disk-snapshot
+// @Summary Create Disk Snapshot
+// @Description Create a new snapshot of a specified Disk. A Disk can be created from the snapshot with 'SourceSnapshotName' of the Disk creation request.
+// @Tags [Disk Management]
+// @Accept  json
+// @Produce  json
+// @Param DiskSnapshotCreateRequest body restruntime.DiskSnapshotCreateRequest true "Request body for creating a Disk Snapshot"
+// @Param Name path string true "The name of the source Disk"
+// @Success 200 {object} cres.DiskSnapshotInfo "Details of the created Disk Snapshot"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /disk/{Name}/snapshot [post]
+func CreateDiskSnapshot(c echo.Context) error {
+	cblog.Info("call CreateDiskSnapshot()")
+
+	req := DiskSnapshotCreateRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Rest RegInfo => Driver ReqInfo
+	reqInfo := cres.DiskSnapshotInfo{
+		IId:     cres.IID{NameId: req.ReqInfo.Name, SystemId: req.ReqInfo.Name},
+		TagList: req.ReqInfo.TagList,
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.CreateDiskSnapshot(req.ConnectionName, DISKSNAPSHOT, c.Param("Name"), reqInfo, req.IDTransformMode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// DiskSnapshotListResponse represents the response body for listing Disk Snapshots.
+type DiskSnapshotListResponse struct {
+	Result []*cres.DiskSnapshotInfo `json:"snapshot" validate:"required" description:"A list of Disk Snapshot information"`
+}
+
+// listDiskSnapshot godoc
+// @ID list-disk-snapshot
+// @Summary List Disk Snapshots
+// @Description Retrieve a list of snapshots of a specified Disk, including the snapshots whose source Disk is already deleted.
+// @Tags [Disk Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionName query string true "The name of the Connection to list Disk Snapshots for"
+// @Param Name path string true "The name of the source Disk"
+// @Success 200 {object} DiskSnapshotListResponse "List of Disk Snapshots"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid query parameter"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /disk/{Name}/snapshot [get]
+func ListDiskSnapshot(c echo.Context) error {
+	cblog.Info("call ListDiskSnapshot()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ListDiskSnapshot(req.ConnectionName, DISKSNAPSHOT, c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	jsonResult := DiskSnapshotListResponse{
+		Result: result,
+	}
+
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+// getDiskSnapshot godoc
+// @ID get-disk-snapshot
+// @Summary Get Disk Snapshot
+// @Description Retrieve details of a specific snapshot of a Disk.
+// @Tags [Disk Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionName query string true "The name of the Connection to get a Disk Snapshot for"
+// @Param Name path string true "The name of the source Disk"
+// @Param SnapshotName path string true "The name of the Disk Snapshot to retrieve"
+// @Success 200 {object} cres.DiskSnapshotInfo "Details of the Disk Snapshot"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /disk/{Name}/snapshot/{SnapshotName} [get]
+func GetDiskSnapshot(c echo.Context) error {
+	cblog.Info("call GetDiskSnapshot()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.GetDiskSnapshot(req.ConnectionName, DISKSNAPSHOT, c.Param("Name"), c.Param("SnapshotName"))
+	if err != nil {
+		if errors.Is(err, cmrt.ErrNotSnapshotOfDisk) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// deleteDiskSnapshot godoc
+// @ID delete-disk-snapshot
+// @Summary Delete Disk Snapshot
+// @Description Delete a specified snapshot of a Disk. The snapshot can be deleted after the source Disk is deleted.
+// @Tags [Disk Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body for deleting a Disk Snapshot"
+// @Param Name path string true "The name of the source Disk"
+// @Param SnapshotName path string true "The name of the Disk Snapshot to delete"
+// @Param force query string false "Force delete the Disk Snapshot. ex) true or false(default: false)"
+// @Success 200 {object} BooleanInfo "Result of the delete operation"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /disk/{Name}/snapshot/{SnapshotName} [delete]
+func DeleteDiskSnapshot(c echo.Context) error {
+	cblog.Info("call DeleteDiskSnapshot()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.DeleteDiskSnapshot(req.ConnectionName, DISKSNAPSHOT, c.Param("Name"), c.Param("SnapshotName"), c.QueryParam("force"))
+	if err != nil {
+		if errors.Is(err, cmrt.ErrNotSnapshotOfDisk) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
@@ -0,0 +1,47 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package restruntime
+
+import (
+	"net/http"
+	"strings"
+
+	// REST API (echo)
+	"github.com/labstack/echo/v4"
+)
+
+//================ Dry-Run(Plan) Mode
+
+// APIs that support '?dryRun=true': "<method> <route path>"
+var dryRunSupportedRoutes = map[string]bool{
+	"POST /spider/vm":                         true, // plan of StartVM
+	"POST /spider/cluster":                    true, // plan of CreateCluster
+	"PUT /spider/securitygroup/:SGName/rules": true, // diff of SyncRules
+}
+
+// isDryRunRequest returns true if the request has '?dryRun=true'.
+// A dry-run request returns the plan(cmrt.CreatePlanInfo) without creating the resource.
+func isDryRunRequest(c echo.Context) bool {
+	return strings.EqualFold(strings.TrimSpace(c.QueryParam("dryRun")), "true")
+}
+
+// DryRunMiddleware rejects the 'dryRun' query parameter of the APIs that do not support it,
+// so a dry-run request is never run for real.
+func DryRunMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if _, ok := c.QueryParams()["dryRun"]; !ok {
+			return next(c)
+		}
+		route := c.Request().Method + " " + c.Path()
+		if !dryRunSupportedRoutes[route] {
+			return echo.NewHTTPError(http.StatusBadRequest, "dryRun is not supported by '"+route+"'")
+		}
+		return next(c)
+	}
+}
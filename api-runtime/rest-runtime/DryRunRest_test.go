@@ -0,0 +1,59 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package restruntime
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestDryRunMiddleware(t *testing.T) {
+	e := echo.New()
+	e.Use(DryRunMiddleware)
+
+	called := false
+	handler := func(c echo.Context) error {
+		called = true
+		return c.NoContent(http.StatusOK)
+	}
+	e.POST("/spider/vm", handler)
+	e.POST("/spider/cluster", handler)
+	e.PUT("/spider/securitygroup/:SGName/rules", handler)
+	e.POST("/spider/vpc", handler)
+	e.POST("/spider/disk", handler)
+
+	testList := []struct {
+		method string
+		target string
+		status int
+	}{
+		{http.MethodPost, "/spider/vm?dryRun=true", http.StatusOK},
+		{http.MethodPost, "/spider/cluster?dryRun=true", http.StatusOK},
+		{http.MethodPut, "/spider/securitygroup/sg-01/rules?dryRun=true", http.StatusOK},
+		{http.MethodPost, "/spider/vpc", http.StatusOK},
+		{http.MethodPost, "/spider/vpc?dryRun=true", http.StatusBadRequest},
+		{http.MethodPost, "/spider/disk?dryRun=false", http.StatusBadRequest},
+		{http.MethodPost, "/spider/disk?dryRun", http.StatusBadRequest},
+	}
+
+	for _, test := range testList {
+		called = false
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(test.method, test.target, nil))
+		if rec.Code != test.status {
+			t.Errorf("%s %s: status %d is not %d", test.method, test.target, rec.Code, test.status)
+		}
+		if called != (test.status == http.StatusOK) {
+			t.Errorf("%s %s: the handler is called: %v", test.method, test.target, called)
+		}
+	}
+}
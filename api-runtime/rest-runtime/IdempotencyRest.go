@@ -0,0 +1,130 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package restruntime
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+
+	// REST API (echo)
+	"github.com/labstack/echo/v4"
+)
+
+//================ Idempotency-Key for resource-creating APIs
+
+const HeaderIdempotencyKey = "Idempotency-Key"
+const HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+// resource-creating APIs that honor the Idempotency-Key header
+var idempotentCreatePaths = map[string]string{
+	"/spider/vpc":           VPC,
+	"/spider/securitygroup": SG,
+	"/spider/keypair":       KEY,
+	"/spider/vm":            VM,
+	"/spider/nlb":           NLB,
+	"/spider/disk":          DISK,
+	"/spider/myimage":       MYIMAGE,
+	"/spider/cluster":       CLUSTER,
+	"/spider/rdbms":         RDBMS,
+}
+
+// idempotentCreateRequest has the common fields of the create request bodies.
+type idempotentCreateRequest struct {
+	ConnectionName string
+	ReqInfo        struct {
+		Name string
+	}
+}
+
+// IdempotencyMiddleware replays the first response for the retried request with the same Idempotency-Key,
+// and rejects the same key with a different request body.
+// The key is scoped per connection name.
+func IdempotencyMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		idempotencyKey := c.Request().Header.Get(HeaderIdempotencyKey)
+		if idempotencyKey == "" || c.Request().Method != http.MethodPost {
+			return next(c)
+		}
+		rsType, ok := idempotentCreatePaths[c.Request().URL.Path]
+		if !ok {
+			return next(c)
+		}
+
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		c.Request().Body = io.NopCloser(bytes.NewReader(body))
+
+		var req idempotentCreateRequest
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &req); err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+			}
+		}
+		connectionName := strings.TrimSpace(req.ConnectionName)
+		if connectionName == "" {
+			connectionName = strings.TrimSpace(c.QueryParam("ConnectionName"))
+		}
+		idempotencyKey = strings.TrimSpace(idempotencyKey)
+
+		// the same key with the same request => the same hash
+		hash := sha256.Sum256(append([]byte(c.Request().URL.RawQuery+"\n"), body...))
+		requestPath := c.Request().Method + " " + c.Request().URL.Path
+
+		info, err := cmrt.BeginIdempotentRequest(connectionName, idempotencyKey, requestPath,
+			hex.EncodeToString(hash[:]), rsType, strings.TrimSpace(req.ReqInfo.Name))
+		if err != nil {
+			var idemErr *cmrt.IdempotencyError
+			if errors.As(err, &idemErr) {
+				return echo.NewHTTPError(idemErr.StatusCode, idemErr.Message)
+			}
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+
+		// retried request: replay the first response
+		if info != nil {
+			c.Response().Header().Set(HeaderIdempotentReplayed, "true")
+			return c.Blob(info.StatusCode, info.ContentType, []byte(info.ResponseBody))
+		}
+
+		// first request: run it and keep the response
+		resBody := new(bytes.Buffer)
+		mw := io.MultiWriter(c.Response().Writer, resBody)
+		c.Response().Writer = &bodyDumpResponseWriter{Writer: mw, ResponseWriter: c.Response().Writer}
+
+		if err := next(c); err != nil {
+			c.Error(err)
+		}
+
+		status := c.Response().Status
+		if status >= http.StatusInternalServerError {
+			// failed by server or CSP error, the same key can be retried
+			if err := cmrt.AbortIdempotentRequest(connectionName, idempotencyKey); err != nil {
+				cblog.Error(err)
+			}
+			return nil
+		}
+
+		err = cmrt.CompleteIdempotentRequest(connectionName, idempotencyKey, status,
+			c.Response().Header().Get(echo.HeaderContentType), resBody.String())
+		if err != nil {
+			cblog.Error(err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,124 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package restruntime
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+
+	// REST API (echo)
+	"github.com/labstack/echo/v4"
+)
+
+//================ Async Job Management
+
+// isAsyncRequest returns true if the request has '?async=true'.
+func isAsyncRequest(c echo.Context) bool {
+	return strings.EqualFold(strings.TrimSpace(c.QueryParam("async")), "true")
+}
+
+// submitJob runs jobFunc in the background and returns the job info with 202 Accepted.
+func submitJob(c echo.Context, connectionName string, rsType string, operation string, nameID string,
+	jobFunc func() (interface{}, error)) error {
+
+	jobInfo, err := cmrt.SubmitJob(connectionName, rsType, operation, nameID, jobFunc)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	c.Response().Header().Set(echo.HeaderLocation, "/spider/job/"+jobInfo.JobId)
+	return c.JSON(http.StatusAccepted, jobInfo)
+}
+
+// JobListResponse represents the response body structure for listing Jobs.
+type JobListResponse struct {
+	Result []*cmrt.JobInfo `json:"job" validate:"required"`
+}
+
+// listJob godoc
+// @ID list-job
+// @Summary List Jobs
+// @Description Retrieve a list of asynchronous Jobs, newest first. Jobs are created by the API calls with '?async=true'.
+// @Tags [Job Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionName query string false "The name of the Connection to list Jobs for. If empty, Jobs of all Connections are listed."
+// @Success 200 {object} JobListResponse "List of Jobs"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /job [get]
+func ListJob(c echo.Context) error {
+	cblog.Info("call ListJob()")
+
+	// Call common-runtime API
+	result, err := cmrt.ListJob(c.QueryParam("ConnectionName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	jobListResponse := JobListResponse{
+		Result: result,
+	}
+
+	return c.JSON(http.StatusOK, &jobListResponse)
+}
+
+// getJob godoc
+// @ID get-job
+// @Summary Get Job
+// @Description Retrieve the state of an asynchronous Job. The Result has the same form as the synchronous API's response when the Status is Succeeded.
+// @Tags [Job Management]
+// @Accept  json
+// @Produce  json
+// @Param Id path string true "The ID of the Job to retrieve"
+// @Success 200 {object} cmrt.JobInfo "Details of the Job"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /job/{Id} [get]
+func GetJob(c echo.Context) error {
+	cblog.Info("call GetJob()")
+
+	// Call common-runtime API
+	result, err := cmrt.GetJob(c.Param("Id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// deleteJob godoc
+// @ID delete-job
+// @Summary Delete Job
+// @Description Delete a finished(Succeeded or Failed) asynchronous Job from the Job list.
+// @Tags [Job Management]
+// @Accept  json
+// @Produce  json
+// @Param Id path string true "The ID of the Job to delete"
+// @Success 200 {object} BooleanInfo "Result of the delete operation"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /job/{Id} [delete]
+func DeleteJob(c echo.Context) error {
+	cblog.Info("call DeleteJob()")
+
+	// Call common-runtime API
+	result, err := cmrt.DeleteJob(c.Param("Id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
@@ -0,0 +1,62 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package restruntime
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+
+	// REST API (echo)
+	"github.com/labstack/echo/v4"
+)
+
+//================ List Options(Pagination, Filtering and Sorting)
+
+// AllResourceListPageResponse represents a page of the list of all resources.
+type AllResourceListPageResponse struct {
+	cmrt.AllResourceList
+	NextToken string `json:"NextToken,omitempty" example:"eyJzIjoiIiwiayI6IjA6dm0tMTAifQ"` // token of the next page, empty at the last page
+}
+
+// getListOption returns the list options from the query params:
+// limit, nextToken, sort, prefix, zone, status and tag.
+func getListOption(c echo.Context) (cmrt.ListOption, error) {
+	opt := cmrt.ListOption{
+		NextToken:  strings.TrimSpace(c.QueryParam("nextToken")),
+		Sort:       strings.TrimSpace(c.QueryParam("sort")),
+		NamePrefix: strings.TrimSpace(c.QueryParam("prefix")),
+		Zone:       strings.TrimSpace(c.QueryParam("zone")),
+		Status:     strings.TrimSpace(c.QueryParam("status")),
+		Tag:        strings.TrimSpace(c.QueryParam("tag")),
+	}
+
+	if limit := strings.TrimSpace(c.QueryParam("limit")); limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil || value <= 0 || value > cmrt.MaxListLimit {
+			return cmrt.ListOption{}, fmt.Errorf("invalid limit '%s': must be between 1 and %d", limit, cmrt.MaxListLimit)
+		}
+		opt.Limit = value
+	}
+
+	return opt, nil
+}
+
+// listErrorStatus returns 400 for an invalid list option, 500 for the other errors.
+func listErrorStatus(err error) int {
+	var optErr *cmrt.ListOptionError
+	if errors.As(err, &optErr) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
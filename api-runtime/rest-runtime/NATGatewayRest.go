@@ -0,0 +1,185 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package restruntime
+
+import (
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+//================ NAT Gateway Handler
+
+// NATGatewayCreateRequest represents the request body for creating a NAT Gateway.
+type NATGatewayCreateRequest struct {
+	ConnectionName  string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	IDTransformMode string `json:"IDTransformMode,omitempty" validate:"omitempty" example:"ON"`
+	ReqInfo         struct {
+		Name         string          `json:"Name" validate:"required" example:"nat-01"`
+		VPCName      string          `json:"VPCName" validate:"required" example:"vpc-01"`
+		SubnetName   string          `json:"SubnetName" validate:"required" example:"public-subnet-01"`
+		PublicIPName string          `json:"PublicIPName,omitempty" validate:"omitempty" example:"publicip-01"` // Leave empty for CSP allocation
+		TagList      []cres.KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// CreateNATGateway godoc
+// @ID create-natgateway
+// @Summary Create NAT Gateway
+// @Description Create a NAT Gateway in a Subnet. <br> * The PublicIP is optional, the CSP allocates a new address if it is empty. <br> * Route the egress traffic of the private Subnets to the NAT Gateway with a RouteTable.
+// @Tags [NAT Gateway Management]
+// @Accept  json
+// @Produce  json
+// @Param NATGatewayCreateRequest body restruntime.NATGatewayCreateRequest true "Request body for creating a NAT Gateway"
+// @Success 200 {object} cres.NATGatewayInfo "Details of the created NAT Gateway"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /natgateway [post]
+func CreateNATGateway(c echo.Context) error {
+	cblog.Info("call CreateNATGateway()")
+	req := NATGatewayCreateRequest{}
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	reqInfo := cres.NATGatewayReqInfo{
+		IId:         cres.IID{NameId: req.ReqInfo.Name},
+		VpcIID:      cres.IID{NameId: req.ReqInfo.VPCName},
+		SubnetIID:   cres.IID{NameId: req.ReqInfo.SubnetName},
+		PublicIPIID: cres.IID{NameId: req.ReqInfo.PublicIPName},
+		TagList:     req.ReqInfo.TagList,
+	}
+
+	result, err := cmrt.CreateNATGateway(req.ConnectionName, NATGATEWAY, reqInfo, req.IDTransformMode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// NATGatewayListResponse is the response body for listing NAT Gateways.
+type NATGatewayListResponse struct {
+	Result []*cres.NATGatewayInfo `json:"natgateway"`
+}
+
+// ListNATGateway godoc
+// @ID list-natgateway
+// @Summary List NAT Gateways
+// @Description Retrieve a list of NAT Gateways.
+// @Tags [NAT Gateway Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body containing the Connection Name"
+// @Success 200 {object} restruntime.NATGatewayListResponse "List of NAT Gateways"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /natgateway [get]
+func ListNATGateway(c echo.Context) error {
+	cblog.Info("call ListNATGateway()")
+	var req ConnectionRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	infoList, err := cmrt.ListNATGateway(req.ConnectionName, NATGATEWAY)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if infoList == nil {
+		infoList = []*cres.NATGatewayInfo{}
+	}
+	return c.JSON(http.StatusOK, &NATGatewayListResponse{Result: infoList})
+}
+
+// GetNATGateway godoc
+// @ID get-natgateway
+// @Summary Get NAT Gateway
+// @Description Retrieve details of a specific NAT Gateway.
+// @Tags [NAT Gateway Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body containing the Connection Name"
+// @Param Name path string true "The name of the NAT Gateway"
+// @Success 200 {object} cres.NATGatewayInfo "Details of the NAT Gateway"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /natgateway/{Name} [get]
+func GetNATGateway(c echo.Context) error {
+	cblog.Info("call GetNATGateway()")
+	var req ConnectionRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	result, err := cmrt.GetNATGateway(req.ConnectionName, NATGATEWAY, c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// DeleteNATGateway godoc
+// @ID delete-natgateway
+// @Summary Delete NAT Gateway
+// @Description Delete a NAT Gateway. A PublicIP given at the creation is not deleted. <br> * The routes to the NAT Gateway must be removed from the route tables first.
+// @Tags [NAT Gateway Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body containing the Connection Name"
+// @Param Name path string true "The name of the NAT Gateway to delete"
+// @Param force query string false "Force delete the NAT Gateway. ex) true or false(default: false)"
+// @Success 200 {object} BooleanInfo "Result of the delete operation"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /natgateway/{Name} [delete]
+func DeleteNATGateway(c echo.Context) error {
+	cblog.Info("call DeleteNATGateway()")
+	var req ConnectionRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	result, err := cmrt.DeleteNATGateway(req.ConnectionName, NATGATEWAY, c.Param("Name"), c.QueryParam("force"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, &BooleanInfo{Result: strconv.FormatBool(result)})
+}
+
+// CountAllNATGateways godoc
+// @ID count-all-natgateways
+// @Summary Count All NAT Gateways
+// @Description Get the total number of NAT Gateways registered across all connections.
+// @Tags [NAT Gateway Management]
+// @Produce  json
+// @Success 200 {object} CountResponse "Total count of NAT Gateways"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /countnatgateway [get]
+func CountAllNATGateways(c echo.Context) error {
+	count, err := cmrt.CountAllNATGateways()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, CountResponse{Count: int(count)})
+}
+
+// CountNATGatewaysByConnection godoc
+// @ID count-natgateway-by-connection
+// @Summary Count NAT Gateways by Connection
+// @Description Get the total number of NAT Gateways for a specific connection.
+// @Tags [NAT Gateway Management]
+// @Produce  json
+// @Param ConnectionName path string true "The name of the Connection"
+// @Success 200 {object} CountResponse "Total count of NAT Gateways for the connection"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /countnatgateway/{ConnectionName} [get]
+func CountNATGatewaysByConnection(c echo.Context) error {
+	count, err := cmrt.CountNATGatewaysByConnection(c.Param("ConnectionName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, CountResponse{Count: int(count)})
+}
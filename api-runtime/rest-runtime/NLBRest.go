@@ -154,25 +154,44 @@ type NLBCreateRequest struct {
 		Listener      NLBListenerCreateRequest `json:"Listener" validate:"required"`
 		VMGroup       NLBVMGroupRequest        `json:"VMGroup,omitempty" validate:"omitempty"`
 		HealthChecker NLBHealthCheckerRequest  `json:"HealthChecker" validate:"required"`
+		ListenerRules []NLBListenerRuleRequest `json:"ListenerRules,omitempty" validate:"omitempty"` // additional listeners, forwarding to the VMs of VMGroup
 		TagList       []cres.KeyValue          `json:"TagList,omitempty" validate:"omitempty"`
 	} `json:"ReqInfo" validate:"required"`
 }
 
 // NLBListenerCreateRequest represents the request body for the listener configuration in an NLB.
 type NLBListenerCreateRequest struct {
-	Protocol string `json:"Protocol" validate:"required" example:"TCP"` // TCP|UDP
-	Port     string `json:"Port" validate:"required" example:"22"`      // 1-65535
+	Protocol      string `json:"Protocol" validate:"required" example:"TCP"`                               // TCP|UDP|HTTP|HTTPS
+	Port          string `json:"Port" validate:"required" example:"22"`                                    // 1-65535
+	CertificateID string `json:"CertificateID,omitempty" validate:"omitempty" example:"my-certificate-id"` // HTTPS only, CSP ID of the certificate
+}
+
+// NLBListenerRuleRequest represents the request body for an additional listener and its target group in an NLB.
+type NLBListenerRuleRequest struct {
+	Listener      NLBListenerCreateRequest `json:"Listener" validate:"required"`
+	TargetGroup   NLBTargetGroupRequest    `json:"TargetGroup" validate:"required"`
+	HealthChecker NLBHealthCheckerRequest  `json:"HealthChecker" validate:"required"`
+}
+
+// NLBTargetGroupRequest represents the request body for the backend protocol and port of a listener rule.
+type NLBTargetGroupRequest struct {
+	Protocol string `json:"Protocol" validate:"required" example:"HTTP"` // TCP|UDP|HTTP|HTTPS
+	Port     string `json:"Port" validate:"required" example:"8080"`     // 1-65535
 }
 
 // createNLB godoc
 // @ID create-nlb
 // @Summary Create NLB
 // @Description Create a new Network Load Balancer (NLB) with specified configurations. 🕷️ [[Concept Guide](https://github.com/cloud-barista/cb-spider/wiki/Network-Load-Balancer-and-Driver-API)]
+// @Description ListenerRules adds the listeners forwarding to the VMs of VMGroup with their own target protocol, port and health checker.
+// @Description HTTP/HTTPS listeners and backends, HTTPS listeners with a CertificateID(required) and HTTP(S) health checks with a Path are supported in the CSPs with the capability.
 // @Tags [NLB Management]
 // @Accept  json
 // @Produce  json
 // @Param NLBCreateRequest body restruntime.NLBCreateRequest true "Request body for creating an NLB"
+// @Param async query string false "Run as an asynchronous Job and return the Job info(202) at once. ex) true or false(default: false)"
 // @Success 200 {object} cres.NLBInfo "Details of the created NLB"
+// @Success 202 {object} cmrt.JobInfo "Job info of the asynchronous operation, poll it with GET /job/{Id}"
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
 // @Failure 404 {object} SimpleMsg "Resource Not Found"
 // @Failure 500 {object} SimpleMsg "Internal Server Error"
@@ -211,6 +230,24 @@ func CreateNLB(c echo.Context) error {
 	}
 	reqInfo.HealthChecker = healthChecker
 
+	for _, ruleReq := range req.ReqInfo.ListenerRules {
+		ruleHealthChecker, err := convertHealthCheckerInfo(ruleReq.HealthChecker)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		reqInfo.ListenerRules = append(reqInfo.ListenerRules, cres.ListenerRuleInfo{
+			Listener:      convertListenerInfo(ruleReq.Listener),
+			TargetGroup:   cres.TargetGroupInfo{Protocol: ruleReq.TargetGroup.Protocol, Port: ruleReq.TargetGroup.Port},
+			HealthChecker: ruleHealthChecker,
+		})
+	}
+
+	if isAsyncRequest(c) {
+		return submitJob(c, req.ConnectionName, NLB, "CreateNLB", reqInfo.IId.NameId, func() (interface{}, error) {
+			return cmrt.CreateNLB(req.ConnectionName, NLB, reqInfo, req.IDTransformMode)
+		})
+	}
+
 	// Call common-runtime API
 	result, err := cmrt.CreateNLB(req.ConnectionName, NLB, reqInfo, req.IDTransformMode)
 	if err != nil {
@@ -223,8 +260,9 @@ func CreateNLB(c echo.Context) error {
 // convertListenerInfo converts an NLBListenerCreateRequest to ListenerInfo.
 func convertListenerInfo(listenerReq NLBListenerCreateRequest) cres.ListenerInfo {
 	return cres.ListenerInfo{
-		Protocol: listenerReq.Protocol,
-		Port:     listenerReq.Port,
+		Protocol:      listenerReq.Protocol,
+		Port:          listenerReq.Port,
+		CertificateID: listenerReq.CertificateID,
 	}
 }
 
@@ -250,6 +288,7 @@ type NLBHealthCheckerRequest struct {
 	Interval  string `json:"Interval,omitempty" validate:"omitempty" example:"default"`  // secs, if not specified, treated as "default", determined by CSP
 	Timeout   string `json:"Timeout,omitempty" validate:"omitempty" example:"default"`   // secs, if not specified, treated as "default", determined by CSP
 	Threshold string `json:"Threshold,omitempty" validate:"omitempty" example:"default"` // num, if not specified, treated as "default", determined by CSP
+	Path      string `json:"Path,omitempty" validate:"omitempty" example:"/healthz"`     // HTTP|HTTPS only, request path of the health checks
 }
 
 func convertHealthCheckerInfo(hcInfo NLBHealthCheckerRequest) (cres.HealthCheckerInfo, error) {
@@ -295,7 +334,7 @@ func convertHealthCheckerInfo(hcInfo NLBHealthCheckerRequest) (cres.HealthChecke
 		}
 	}
 
-	return cres.HealthCheckerInfo{hcInfo.Protocol, hcInfo.Port, interval, timeout, threshold, "", nil}, nil
+	return cres.HealthCheckerInfo{hcInfo.Protocol, hcInfo.Port, interval, timeout, threshold, hcInfo.Path, "", nil}, nil
 }
 
 // NLBListResponse represents the response body for listing NLBs.
@@ -518,8 +557,9 @@ func RemoveNLBVMs(c echo.Context) error {
 type NLBChangeListenerRequest struct {
 	ConnectionName string `json:"ConnectionName" validate:"required" example:"aws-connection"`
 	ReqInfo        struct {
-		Protocol string `json:"Protocol" validate:"required" example:"TCP"`
-		Port     string `json:"Port" validate:"required" example:"80"`
+		Protocol      string `json:"Protocol" validate:"required" example:"TCP"`
+		Port          string `json:"Port" validate:"required" example:"80"`
+		CertificateID string `json:"CertificateID,omitempty" validate:"omitempty" example:"my-certificate-id"` // HTTPS only
 	} `json:"ReqInfo" validate:"required"`
 }
 
@@ -551,8 +591,9 @@ func ChangeListener(c echo.Context) error {
 	}
 
 	reqInfo := cres.ListenerInfo{
-		Protocol: req.ReqInfo.Protocol,
-		Port:     req.ReqInfo.Port,
+		Protocol:      req.ReqInfo.Protocol,
+		Port:          req.ReqInfo.Port,
+		CertificateID: req.ReqInfo.CertificateID,
 	}
 
 	// Call common-runtime API
@@ -568,8 +609,9 @@ func ChangeListener(c echo.Context) error {
 type NLBChangeVMGroupRequest struct {
 	ConnectionName string `json:"ConnectionName" validate:"required" example:"aws-connection"`
 	ReqInfo        struct {
-		Protocol string `json:"Protocol" validate:"required" example:"TCP"`
-		Port     string `json:"Port" validate:"required" example:"80"`
+		Protocol      string `json:"Protocol" validate:"required" example:"TCP"`
+		Port          string `json:"Port" validate:"required" example:"80"`
+		CertificateID string `json:"CertificateID,omitempty" validate:"omitempty" example:"my-certificate-id"` // HTTPS only
 	} `json:"ReqInfo" validate:"required"`
 }
 
@@ -623,6 +665,7 @@ type NLBChangeHealthCheckerRequest struct {
 		Interval  string `json:"Interval" validate:"required" example:"30"`
 		Timeout   string `json:"Timeout" validate:"required" example:"5"`
 		Threshold string `json:"Threshold" validate:"required" example:"3"`
+		Path      string `json:"Path,omitempty" validate:"omitempty" example:"/healthz"` // HTTP|HTTPS only
 	} `json:"ReqInfo" validate:"required"`
 }
 
@@ -663,6 +706,7 @@ func ChangeHealthChecker(c echo.Context) error {
 		Interval:  interval,
 		Timeout:   timeout,
 		Threshold: threshold,
+		Path:      req.ReqInfo.Path,
 	}
 
 	// Call common-runtime API
@@ -730,7 +774,9 @@ func GetVMGroupHealthInfo(c echo.Context) error {
 // @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body for deleting an NLB"
 // @Param Name path string true "The name of the NLB to delete"
 // @Param force query string false "Force delete the NLB. ex) true or false(default: false)"
+// @Param async query string false "Run as an asynchronous Job and return the Job info(202) at once. ex) true or false(default: false)"
 // @Success 200 {object} BooleanInfo "Result of the delete operation"
+// @Success 202 {object} cmrt.JobInfo "Job info of the asynchronous operation, poll it with GET /job/{Id}"
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
 // @Failure 404 {object} SimpleMsg "Resource Not Found"
 // @Failure 500 {object} SimpleMsg "Internal Server Error"
@@ -744,6 +790,17 @@ func DeleteNLB(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
+	if isAsyncRequest(c) {
+		nameID, force := c.Param("Name"), c.QueryParam("force")
+		return submitJob(c, req.ConnectionName, NLB, "DeleteNLB", nameID, func() (interface{}, error) {
+			result, err := cmrt.DeleteNLB(req.ConnectionName, NLB, nameID, force)
+			if err != nil {
+				return nil, err
+			}
+			return BooleanInfo{Result: strconv.FormatBool(result)}, nil
+		})
+	}
+
 	// Call common-runtime API
 	result, err := cmrt.DeleteNLB(req.ConnectionName, NLB, c.Param("Name"), c.QueryParam("force"))
 	if err != nil {
@@ -9,6 +9,8 @@
 package restruntime
 
 import (
+	"errors"
+
 	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 
@@ -147,7 +149,9 @@ type RDBMSCreateRequest struct {
 // @Accept  json
 // @Produce  json
 // @Param RDBMSCreateRequest body restruntime.RDBMSCreateRequest true "Request body for creating an RDBMS"
+// @Param async query string false "Run as an asynchronous Job and return the Job info(202) at once. ex) true or false(default: false)"
 // @Success 200 {object} cres.RDBMSInfo "Details of the created RDBMS"
+// @Success 202 {object} cmrt.JobInfo "Job info of the asynchronous operation, poll it with GET /job/{Id}"
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
 // @Failure 404 {object} SimpleMsg "Resource Not Found"
 // @Failure 500 {object} SimpleMsg "Internal Server Error"
@@ -206,6 +210,12 @@ func CreateRDBMS(c echo.Context) error {
 		TagList: req.ReqInfo.TagList,
 	}
 
+	if isAsyncRequest(c) {
+		return submitJob(c, req.ConnectionName, RDBMS, "CreateRDBMS", reqInfo.IId.NameId, func() (interface{}, error) {
+			return cmrt.CreateRDBMS(req.ConnectionName, RDBMS, reqInfo, req.IDTransformMode)
+		})
+	}
+
 	// Call common-runtime API
 	result, err := cmrt.CreateRDBMS(req.ConnectionName, RDBMS, reqInfo, req.IDTransformMode)
 	if err != nil {
@@ -355,7 +365,9 @@ func GetRDBMS(c echo.Context) error {
 // @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body for deleting an RDBMS"
 // @Param Name path string true "The name of the RDBMS to delete"
 // @Param force query string false "Force delete the RDBMS. ex) true or false(default: false)"
+// @Param async query string false "Run as an asynchronous Job and return the Job info(202) at once. ex) true or false(default: false)"
 // @Success 200 {object} BooleanInfo "Result of the delete operation"
+// @Success 202 {object} cmrt.JobInfo "Job info of the asynchronous operation, poll it with GET /job/{Id}"
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
 // @Failure 404 {object} SimpleMsg "Resource Not Found"
 // @Failure 500 {object} SimpleMsg "Internal Server Error"
@@ -369,6 +381,17 @@ func DeleteRDBMS(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
+	if isAsyncRequest(c) {
+		nameID, force := c.Param("Name"), c.QueryParam("force")
+		return submitJob(c, req.ConnectionName, RDBMS, "DeleteRDBMS", nameID, func() (interface{}, error) {
+			result, err := cmrt.DeleteRDBMS(req.ConnectionName, RDBMS, nameID, force)
+			if err != nil {
+				return nil, err
+			}
+			return BooleanInfo{Result: strconv.FormatBool(result)}, nil
+		})
+	}
+
 	// Call common-runtime API
 	result, err := cmrt.DeleteRDBMS(req.ConnectionName, RDBMS, c.Param("Name"), c.QueryParam("force"))
 	if err != nil {
@@ -418,6 +441,395 @@ func DeleteCSPRDBMS(c echo.Context) error {
 	return c.JSON(http.StatusOK, &resultInfo)
 }
 
+// rdbmsLifecycleError maps the errors of the RDBMS lifecycle operations to the HTTP errors.
+func rdbmsLifecycleError(err error) error {
+	if errors.Is(err, cmrt.ErrRDBMSOperationNotSupported) {
+		return echo.NewHTTPError(http.StatusNotImplemented, err.Error())
+	}
+	if errors.Is(err, cmrt.ErrNotSnapshotOfRDBMS) {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+	if errors.Is(err, cmrt.ErrRDBMSRestoreNetworkRequired) {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
+
+// RDBMSSpecChangeRequest represents the request body for changing the DBInstanceSpec of an RDBMS.
+type RDBMSSpecChangeRequest struct {
+	ConnectionName string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	ReqInfo        struct {
+		DBInstanceSpec string `json:"DBInstanceSpec" validate:"required" example:"db.t3.large"`
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// changeRDBMSSpec godoc
+// @ID change-rdbms-spec
+// @Summary Change RDBMS Spec
+// @Description Change the DBInstanceSpec(instance class) of an RDBMS. Returns 501 if SupportsChangeSpec of the RDBMS meta info is false.
+// @Tags [RDBMS Management]
+// @Accept  json
+// @Produce  json
+// @Param RDBMSSpecChangeRequest body restruntime.RDBMSSpecChangeRequest true "Request body for changing the DBInstanceSpec"
+// @Param Name path string true "The name of the RDBMS to change the DBInstanceSpec for"
+// @Param async query string false "Run as an asynchronous Job and return the Job info(202) at once. ex) true or false(default: false)"
+// @Success 200 {object} cres.RDBMSInfo "Details of the RDBMS with the changed DBInstanceSpec"
+// @Success 202 {object} cmrt.JobInfo "Job info of the asynchronous operation, poll it with GET /job/{Id}"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Failure 501 {object} SimpleMsg "Not Supported by driver"
+// @Router /rdbms/{Name}/spec [put]
+func ChangeRDBMSSpec(c echo.Context) error {
+	cblog.Info("call ChangeRDBMSSpec()")
+
+	var req RDBMSSpecChangeRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	if isAsyncRequest(c) {
+		nameID := c.Param("Name")
+		return submitJob(c, req.ConnectionName, RDBMS, "ChangeRDBMSSpec", nameID, func() (interface{}, error) {
+			return cmrt.ChangeRDBMSSpec(req.ConnectionName, RDBMS, nameID, req.ReqInfo.DBInstanceSpec)
+		})
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ChangeRDBMSSpec(req.ConnectionName, RDBMS, c.Param("Name"), req.ReqInfo.DBInstanceSpec)
+	if err != nil {
+		return rdbmsLifecycleError(err)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// RDBMSStorageChangeRequest represents the request body for expanding the storage of an RDBMS.
+type RDBMSStorageChangeRequest struct {
+	ConnectionName string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	ReqInfo        struct {
+		StorageSize string `json:"StorageSize" validate:"required" example:"200"` // in GB, larger than the current size
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// changeRDBMSStorageSize godoc
+// @ID change-rdbms-storage-size
+// @Summary Change RDBMS Storage Size
+// @Description Expand the StorageSize(GB) of an RDBMS. Shrinking is not allowed. Returns 501 if SupportsChangeStorageSize of the RDBMS meta info is false.
+// @Tags [RDBMS Management]
+// @Accept  json
+// @Produce  json
+// @Param RDBMSStorageChangeRequest body restruntime.RDBMSStorageChangeRequest true "Request body for expanding the storage"
+// @Param Name path string true "The name of the RDBMS to expand the storage for"
+// @Param async query string false "Run as an asynchronous Job and return the Job info(202) at once. ex) true or false(default: false)"
+// @Success 200 {object} cres.RDBMSInfo "Details of the RDBMS with the expanded storage"
+// @Success 202 {object} cmrt.JobInfo "Job info of the asynchronous operation, poll it with GET /job/{Id}"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Failure 501 {object} SimpleMsg "Not Supported by driver"
+// @Router /rdbms/{Name}/storage [put]
+func ChangeRDBMSStorageSize(c echo.Context) error {
+	cblog.Info("call ChangeRDBMSStorageSize()")
+
+	var req RDBMSStorageChangeRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	if isAsyncRequest(c) {
+		nameID := c.Param("Name")
+		return submitJob(c, req.ConnectionName, RDBMS, "ChangeRDBMSStorageSize", nameID, func() (interface{}, error) {
+			return cmrt.ChangeRDBMSStorageSize(req.ConnectionName, RDBMS, nameID, req.ReqInfo.StorageSize)
+		})
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ChangeRDBMSStorageSize(req.ConnectionName, RDBMS, c.Param("Name"), req.ReqInfo.StorageSize)
+	if err != nil {
+		return rdbmsLifecycleError(err)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// startRDBMS godoc
+// @ID start-rdbms
+// @Summary Start RDBMS
+// @Description Start a stopped RDBMS. Returns 501 if SupportsStartStop of the RDBMS meta info is false.
+// @Tags [RDBMS Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body for starting an RDBMS"
+// @Param Name path string true "The name of the RDBMS to start"
+// @Success 200 {object} BooleanInfo "Result of the start operation"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Failure 501 {object} SimpleMsg "Not Supported by driver"
+// @Router /rdbms/{Name}/start [put]
+func StartRDBMS(c echo.Context) error {
+	cblog.Info("call StartRDBMS()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.StartRDBMS(req.ConnectionName, RDBMS, c.Param("Name"))
+	if err != nil {
+		return rdbmsLifecycleError(err)
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+// stopRDBMS godoc
+// @ID stop-rdbms
+// @Summary Stop RDBMS
+// @Description Stop an RDBMS. Some CSPs restart a stopped RDBMS automatically after a while(ex: AWS after 7 days). Returns 501 if SupportsStartStop of the RDBMS meta info is false.
+// @Tags [RDBMS Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body for stopping an RDBMS"
+// @Param Name path string true "The name of the RDBMS to stop"
+// @Success 200 {object} BooleanInfo "Result of the stop operation"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Failure 501 {object} SimpleMsg "Not Supported by driver"
+// @Router /rdbms/{Name}/stop [put]
+func StopRDBMS(c echo.Context) error {
+	cblog.Info("call StopRDBMS()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.StopRDBMS(req.ConnectionName, RDBMS, c.Param("Name"))
+	if err != nil {
+		return rdbmsLifecycleError(err)
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+// RDBMSSnapshotCreateRequest represents the request body for creating a snapshot of an RDBMS.
+type RDBMSSnapshotCreateRequest struct {
+	ConnectionName  string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	IDTransformMode string `json:"IDTransformMode,omitempty" validate:"omitempty" example:"ON"` // ON: transform CSP ID, OFF: no-transform CSP ID
+	ReqInfo         struct {
+		Name string `json:"Name" validate:"required" example:"rdbms-01-before-migration"`
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// RDBMSSnapshotListResponse represents the response body for listing the snapshots of an RDBMS.
+type RDBMSSnapshotListResponse struct {
+	Result []*cres.RDBMSSnapshotInfo `json:"snapshot" validate:"required" description:"A list of RDBMS snapshot information"`
+}
+
+// createRDBMSSnapshot godoc
+// @ID create-rdbms-snapshot
+// @Summary Create RDBMS Snapshot
+// @Description Take a manual snapshot of an RDBMS. The snapshot is registered in Spider with its name. Returns 501 if SupportsSnapshot of the RDBMS meta info is false.
+// @Tags [RDBMS Management]
+// @Accept  json
+// @Produce  json
+// @Param RDBMSSnapshotCreateRequest body restruntime.RDBMSSnapshotCreateRequest true "Request body for creating an RDBMS snapshot"
+// @Param Name path string true "The name of the RDBMS to take a snapshot of"
+// @Success 200 {object} cres.RDBMSSnapshotInfo "Details of the created snapshot"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Failure 501 {object} SimpleMsg "Not Supported by driver"
+// @Router /rdbms/{Name}/snapshot [post]
+func CreateRDBMSSnapshot(c echo.Context) error {
+	cblog.Info("call CreateRDBMSSnapshot()")
+
+	var req RDBMSSnapshotCreateRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.CreateRDBMSSnapshot(req.ConnectionName, RDBMS, c.Param("Name"), req.ReqInfo.Name, req.IDTransformMode)
+	if err != nil {
+		return rdbmsLifecycleError(err)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// listRDBMSSnapshot godoc
+// @ID list-rdbms-snapshot
+// @Summary List RDBMS Snapshots
+// @Description Retrieve the manual snapshots of an RDBMS registered in Spider. Returns 501 if SupportsSnapshot of the RDBMS meta info is false.
+// @Tags [RDBMS Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionName query string true "The name of the Connection"
+// @Param Name path string true "The name of the RDBMS to list the snapshots for"
+// @Success 200 {object} RDBMSSnapshotListResponse "List of RDBMS snapshots"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Failure 501 {object} SimpleMsg "Not Supported by driver"
+// @Router /rdbms/{Name}/snapshot [get]
+func ListRDBMSSnapshot(c echo.Context) error {
+	cblog.Info("call ListRDBMSSnapshot()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ListRDBMSSnapshot(req.ConnectionName, RDBMS, c.Param("Name"))
+	if err != nil {
+		return rdbmsLifecycleError(err)
+	}
+
+	jsonResult := RDBMSSnapshotListResponse{
+		Result: result,
+	}
+
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+// deleteRDBMSSnapshot godoc
+// @ID delete-rdbms-snapshot
+// @Summary Delete RDBMS Snapshot
+// @Description Delete a manual snapshot of an RDBMS registered in Spider. Returns 501 if SupportsSnapshot of the RDBMS meta info is false.
+// @Tags [RDBMS Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body for deleting an RDBMS snapshot"
+// @Param Name path string true "The name of the RDBMS"
+// @Param SnapshotName path string true "The name of the snapshot to delete"
+// @Success 200 {object} BooleanInfo "Result of the delete operation"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Failure 501 {object} SimpleMsg "Not Supported by driver"
+// @Router /rdbms/{Name}/snapshot/{SnapshotName} [delete]
+func DeleteRDBMSSnapshot(c echo.Context) error {
+	cblog.Info("call DeleteRDBMSSnapshot()")
+
+	var req ConnectionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.DeleteRDBMSSnapshot(req.ConnectionName, RDBMS, c.Param("Name"), c.Param("SnapshotName"))
+	if err != nil {
+		return rdbmsLifecycleError(err)
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+// RDBMSSnapshotRestoreRequest represents the request body for restoring a new RDBMS from a snapshot.
+type RDBMSSnapshotRestoreRequest struct {
+	ConnectionName  string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	IDTransformMode string `json:"IDTransformMode,omitempty" validate:"omitempty" example:"ON"` // ON: transform CSP ID, OFF: no-transform CSP ID
+	ReqInfo         struct {
+		Name           string `json:"Name" validate:"required" example:"rdbms-01-restored"`                 // name of the new RDBMS
+		DBInstanceSpec string `json:"DBInstanceSpec,omitempty" validate:"omitempty" example:"db.t3.medium"` // default: the spec of the snapshot
+
+		// default: the network of the source RDBMS, required if the source RDBMS is deleted
+		VPCName            string   `json:"VPCName,omitempty" validate:"omitempty" example:"vpc-01"`
+		SubnetNames        []string `json:"SubnetNames,omitempty" validate:"omitempty" example:"subnet-01"` // required with VPCName
+		SecurityGroupNames []string `json:"SecurityGroupNames,omitempty" validate:"omitempty" example:"sg-01"`
+
+		TagList []cres.KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// restoreRDBMSSnapshot godoc
+// @ID restore-rdbms-snapshot
+// @Summary Restore RDBMS Snapshot
+// @Description Create a new RDBMS from a snapshot of an RDBMS. The new RDBMS is placed in the VPC, Subnets and SecurityGroups of the request, or of the source RDBMS if VPCName is empty. The source RDBMS can be deleted after the snapshot is taken, then VPCName and SubnetNames are required. Returns 501 if SupportsSnapshot of the RDBMS meta info is false.
+// @Tags [RDBMS Management]
+// @Accept  json
+// @Produce  json
+// @Param RDBMSSnapshotRestoreRequest body restruntime.RDBMSSnapshotRestoreRequest true "Request body for restoring an RDBMS snapshot"
+// @Param Name path string true "The name of the source RDBMS, which can be deleted"
+// @Param SnapshotName path string true "The name of the snapshot to restore"
+// @Param async query string false "Run as an asynchronous Job and return the Job info(202) at once. ex) true or false(default: false)"
+// @Success 200 {object} cres.RDBMSInfo "Details of the restored RDBMS"
+// @Success 202 {object} cmrt.JobInfo "Job info of the asynchronous operation, poll it with GET /job/{Id}"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields, ex) the network of the deleted source RDBMS"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Failure 501 {object} SimpleMsg "Not Supported by driver"
+// @Router /rdbms/{Name}/snapshot/{SnapshotName}/restore [post]
+func RestoreRDBMSSnapshot(c echo.Context) error {
+	cblog.Info("call RestoreRDBMSSnapshot()")
+
+	var req RDBMSSnapshotRestoreRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Rest RegInfo => Driver ReqInfo
+	reqInfo := cres.RDBMSInfo{
+		IId:            cres.IID{NameId: req.ReqInfo.Name, SystemId: req.ReqInfo.Name},
+		VpcIID:         cres.IID{NameId: req.ReqInfo.VPCName, SystemId: ""},
+		DBInstanceSpec: req.ReqInfo.DBInstanceSpec,
+		TagList:        req.ReqInfo.TagList,
+	}
+	for _, name := range req.ReqInfo.SubnetNames {
+		reqInfo.SubnetIIDs = append(reqInfo.SubnetIIDs, cres.IID{NameId: name, SystemId: ""})
+	}
+	for _, name := range req.ReqInfo.SecurityGroupNames {
+		reqInfo.SecurityGroupIIDs = append(reqInfo.SecurityGroupIIDs, cres.IID{NameId: name, SystemId: ""})
+	}
+
+	nameID, snapshotName := c.Param("Name"), c.Param("SnapshotName")
+	if isAsyncRequest(c) {
+		return submitJob(c, req.ConnectionName, RDBMS, "RestoreRDBMSSnapshot", reqInfo.IId.NameId, func() (interface{}, error) {
+			return cmrt.RestoreRDBMSSnapshot(req.ConnectionName, RDBMS, nameID, snapshotName, reqInfo, req.IDTransformMode)
+		})
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.RestoreRDBMSSnapshot(req.ConnectionName, RDBMS, nameID, snapshotName, reqInfo, req.IDTransformMode)
+	if err != nil {
+		return rdbmsLifecycleError(err)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
 // getRDBMSMetaInfo godoc
 // @ID get-rdbms-metainfo
 // @Summary Get RDBMS Meta Information
@@ -0,0 +1,326 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package restruntime
+
+import (
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+//================ Route Table Handler
+
+// RouteRequest represents a route of a route table.
+type RouteRequest struct {
+	DestinationCIDR string `json:"DestinationCIDR" validate:"required" example:"0.0.0.0/0"`
+	TargetType      string `json:"TargetType" validate:"required" example:"NATGateway"`        // InternetGateway | NATGateway | NIC | VPCPeering
+	TargetName      string `json:"TargetName,omitempty" validate:"omitempty" example:"nat-01"` // not used for InternetGateway
+}
+
+func convertRouteRequests(routeReqList []RouteRequest) []cres.RouteInfo {
+	routeList := []cres.RouteInfo{}
+	for _, route := range routeReqList {
+		routeList = append(routeList, cres.RouteInfo{
+			DestinationCIDR: route.DestinationCIDR,
+			TargetType:      cres.RouteTargetType(route.TargetType),
+			TargetIID:       cres.IID{NameId: route.TargetName},
+		})
+	}
+	return routeList
+}
+
+// RouteTableCreateRequest represents the request body for creating a route table.
+type RouteTableCreateRequest struct {
+	ConnectionName  string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	IDTransformMode string `json:"IDTransformMode,omitempty" validate:"omitempty" example:"ON"`
+	ReqInfo         struct {
+		Name      string          `json:"Name" validate:"required" example:"rt-private-01"`
+		VPCName   string          `json:"VPCName" validate:"required" example:"vpc-01"`
+		RouteList []RouteRequest  `json:"RouteList,omitempty" validate:"omitempty"`
+		TagList   []cres.KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// CreateRouteTable godoc
+// @ID create-routetable
+// @Summary Create Route Table
+// @Description Create a custom route table in a VPC. <br> * A route forwards the traffic to the DestinationCIDR to a target of InternetGateway, NATGateway, NIC or VPCPeering. <br> * The NATGateway and the NIC must be in the VPC, and the VPCPeering must be a peering of the VPC. <br> * Associate the Subnets with the route table to apply the routes.
+// @Tags [Route Table Management]
+// @Accept  json
+// @Produce  json
+// @Param RouteTableCreateRequest body restruntime.RouteTableCreateRequest true "Request body for creating a route table"
+// @Success 200 {object} cres.RouteTableInfo "Details of the created route table"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /routetable [post]
+func CreateRouteTable(c echo.Context) error {
+	cblog.Info("call CreateRouteTable()")
+	req := RouteTableCreateRequest{}
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	reqInfo := cres.RouteTableReqInfo{
+		IId:       cres.IID{NameId: req.ReqInfo.Name},
+		VpcIID:    cres.IID{NameId: req.ReqInfo.VPCName},
+		RouteList: convertRouteRequests(req.ReqInfo.RouteList),
+		TagList:   req.ReqInfo.TagList,
+	}
+
+	result, err := cmrt.CreateRouteTable(req.ConnectionName, ROUTETABLE, reqInfo, req.IDTransformMode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// RouteTableListResponse is the response body for listing route tables.
+type RouteTableListResponse struct {
+	Result []*cres.RouteTableInfo `json:"routetable"`
+}
+
+// ListRouteTable godoc
+// @ID list-routetable
+// @Summary List Route Tables
+// @Description Retrieve a list of route tables. A route table registered but not found in the CSP is listed with the Status 'NotFound'.
+// @Tags [Route Table Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body containing the Connection Name"
+// @Success 200 {object} restruntime.RouteTableListResponse "List of route tables"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /routetable [get]
+func ListRouteTable(c echo.Context) error {
+	cblog.Info("call ListRouteTable()")
+	var req ConnectionRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	infoList, err := cmrt.ListRouteTable(req.ConnectionName, ROUTETABLE)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if infoList == nil {
+		infoList = []*cres.RouteTableInfo{}
+	}
+	return c.JSON(http.StatusOK, &RouteTableListResponse{Result: infoList})
+}
+
+// GetRouteTable godoc
+// @ID get-routetable
+// @Summary Get Route Table
+// @Description Retrieve details of a specific route table.
+// @Tags [Route Table Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body containing the Connection Name"
+// @Param Name path string true "The name of the route table"
+// @Success 200 {object} cres.RouteTableInfo "Details of the route table"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /routetable/{Name} [get]
+func GetRouteTable(c echo.Context) error {
+	cblog.Info("call GetRouteTable()")
+	var req ConnectionRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	result, err := cmrt.GetRouteTable(req.ConnectionName, ROUTETABLE, c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// DeleteRouteTable godoc
+// @ID delete-routetable
+// @Summary Delete Route Table
+// @Description Delete a route table. The associated Subnets are returned to the default route table of the VPC.
+// @Tags [Route Table Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body containing the Connection Name"
+// @Param Name path string true "The name of the route table to delete"
+// @Param force query string false "Force delete the route table. ex) true or false(default: false)"
+// @Success 200 {object} BooleanInfo "Result of the delete operation"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /routetable/{Name} [delete]
+func DeleteRouteTable(c echo.Context) error {
+	cblog.Info("call DeleteRouteTable()")
+	var req ConnectionRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	result, err := cmrt.DeleteRouteTable(req.ConnectionName, ROUTETABLE, c.Param("Name"), c.QueryParam("force"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, &BooleanInfo{Result: strconv.FormatBool(result)})
+}
+
+// RouteAddRequest represents the request body for adding routes to a route table.
+type RouteAddRequest struct {
+	ConnectionName string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	ReqInfo        struct {
+		RouteList []RouteRequest `json:"RouteList" validate:"required"`
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// AddRoutes godoc
+// @ID add-routes
+// @Summary Add Routes
+// @Description Add routes to a route table.
+// @Tags [Route Table Management]
+// @Accept  json
+// @Produce  json
+// @Param RouteAddRequest body restruntime.RouteAddRequest true "Request body for adding routes"
+// @Param Name path string true "The name of the route table"
+// @Success 200 {object} cres.RouteTableInfo "Updated route table info"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /routetable/{Name}/routes [post]
+func AddRoutes(c echo.Context) error {
+	cblog.Info("call AddRoutes()")
+	req := RouteAddRequest{}
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	result, err := cmrt.AddRouteTableRoutes(req.ConnectionName, c.Param("Name"), convertRouteRequests(req.ReqInfo.RouteList))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// RouteRemoveRequest represents the request body for removing routes from a route table.
+type RouteRemoveRequest struct {
+	ConnectionName string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	ReqInfo        struct {
+		DestinationCIDRs []string `json:"DestinationCIDRs" validate:"required" example:"0.0.0.0/0"`
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// RemoveRoutes godoc
+// @ID remove-routes
+// @Summary Remove Routes
+// @Description Remove the routes to the destination CIDRs from a route table.
+// @Tags [Route Table Management]
+// @Accept  json
+// @Produce  json
+// @Param RouteRemoveRequest body restruntime.RouteRemoveRequest true "Request body for removing routes"
+// @Param Name path string true "The name of the route table"
+// @Success 200 {object} BooleanInfo "Result of the remove operation"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /routetable/{Name}/routes [delete]
+func RemoveRoutes(c echo.Context) error {
+	cblog.Info("call RemoveRoutes()")
+	req := RouteRemoveRequest{}
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	result, err := cmrt.RemoveRouteTableRoutes(req.ConnectionName, c.Param("Name"), req.ReqInfo.DestinationCIDRs)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, &BooleanInfo{Result: strconv.FormatBool(result)})
+}
+
+// RouteTableSubnetRequest represents the request body for associating or disassociating a Subnet.
+type RouteTableSubnetRequest struct {
+	ConnectionName string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	ReqInfo        struct {
+		SubnetName string `json:"SubnetName" validate:"required" example:"private-subnet-01"` // Subnet of the VPC of the route table
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// AssociateRouteTableSubnet godoc
+// @ID associate-routetable-subnet
+// @Summary Associate Subnet with Route Table
+// @Description Associate a Subnet with a route table. The previous association of the Subnet is replaced.
+// @Tags [Route Table Management]
+// @Accept  json
+// @Produce  json
+// @Param RouteTableSubnetRequest body restruntime.RouteTableSubnetRequest true "Request body for associating a Subnet"
+// @Param Name path string true "The name of the route table"
+// @Success 200 {object} cres.RouteTableInfo "Updated route table info"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /routetable/{Name}/associate [put]
+func AssociateRouteTableSubnet(c echo.Context) error {
+	cblog.Info("call AssociateRouteTableSubnet()")
+	req := RouteTableSubnetRequest{}
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	result, err := cmrt.AssociateRouteTableSubnet(req.ConnectionName, c.Param("Name"), req.ReqInfo.SubnetName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// DisassociateRouteTableSubnet godoc
+// @ID disassociate-routetable-subnet
+// @Summary Disassociate Subnet from Route Table
+// @Description Disassociate a Subnet from a route table. The Subnet uses the default route table of the VPC.
+// @Tags [Route Table Management]
+// @Accept  json
+// @Produce  json
+// @Param RouteTableSubnetRequest body restruntime.RouteTableSubnetRequest true "Request body for disassociating a Subnet"
+// @Param Name path string true "The name of the route table"
+// @Success 200 {object} BooleanInfo "Result of the disassociate operation"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /routetable/{Name}/disassociate [put]
+func DisassociateRouteTableSubnet(c echo.Context) error {
+	cblog.Info("call DisassociateRouteTableSubnet()")
+	req := RouteTableSubnetRequest{}
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	result, err := cmrt.DisassociateRouteTableSubnet(req.ConnectionName, c.Param("Name"), req.ReqInfo.SubnetName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, &BooleanInfo{Result: strconv.FormatBool(result)})
+}
+
+// CountAllRouteTables godoc
+// @ID count-all-routetables
+// @Summary Count All Route Tables
+// @Description Get the total number of route tables registered across all connections.
+// @Tags [Route Table Management]
+// @Produce  json
+// @Success 200 {object} CountResponse "Total count of route tables"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /countroutetable [get]
+func CountAllRouteTables(c echo.Context) error {
+	count, err := cmrt.CountAllRouteTables()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, CountResponse{Count: int(count)})
+}
+
+// CountRouteTablesByConnection godoc
+// @ID count-routetable-by-connection
+// @Summary Count Route Tables by Connection
+// @Description Get the total number of route tables for a specific connection.
+// @Tags [Route Table Management]
+// @Produce  json
+// @Param ConnectionName path string true "The name of the Connection"
+// @Success 200 {object} CountResponse "Total count of route tables for the connection"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /countroutetable/{ConnectionName} [get]
+func CountRouteTablesByConnection(c echo.Context) error {
+	count, err := cmrt.CountRouteTablesByConnection(c.Param("ConnectionName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, CountResponse{Count: int(count)})
+}
@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"net/url"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -21,6 +22,8 @@ import (
 	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
 	"github.com/labstack/echo/v4"
 	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/minio/minio-go/v7/pkg/tags"
 )
 
 // ---------- dummy struct for Swagger documentation ----------
@@ -471,6 +474,73 @@ type CORSRule struct {
 	MaxAgeSeconds int      `xml:"MaxAgeSeconds,omitempty" json:"MaxAgeSeconds,omitempty" example:"3000"`
 }
 
+// LifecycleConfiguration uses minio lifecycle rules (Expiration, Transition, NoncurrentVersionExpiration, ...)
+type LifecycleConfiguration struct {
+	XMLName xml.Name         `xml:"LifecycleConfiguration" json:"-" swaggertype:"object"`
+	Xmlns   string           `xml:"xmlns,attr" json:"-"`
+	Rules   []lifecycle.Rule `xml:"Rule" json:"Rule" swaggertype:"array,object"`
+}
+
+type Tagging struct {
+	XMLName xml.Name `xml:"Tagging" json:"-" swaggertype:"object"`
+	Xmlns   string   `xml:"xmlns,attr" json:"-"`
+	TagSet  TagSet   `xml:"TagSet" json:"TagSet"`
+}
+
+type TagSet struct {
+	Tags []S3Tag `xml:"Tag" json:"Tag"`
+}
+
+type S3Tag struct {
+	Key   string `xml:"Key" json:"Key" example:"env"`
+	Value string `xml:"Value" json:"Value" example:"log"`
+}
+
+type ServerSideEncryptionConfiguration struct {
+	XMLName xml.Name                   `xml:"ServerSideEncryptionConfiguration" json:"-" swaggertype:"object"`
+	Xmlns   string                     `xml:"xmlns,attr" json:"-"`
+	Rules   []ServerSideEncryptionRule `xml:"Rule" json:"Rule"`
+}
+
+type ServerSideEncryptionRule struct {
+	ApplyServerSideEncryptionByDefault ApplyServerSideEncryptionByDefault `xml:"ApplyServerSideEncryptionByDefault" json:"ApplyServerSideEncryptionByDefault"`
+}
+
+type ApplyServerSideEncryptionByDefault struct {
+	SSEAlgorithm   string `xml:"SSEAlgorithm" json:"SSEAlgorithm" example:"AES256"`
+	KMSMasterKeyID string `xml:"KMSMasterKeyID,omitempty" json:"KMSMasterKeyID,omitempty"`
+}
+
+type ObjectLockConfiguration struct {
+	XMLName           xml.Name        `xml:"ObjectLockConfiguration" json:"-" swaggertype:"object"`
+	Xmlns             string          `xml:"xmlns,attr" json:"-"`
+	ObjectLockEnabled string          `xml:"ObjectLockEnabled,omitempty" json:"ObjectLockEnabled,omitempty" example:"Enabled"`
+	Rule              *ObjectLockRule `xml:"Rule,omitempty" json:"Rule,omitempty"`
+}
+
+type ObjectLockRule struct {
+	DefaultRetention DefaultRetention `xml:"DefaultRetention" json:"DefaultRetention"`
+}
+
+type DefaultRetention struct {
+	Mode  string `xml:"Mode" json:"Mode" example:"GOVERNANCE"`
+	Days  uint   `xml:"Days,omitempty" json:"Days,omitempty" example:"30"`
+	Years uint   `xml:"Years,omitempty" json:"Years,omitempty"`
+}
+
+type ObjectRetention struct {
+	XMLName         xml.Name `xml:"Retention" json:"-" swaggertype:"object"`
+	Xmlns           string   `xml:"xmlns,attr" json:"-"`
+	Mode            string   `xml:"Mode" json:"Mode" example:"GOVERNANCE"`
+	RetainUntilDate string   `xml:"RetainUntilDate" json:"RetainUntilDate" example:"2027-01-01T00:00:00Z"`
+}
+
+type ObjectLegalHold struct {
+	XMLName xml.Name `xml:"LegalHold" json:"-" swaggertype:"object"`
+	Xmlns   string   `xml:"xmlns,attr" json:"-"`
+	Status  string   `xml:"Status" json:"Status" example:"ON"`
+}
+
 type AccessControlPolicy struct {
 	XMLName           xml.Name          `xml:"AccessControlPolicy" json:"-"`
 	Xmlns             string            `xml:"xmlns,attr" json:"-"`
@@ -864,6 +934,555 @@ func deleteBucketCORS(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
+// readS3ConfigBody parses a configuration body as JSON or XML by Content-Type
+func readS3ConfigBody(c echo.Context, v interface{}) error {
+	bodyBytes, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return fmt.Errorf("failed to read request body: %v", err)
+	}
+
+	// Remove namespace prefix from XML if present (e.g., <spider.Tagging> -> <Tagging>)
+	bodyStr := string(bodyBytes)
+	bodyStr = strings.ReplaceAll(bodyStr, "<spider.", "<")
+	bodyStr = strings.ReplaceAll(bodyStr, "</spider.", "</")
+	bodyBytes = []byte(bodyStr)
+
+	if strings.Contains(c.Request().Header.Get("Content-Type"), "application/json") {
+		return json.Unmarshal(bodyBytes, v)
+	}
+	return xml.Unmarshal(bodyBytes, v)
+}
+
+// malformedBodyError returns MalformedJSON or MalformedXML by Content-Type
+func malformedBodyError(c echo.Context, err error, resource string) error {
+	if strings.Contains(c.Request().Header.Get("Content-Type"), "application/json") {
+		return returnS3Error(c, http.StatusBadRequest, "MalformedJSON", err.Error(), resource)
+	}
+	return returnS3Error(c, http.StatusBadRequest, "MalformedXML", fmt.Sprintf("The XML you provided was not well-formed or did not validate against our published schema: %v", err), resource)
+}
+
+// configErrorResponse maps lifecycle and tagging errors to S3 errors.
+// noSuchCode is the S3 error code of a missing configuration, e.g. NoSuchLifecycleConfiguration.
+func configErrorResponse(c echo.Context, err error, noSuchCode string, resource string) error {
+	errorCode := "InternalError"
+	statusCode := http.StatusInternalServerError
+
+	var tagErr tags.Error
+	errResp := minio.ToErrorResponse(err)
+	if strings.Contains(err.Error(), "not supported by") {
+		errorCode = "NotImplemented"
+		statusCode = http.StatusNotImplemented
+	} else if noSuchCode != "" && strings.Contains(err.Error(), noSuchCode) {
+		errorCode = noSuchCode
+		statusCode = http.StatusNotFound
+	} else if errors.As(err, &tagErr) {
+		errorCode = tagErr.Code()
+		statusCode = http.StatusBadRequest
+	} else if errResp.Code != "" && errResp.StatusCode != 0 {
+		errorCode = errResp.Code
+		statusCode = errResp.StatusCode
+	} else if errors.Is(err, cmrt.ErrNoSuchS3Bucket) {
+		errorCode = "NoSuchBucket"
+		statusCode = http.StatusNotFound
+	}
+	return returnS3Error(c, statusCode, errorCode, err.Error(), resource)
+}
+
+// getBucketLifecycle returns the lifecycle configuration of a bucket
+func getBucketLifecycle(c echo.Context) error {
+	conn, _ := getConnectionName(c)
+	bucketName := strings.TrimSuffix(c.Param("BucketName"), "/")
+
+	config, err := cmrt.GetS3BucketLifecycle(conn, bucketName)
+	if err != nil {
+		cblog.Errorf("GetS3BucketLifecycle failed: %v", err)
+		return configErrorResponse(c, err, "NoSuchLifecycleConfiguration", "/"+bucketName)
+	}
+
+	resp := LifecycleConfiguration{
+		Xmlns: "http://s3.amazonaws.com/doc/2006-03-01/",
+		Rules: config.Rules,
+	}
+	return returnS3Response(c, http.StatusOK, resp)
+}
+
+// putBucketLifecycle replaces the lifecycle configuration of a bucket
+func putBucketLifecycle(c echo.Context) error {
+	conn, _ := getConnectionName(c)
+	bucketName := strings.TrimSuffix(c.Param("BucketName"), "/")
+
+	cblog.Infof("putBucketLifecycle called - Bucket: %s, Connection: %s", bucketName, conn)
+
+	var config LifecycleConfiguration
+	if err := readS3ConfigBody(c, &config); err != nil {
+		cblog.Errorf("Failed to parse lifecycle config: %v", err)
+		return malformedBodyError(c, err, "/"+bucketName)
+	}
+
+	if len(config.Rules) == 0 {
+		return returnS3Error(c, http.StatusBadRequest, "InvalidRequest", "At least one lifecycle rule is required", "/"+bucketName)
+	}
+	for _, rule := range config.Rules {
+		if rule.Status != "Enabled" && rule.Status != "Disabled" {
+			return returnS3Error(c, http.StatusBadRequest, "MalformedXML",
+				fmt.Sprintf("Invalid Status '%s' of lifecycle rule '%s': must be 'Enabled' or 'Disabled'", rule.Status, rule.ID), "/"+bucketName)
+		}
+	}
+
+	cblog.Infof("Parsed lifecycle configuration with %d rules", len(config.Rules))
+
+	_, err := cmrt.SetS3BucketLifecycle(conn, bucketName, &lifecycle.Configuration{Rules: config.Rules})
+	if err != nil {
+		cblog.Errorf("SetS3BucketLifecycle failed: %v", err)
+		return configErrorResponse(c, err, "", "/"+bucketName)
+	}
+
+	cblog.Infof("Successfully set lifecycle for bucket %s", bucketName)
+	addS3Headers(c)
+	return c.NoContent(http.StatusOK)
+}
+
+// deleteBucketLifecycle deletes the lifecycle configuration of a bucket
+func deleteBucketLifecycle(c echo.Context) error {
+	conn, _ := getConnectionName(c)
+	bucketName := strings.TrimSuffix(c.Param("BucketName"), "/")
+
+	cblog.Infof("deleteBucketLifecycle called - Bucket: %s, Connection: %s", bucketName, conn)
+
+	_, err := cmrt.DeleteS3BucketLifecycle(conn, bucketName)
+	if err != nil {
+		cblog.Errorf("DeleteS3BucketLifecycle failed: %v", err)
+		return configErrorResponse(c, err, "", "/"+bucketName)
+	}
+
+	cblog.Infof("Successfully deleted lifecycle for bucket %s", bucketName)
+	addS3Headers(c)
+	return c.NoContent(http.StatusNoContent)
+}
+
+// tagMapToTagging converts a tag map to the S3 Tagging format sorted by key
+func tagMapToTagging(tagMap map[string]string) Tagging {
+	keys := make([]string, 0, len(tagMap))
+	for key := range tagMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	resp := Tagging{Xmlns: "http://s3.amazonaws.com/doc/2006-03-01/"}
+	resp.TagSet.Tags = []S3Tag{}
+	for _, key := range keys {
+		resp.TagSet.Tags = append(resp.TagSet.Tags, S3Tag{Key: key, Value: tagMap[key]})
+	}
+	return resp
+}
+
+// readTaggingBody parses a Tagging body into a tag map.
+// On failure, the S3 error response is already written and the tag map is nil.
+func readTaggingBody(c echo.Context, resource string) (map[string]string, error) {
+	var tagging Tagging
+	if err := readS3ConfigBody(c, &tagging); err != nil {
+		cblog.Errorf("Failed to parse tagging: %v", err)
+		return nil, malformedBodyError(c, err, resource)
+	}
+
+	tagMap := make(map[string]string, len(tagging.TagSet.Tags))
+	for _, tag := range tagging.TagSet.Tags {
+		if _, ok := tagMap[tag.Key]; ok {
+			return nil, returnS3Error(c, http.StatusBadRequest, "InvalidTag", "Cannot provide multiple Tags with the same key", resource)
+		}
+		tagMap[tag.Key] = tag.Value
+	}
+	return tagMap, nil
+}
+
+// getBucketTagging returns the tags of a bucket
+func getBucketTagging(c echo.Context) error {
+	conn, _ := getConnectionName(c)
+	bucketName := strings.TrimSuffix(c.Param("BucketName"), "/")
+
+	tagMap, err := cmrt.GetS3BucketTagging(conn, bucketName)
+	if err != nil {
+		cblog.Errorf("GetS3BucketTagging failed: %v", err)
+		return configErrorResponse(c, err, "NoSuchTagSet", "/"+bucketName)
+	}
+	return returnS3Response(c, http.StatusOK, tagMapToTagging(tagMap))
+}
+
+// putBucketTagging replaces the tags of a bucket
+func putBucketTagging(c echo.Context) error {
+	conn, _ := getConnectionName(c)
+	bucketName := strings.TrimSuffix(c.Param("BucketName"), "/")
+
+	cblog.Infof("putBucketTagging called - Bucket: %s, Connection: %s", bucketName, conn)
+
+	tagMap, err := readTaggingBody(c, "/"+bucketName)
+	if tagMap == nil {
+		return err
+	}
+
+	_, err = cmrt.SetS3BucketTagging(conn, bucketName, tagMap)
+	if err != nil {
+		cblog.Errorf("SetS3BucketTagging failed: %v", err)
+		return configErrorResponse(c, err, "", "/"+bucketName)
+	}
+
+	addS3Headers(c)
+	return c.NoContent(http.StatusNoContent)
+}
+
+// deleteBucketTagging deletes the tags of a bucket
+func deleteBucketTagging(c echo.Context) error {
+	conn, _ := getConnectionName(c)
+	bucketName := strings.TrimSuffix(c.Param("BucketName"), "/")
+
+	cblog.Infof("deleteBucketTagging called - Bucket: %s, Connection: %s", bucketName, conn)
+
+	_, err := cmrt.DeleteS3BucketTagging(conn, bucketName)
+	if err != nil {
+		cblog.Errorf("DeleteS3BucketTagging failed: %v", err)
+		return configErrorResponse(c, err, "", "/"+bucketName)
+	}
+
+	addS3Headers(c)
+	return c.NoContent(http.StatusNoContent)
+}
+
+// getObjectTagging returns the tags of an object
+func getObjectTagging(c echo.Context) error {
+	conn, _ := getConnectionName(c)
+	bucketName := c.Param("BucketName")
+	objKey := c.Param("ObjectKey+")
+	if decodedObjKey, err := url.PathUnescape(objKey); err == nil {
+		objKey = decodedObjKey
+	}
+	versionId := c.QueryParam("versionId")
+
+	tagMap, err := cmrt.GetS3ObjectTagging(conn, bucketName, objKey, versionId)
+	if err != nil {
+		cblog.Errorf("GetS3ObjectTagging failed: %v", err)
+		return configErrorResponse(c, err, "", "/"+bucketName+"/"+objKey)
+	}
+
+	if versionId != "" {
+		c.Response().Header().Set("x-amz-version-id", versionId)
+	}
+	return returnS3Response(c, http.StatusOK, tagMapToTagging(tagMap))
+}
+
+// putObjectTagging replaces the tags of an object
+func putObjectTagging(c echo.Context) error {
+	conn, _ := getConnectionName(c)
+	bucketName := c.Param("BucketName")
+	objKey := c.Param("ObjectKey+")
+	if decodedObjKey, err := url.PathUnescape(objKey); err == nil {
+		objKey = decodedObjKey
+	}
+	versionId := c.QueryParam("versionId")
+
+	cblog.Infof("putObjectTagging called - Bucket: %s, Object: %s, Connection: %s", bucketName, objKey, conn)
+
+	tagMap, err := readTaggingBody(c, "/"+bucketName+"/"+objKey)
+	if tagMap == nil {
+		return err
+	}
+
+	_, err = cmrt.SetS3ObjectTagging(conn, bucketName, objKey, versionId, tagMap)
+	if err != nil {
+		cblog.Errorf("SetS3ObjectTagging failed: %v", err)
+		return configErrorResponse(c, err, "", "/"+bucketName+"/"+objKey)
+	}
+
+	if versionId != "" {
+		c.Response().Header().Set("x-amz-version-id", versionId)
+	}
+	addS3Headers(c)
+	return c.NoContent(http.StatusOK)
+}
+
+// deleteObjectTagging deletes the tags of an object
+func deleteObjectTagging(c echo.Context) error {
+	conn, _ := getConnectionName(c)
+	bucketName := c.Param("BucketName")
+	objKey := c.Param("ObjectKey+")
+	if decodedObjKey, err := url.PathUnescape(objKey); err == nil {
+		objKey = decodedObjKey
+	}
+	versionId := c.QueryParam("versionId")
+
+	cblog.Infof("deleteObjectTagging called - Bucket: %s, Object: %s, Connection: %s", bucketName, objKey, conn)
+
+	_, err := cmrt.DeleteS3ObjectTagging(conn, bucketName, objKey, versionId)
+	if err != nil {
+		cblog.Errorf("DeleteS3ObjectTagging failed: %v", err)
+		return configErrorResponse(c, err, "", "/"+bucketName+"/"+objKey)
+	}
+
+	if versionId != "" {
+		c.Response().Header().Set("x-amz-version-id", versionId)
+	}
+	addS3Headers(c)
+	return c.NoContent(http.StatusNoContent)
+}
+
+// getBucketEncryption returns the default encryption of a bucket
+func getBucketEncryption(c echo.Context) error {
+	conn, _ := getConnectionName(c)
+	bucketName := strings.TrimSuffix(c.Param("BucketName"), "/")
+
+	config, err := cmrt.GetS3BucketEncryption(conn, bucketName)
+	if err != nil {
+		cblog.Errorf("GetS3BucketEncryption failed: %v", err)
+		return configErrorResponse(c, err, "", "/"+bucketName)
+	}
+
+	resp := ServerSideEncryptionConfiguration{Xmlns: "http://s3.amazonaws.com/doc/2006-03-01/"}
+	for _, rule := range config.Rules {
+		resp.Rules = append(resp.Rules, ServerSideEncryptionRule{
+			ApplyServerSideEncryptionByDefault: ApplyServerSideEncryptionByDefault{
+				SSEAlgorithm:   rule.Apply.SSEAlgorithm,
+				KMSMasterKeyID: rule.Apply.KmsMasterKeyID,
+			},
+		})
+	}
+	return returnS3Response(c, http.StatusOK, resp)
+}
+
+// putBucketEncryption sets the default encryption of a bucket
+func putBucketEncryption(c echo.Context) error {
+	conn, _ := getConnectionName(c)
+	bucketName := strings.TrimSuffix(c.Param("BucketName"), "/")
+
+	cblog.Infof("putBucketEncryption called - Bucket: %s, Connection: %s", bucketName, conn)
+
+	var config ServerSideEncryptionConfiguration
+	if err := readS3ConfigBody(c, &config); err != nil {
+		cblog.Errorf("Failed to parse encryption config: %v", err)
+		return malformedBodyError(c, err, "/"+bucketName)
+	}
+	if len(config.Rules) != 1 {
+		return returnS3Error(c, http.StatusBadRequest, "MalformedXML", "Exactly one encryption rule is required", "/"+bucketName)
+	}
+
+	apply := config.Rules[0].ApplyServerSideEncryptionByDefault
+	if apply.SSEAlgorithm != cmrt.S3EncryptionSSES3 && apply.SSEAlgorithm != cmrt.S3EncryptionSSEKMS {
+		return returnS3Error(c, http.StatusBadRequest, "InvalidArgument",
+			fmt.Sprintf("Invalid SSEAlgorithm '%s': must be '%s' or '%s'", apply.SSEAlgorithm, cmrt.S3EncryptionSSES3, cmrt.S3EncryptionSSEKMS), "/"+bucketName)
+	}
+
+	_, err := cmrt.SetS3BucketEncryption(conn, bucketName, apply.SSEAlgorithm, apply.KMSMasterKeyID)
+	if err != nil {
+		cblog.Errorf("SetS3BucketEncryption failed: %v", err)
+		return configErrorResponse(c, err, "", "/"+bucketName)
+	}
+
+	addS3Headers(c)
+	return c.NoContent(http.StatusOK)
+}
+
+// deleteBucketEncryption deletes the default encryption of a bucket
+func deleteBucketEncryption(c echo.Context) error {
+	conn, _ := getConnectionName(c)
+	bucketName := strings.TrimSuffix(c.Param("BucketName"), "/")
+
+	cblog.Infof("deleteBucketEncryption called - Bucket: %s, Connection: %s", bucketName, conn)
+
+	_, err := cmrt.DeleteS3BucketEncryption(conn, bucketName)
+	if err != nil {
+		cblog.Errorf("DeleteS3BucketEncryption failed: %v", err)
+		return configErrorResponse(c, err, "", "/"+bucketName)
+	}
+
+	addS3Headers(c)
+	return c.NoContent(http.StatusNoContent)
+}
+
+// getBucketObjectLock returns the object lock configuration of a bucket
+func getBucketObjectLock(c echo.Context) error {
+	conn, _ := getConnectionName(c)
+	bucketName := strings.TrimSuffix(c.Param("BucketName"), "/")
+
+	config, err := cmrt.GetS3BucketObjectLock(conn, bucketName)
+	if err != nil {
+		cblog.Errorf("GetS3BucketObjectLock failed: %v", err)
+		return configErrorResponse(c, err, "", "/"+bucketName)
+	}
+
+	resp := ObjectLockConfiguration{Xmlns: "http://s3.amazonaws.com/doc/2006-03-01/"}
+	if config.Enabled {
+		resp.ObjectLockEnabled = "Enabled"
+	}
+	if config.Mode != "" {
+		resp.Rule = &ObjectLockRule{DefaultRetention: DefaultRetention{Mode: config.Mode}}
+		if config.Unit == "YEARS" {
+			resp.Rule.DefaultRetention.Years = config.Validity
+		} else {
+			resp.Rule.DefaultRetention.Days = config.Validity
+		}
+	}
+	return returnS3Response(c, http.StatusOK, resp)
+}
+
+// putBucketObjectLock sets the default retention of an object lock enabled bucket
+func putBucketObjectLock(c echo.Context) error {
+	conn, _ := getConnectionName(c)
+	bucketName := strings.TrimSuffix(c.Param("BucketName"), "/")
+
+	cblog.Infof("putBucketObjectLock called - Bucket: %s, Connection: %s", bucketName, conn)
+
+	var config ObjectLockConfiguration
+	if err := readS3ConfigBody(c, &config); err != nil {
+		cblog.Errorf("Failed to parse object lock config: %v", err)
+		return malformedBodyError(c, err, "/"+bucketName)
+	}
+	if config.ObjectLockEnabled != "Enabled" {
+		return returnS3Error(c, http.StatusBadRequest, "MalformedXML", "ObjectLockEnabled must be 'Enabled'", "/"+bucketName)
+	}
+
+	var mode, unit string
+	var validity uint
+	if config.Rule != nil {
+		retention := config.Rule.DefaultRetention
+		if (retention.Days == 0) == (retention.Years == 0) {
+			return returnS3Error(c, http.StatusBadRequest, "MalformedXML", "DefaultRetention requires either Days or Years", "/"+bucketName)
+		}
+		mode, unit, validity = retention.Mode, "DAYS", retention.Days
+		if retention.Years != 0 {
+			unit, validity = "YEARS", retention.Years
+		}
+	}
+
+	_, err := cmrt.SetS3BucketObjectLock(conn, bucketName, mode, validity, unit)
+	if err != nil {
+		cblog.Errorf("SetS3BucketObjectLock failed: %v", err)
+		if strings.Contains(err.Error(), "invalid retention mode") {
+			return returnS3Error(c, http.StatusBadRequest, "MalformedXML", err.Error(), "/"+bucketName)
+		}
+		return configErrorResponse(c, err, "", "/"+bucketName)
+	}
+
+	addS3Headers(c)
+	return c.NoContent(http.StatusOK)
+}
+
+// getObjectRetention returns the retention of an object
+func getObjectRetention(c echo.Context) error {
+	conn, _ := getConnectionName(c)
+	bucketName := c.Param("BucketName")
+	objKey := c.Param("ObjectKey+")
+	if decodedObjKey, err := url.PathUnescape(objKey); err == nil {
+		objKey = decodedObjKey
+	}
+	versionId := c.QueryParam("versionId")
+
+	mode, retainUntilDate, err := cmrt.GetS3ObjectRetention(conn, bucketName, objKey, versionId)
+	if err != nil {
+		cblog.Errorf("GetS3ObjectRetention failed: %v", err)
+		return configErrorResponse(c, err, "", "/"+bucketName+"/"+objKey)
+	}
+	if mode == "" || retainUntilDate == nil {
+		return returnS3Error(c, http.StatusNotFound, "NoSuchObjectLockConfiguration", "The specified object does not have a retention configuration", "/"+bucketName+"/"+objKey)
+	}
+
+	resp := ObjectRetention{
+		Xmlns:           "http://s3.amazonaws.com/doc/2006-03-01/",
+		Mode:            mode,
+		RetainUntilDate: retainUntilDate.UTC().Format(time.RFC3339),
+	}
+	return returnS3Response(c, http.StatusOK, resp)
+}
+
+// putObjectRetention sets the retention of an object
+func putObjectRetention(c echo.Context) error {
+	conn, _ := getConnectionName(c)
+	bucketName := c.Param("BucketName")
+	objKey := c.Param("ObjectKey+")
+	if decodedObjKey, err := url.PathUnescape(objKey); err == nil {
+		objKey = decodedObjKey
+	}
+	versionId := c.QueryParam("versionId")
+
+	cblog.Infof("putObjectRetention called - Bucket: %s, Object: %s, Connection: %s", bucketName, objKey, conn)
+
+	var retention ObjectRetention
+	if err := readS3ConfigBody(c, &retention); err != nil {
+		cblog.Errorf("Failed to parse retention: %v", err)
+		return malformedBodyError(c, err, "/"+bucketName+"/"+objKey)
+	}
+	retainUntilDate, err := time.Parse(time.RFC3339, retention.RetainUntilDate)
+	if err != nil {
+		return returnS3Error(c, http.StatusBadRequest, "InvalidArgument",
+			fmt.Sprintf("Invalid RetainUntilDate '%s': must be RFC3339 (e.g., 2027-01-01T00:00:00Z)", retention.RetainUntilDate), "/"+bucketName+"/"+objKey)
+	}
+	bypassGovernance := strings.EqualFold(c.Request().Header.Get("x-amz-bypass-governance-retention"), "true")
+
+	_, err = cmrt.SetS3ObjectRetention(conn, bucketName, objKey, versionId, retention.Mode, retainUntilDate, bypassGovernance)
+	if err != nil {
+		cblog.Errorf("SetS3ObjectRetention failed: %v", err)
+		if strings.Contains(err.Error(), "invalid retention mode") {
+			return returnS3Error(c, http.StatusBadRequest, "MalformedXML", err.Error(), "/"+bucketName+"/"+objKey)
+		}
+		return configErrorResponse(c, err, "", "/"+bucketName+"/"+objKey)
+	}
+
+	addS3Headers(c)
+	return c.NoContent(http.StatusOK)
+}
+
+// getObjectLegalHold returns the legal hold status of an object
+func getObjectLegalHold(c echo.Context) error {
+	conn, _ := getConnectionName(c)
+	bucketName := c.Param("BucketName")
+	objKey := c.Param("ObjectKey+")
+	if decodedObjKey, err := url.PathUnescape(objKey); err == nil {
+		objKey = decodedObjKey
+	}
+	versionId := c.QueryParam("versionId")
+
+	status, err := cmrt.GetS3ObjectLegalHold(conn, bucketName, objKey, versionId)
+	if err != nil {
+		cblog.Errorf("GetS3ObjectLegalHold failed: %v", err)
+		return configErrorResponse(c, err, "", "/"+bucketName+"/"+objKey)
+	}
+
+	resp := ObjectLegalHold{
+		Xmlns:  "http://s3.amazonaws.com/doc/2006-03-01/",
+		Status: status,
+	}
+	return returnS3Response(c, http.StatusOK, resp)
+}
+
+// putObjectLegalHold turns the legal hold of an object on or off
+func putObjectLegalHold(c echo.Context) error {
+	conn, _ := getConnectionName(c)
+	bucketName := c.Param("BucketName")
+	objKey := c.Param("ObjectKey+")
+	if decodedObjKey, err := url.PathUnescape(objKey); err == nil {
+		objKey = decodedObjKey
+	}
+	versionId := c.QueryParam("versionId")
+
+	cblog.Infof("putObjectLegalHold called - Bucket: %s, Object: %s, Connection: %s", bucketName, objKey, conn)
+
+	var legalHold ObjectLegalHold
+	if err := readS3ConfigBody(c, &legalHold); err != nil {
+		cblog.Errorf("Failed to parse legal hold: %v", err)
+		return malformedBodyError(c, err, "/"+bucketName+"/"+objKey)
+	}
+	if legalHold.Status != "ON" && legalHold.Status != "OFF" {
+		return returnS3Error(c, http.StatusBadRequest, "MalformedXML",
+			fmt.Sprintf("Invalid legal hold Status '%s': must be 'ON' or 'OFF'", legalHold.Status), "/"+bucketName+"/"+objKey)
+	}
+
+	_, err := cmrt.SetS3ObjectLegalHold(conn, bucketName, objKey, versionId, legalHold.Status == "ON")
+	if err != nil {
+		cblog.Errorf("SetS3ObjectLegalHold failed: %v", err)
+		return configErrorResponse(c, err, "", "/"+bucketName+"/"+objKey)
+	}
+
+	addS3Headers(c)
+	return c.NoContent(http.StatusOK)
+}
+
 // listObjectVersions lists all versions of objects in a bucket
 func listObjectVersions(c echo.Context) error {
 	conn, _ := getConnectionName(c)
@@ -983,12 +1602,26 @@ func listObjectVersions(c echo.Context) error {
 // @Description - No query params: Create a new bucket
 // @Description - ?versioning: Set versioning configuration (Enable/Suspend)
 // @Description - ?cors: Set CORS configuration
+// @Description - ?lifecycle: Set lifecycle configuration (replaces all rules)
+// @Description - ?tagging: Set bucket tags (replaces all tags, GCP: bucket labels of lowercase letters, digits, '_' and '-', otherwise 400 InvalidTag)
+// @Description - ?encryption: Set default server-side encryption
+// @Description - ?object-lock: Set default retention of an object lock enabled bucket
 // @Description
 // @Description **IMPORTANT: Choose only ONE body configuration based on query parameter:**
 // @Description - If using ?versioning: Use VersioningConfiguration body
 // @Description - If using ?cors: Use CORSConfiguration body
+// @Description - If using ?lifecycle: Use LifecycleConfiguration body
+// @Description - If using ?tagging: Use Tagging body
+// @Description - If using ?encryption: Use ServerSideEncryptionConfiguration body
+// @Description - If using ?object-lock: Use ObjectLockConfiguration body
 // @Description - If no query params: No body required (bucket creation)
 // @Description
+// @Description **Bucket Creation Options (headers):**
+// @Description - x-spider-server-side-encryption: AES256 or aws:kms (default encryption, GCP: Google-managed or Cloud KMS key)
+// @Description - x-spider-server-side-encryption-aws-kms-key-id: KMS key ID for aws:kms
+// @Description - x-amz-bucket-object-lock-enabled: true (object lock can only be enabled at creation)
+// @Description - Options not supported by the provider fail with 501 NotImplemented, the bucket is not created
+// @Description
 // @Description **Versioning Status Values:**
 // @Description - Enabled: Enable versioning for the bucket
 // @Description - Suspended: Suspend versioning for the bucket
@@ -999,6 +1632,12 @@ func listObjectVersions(c echo.Context) error {
 // @Description - AllowedHeader: ["*"] or ["Content-Type", "Authorization"]
 // @Description - ExposeHeader: ["ETag", "x-amz-request-id"]
 // @Description - MaxAgeSeconds: 3600 (cache preflight response for 1 hour)
+// @Description
+// @Description **Lifecycle Configuration Example:**
+// @Description - {"Rule": [{"ID": "expire-logs", "Status": "Enabled", "Filter": {"Prefix": "logs/"}, "Expiration": {"Days": 30}}]}
+// @Description - Transition: {"Days": 7, "StorageClass": "STANDARD_IA"} (GCP: NEARLINE, COLDLINE, ARCHIVE)
+// @Description - NoncurrentVersionExpiration: {"NoncurrentDays": 7}, AbortIncompleteMultipartUpload: {"DaysAfterInitiation": 1}
+// @Description - GCP has no disabled rule: a Disabled rule fails with 501 NotImplemented, remove the rule instead
 // @Tags [S3 Object Storage Management]
 // @Accept  json
 // @Produce  json
@@ -1006,12 +1645,24 @@ func listObjectVersions(c echo.Context) error {
 // @Param BucketName path string true "Bucket name"
 // @Param versioning query string false "Set versioning configuration"
 // @Param cors query string false "Set CORS configuration"
+// @Param lifecycle query string false "Set lifecycle configuration"
+// @Param tagging query string false "Set bucket tags"
+// @Param encryption query string false "Set default encryption"
+// @Param object-lock query string false "Set object lock default retention"
+// @Param x-spider-server-side-encryption header string false "Default encryption at bucket creation: AES256 or aws:kms"
+// @Param x-spider-server-side-encryption-aws-kms-key-id header string false "KMS key ID for aws:kms at bucket creation"
+// @Param x-amz-bucket-object-lock-enabled header string false "Enable object lock at bucket creation: true"
 // @Param VersioningConfiguration body VersioningConfiguration false "USE THIS ONLY with ?versioning query parameter. Status: 'Enabled' or 'Suspended'"
 // @Param CORSConfiguration body CORSConfiguration false "USE THIS ONLY with ?cors query parameter. Must include at least one CORSRule"
+// @Param LifecycleConfiguration body LifecycleConfiguration false "USE THIS ONLY with ?lifecycle query parameter. Must include at least one Rule"
+// @Param Tagging body Tagging false "USE THIS ONLY with ?tagging query parameter"
+// @Param ServerSideEncryptionConfiguration body ServerSideEncryptionConfiguration false "USE THIS ONLY with ?encryption query parameter. Must include exactly one Rule"
+// @Param ObjectLockConfiguration body ObjectLockConfiguration false "USE THIS ONLY with ?object-lock query parameter. ObjectLockEnabled must be 'Enabled'"
 // @Success 200 "Bucket created or configuration updated successfully"
 // @Failure 400 {object} S3Error "Bad Request"
 // @Failure 409 {object} S3Error "Conflict - Bucket already exists"
 // @Failure 500 {object} S3Error "Internal Server Error"
+// @Failure 501 {object} S3Error "Not Implemented - option not supported by the provider"
 // @Router /s3/{BucketName} [put]
 func CreateS3Bucket(c echo.Context) error {
 	conn, _ := getConnectionName(c)
@@ -1037,7 +1688,9 @@ func CreateS3Bucket(c echo.Context) error {
 	// Check if this is a configuration request (any query parameter that indicates configuration)
 	// Use QueryParams().Has() to check for parameter existence regardless of value
 	if c.QueryParams().Has("versioning") || c.QueryParams().Has("cors") ||
-		c.QueryParams().Has("policy") || c.QueryParams().Has("location") || c.QueryParams().Has("versions") {
+		c.QueryParams().Has("policy") || c.QueryParams().Has("location") || c.QueryParams().Has("versions") ||
+		c.QueryParams().Has("lifecycle") || c.QueryParams().Has("tagging") ||
+		c.QueryParams().Has("encryption") || c.QueryParams().Has("object-lock") {
 		cblog.Infof("Detected bucket configuration request, redirecting to GetS3Bucket")
 		return GetS3Bucket(c)
 	}
@@ -1063,7 +1716,14 @@ func CreateS3Bucket(c echo.Context) error {
 		return GetS3Bucket(c)
 	}
 
-	_, err := cmrt.CreateS3Bucket(conn, bucketName)
+	// Optional default encryption (Spider extension headers) and object lock
+	opts := &cmrt.S3BucketCreateOptions{
+		Encryption: c.Request().Header.Get("x-spider-server-side-encryption"),
+		KMSKeyID:   c.Request().Header.Get("x-spider-server-side-encryption-aws-kms-key-id"),
+		ObjectLock: strings.EqualFold(c.Request().Header.Get("x-amz-bucket-object-lock-enabled"), "true"),
+	}
+
+	_, err := cmrt.CreateS3BucketWithOptions(conn, bucketName, opts)
 	if err != nil {
 		cblog.Errorf("Failed to create bucket %s: %v", bucketName, err)
 
@@ -1071,8 +1731,14 @@ func CreateS3Bucket(c echo.Context) error {
 		statusCode := http.StatusInternalServerError
 		errMsg := err.Error()
 
-		// Check for bucket name validation errors (CSP-specific)
-		if strings.Contains(errMsg, "invalid") || strings.Contains(errMsg, "Bucket name") ||
+		// Check for unsupported create options and bucket name validation errors (CSP-specific)
+		if strings.Contains(errMsg, "not supported by") {
+			errorCode = "NotImplemented"
+			statusCode = http.StatusNotImplemented
+		} else if strings.Contains(errMsg, "invalid encryption algorithm") || strings.Contains(errMsg, "KMS key name is required") {
+			errorCode = "InvalidArgument"
+			statusCode = http.StatusBadRequest
+		} else if strings.Contains(errMsg, "invalid") || strings.Contains(errMsg, "Bucket name") ||
 			strings.Contains(errMsg, "bucket name") || strings.Contains(errMsg, "BucketName") {
 			errorCode = "InvalidBucketName"
 			statusCode = http.StatusBadRequest
@@ -1181,6 +1847,10 @@ func ListS3Buckets(c echo.Context) error {
 // @Description | `?location` | `{"LocationConstraint": "ap-northeast-2"}` | Bucket region/location |
 // @Description | `?versioning` | `VersioningConfiguration` | Versioning status: Enabled / Suspended / "" |
 // @Description | `?cors` | `CORSConfiguration` | CORS configuration rules |
+// @Description | `?lifecycle` | `LifecycleConfiguration` | Lifecycle rules (Expiration, Transition, ...) |
+// @Description | `?tagging` | `Tagging` | Bucket tags (GCP: bucket labels) |
+// @Description | `?encryption` | `ServerSideEncryptionConfiguration` | Default server-side encryption |
+// @Description | `?object-lock` | `ObjectLockConfiguration` | Object lock and default retention |
 // @Description | `?versions` | `ListVersionsResultJSON` | Object version history |
 // @Description | `?uploads` | `ListMultipartUploadsResultJSON` | In-progress multipart uploads |
 // @Description
@@ -1193,6 +1863,10 @@ func ListS3Buckets(c echo.Context) error {
 // @Param location query string false "Get bucket location. Returns: LocationConstraint object (e.g. ap-northeast-2)"
 // @Param versioning query string false "Get versioning status. Returns: VersioningConfiguration"
 // @Param cors query string false "Get CORS configuration. Returns: CORSConfiguration"
+// @Param lifecycle query string false "Get lifecycle configuration. Returns: LifecycleConfiguration"
+// @Param tagging query string false "Get bucket tags. Returns: Tagging"
+// @Param encryption query string false "Get default encryption. Returns: ServerSideEncryptionConfiguration"
+// @Param object-lock query string false "Get object lock configuration. Returns: ObjectLockConfiguration"
 // @Param versions query string false "List object versions. Returns: ListVersionsResultJSON"
 // @Param uploads query string false "List multipart uploads. Returns: ListMultipartUploadsResultJSON"
 // @Success 200 {object} ListBucketResultJSON "Default response (no query params): object list. See description table for other query param responses."
@@ -1300,6 +1974,22 @@ func GetS3Bucket(c echo.Context) error {
 			cblog.Infof("Handling PUT cors for bucket: %s", name)
 			return putBucketCORS(c)
 		}
+		if c.QueryParams().Has("lifecycle") {
+			cblog.Infof("Handling PUT lifecycle for bucket: %s", name)
+			return putBucketLifecycle(c)
+		}
+		if c.QueryParams().Has("tagging") {
+			cblog.Infof("Handling PUT tagging for bucket: %s", name)
+			return putBucketTagging(c)
+		}
+		if c.QueryParams().Has("encryption") {
+			cblog.Infof("Handling PUT encryption for bucket: %s", name)
+			return putBucketEncryption(c)
+		}
+		if c.QueryParams().Has("object-lock") {
+			cblog.Infof("Handling PUT object-lock for bucket: %s", name)
+			return putBucketObjectLock(c)
+		}
 		// Log all query parameters for debugging
 		cblog.Infof("All query parameters: %v", c.QueryParams())
 
@@ -1337,6 +2027,22 @@ func GetS3Bucket(c echo.Context) error {
 			cblog.Infof("Handling GET cors for bucket: %s", name)
 			return getBucketCORS(c)
 		}
+		if c.QueryParams().Has("lifecycle") {
+			cblog.Infof("Handling GET lifecycle for bucket: %s", name)
+			return getBucketLifecycle(c)
+		}
+		if c.QueryParams().Has("tagging") {
+			cblog.Infof("Handling GET tagging for bucket: %s", name)
+			return getBucketTagging(c)
+		}
+		if c.QueryParams().Has("encryption") {
+			cblog.Infof("Handling GET encryption for bucket: %s", name)
+			return getBucketEncryption(c)
+		}
+		if c.QueryParams().Has("object-lock") {
+			cblog.Infof("Handling GET object-lock for bucket: %s", name)
+			return getBucketObjectLock(c)
+		}
 		if c.QueryParams().Has("versions") {
 			cblog.Infof("Handling GET versions for bucket: %s", name)
 			return listObjectVersions(c)
@@ -1351,6 +2057,9 @@ func GetS3Bucket(c echo.Context) error {
 			!c.QueryParams().Has("policy") &&
 			!c.QueryParams().Has("lifecycle") &&
 			!c.QueryParams().Has("cors") &&
+			!c.QueryParams().Has("tagging") &&
+			!c.QueryParams().Has("encryption") &&
+			!c.QueryParams().Has("object-lock") &&
 			!c.QueryParams().Has("versions") &&
 			!c.QueryParams().Has("location") {
 			cblog.Infof("No special query params, treating as list objects request for bucket: %s", name)
@@ -1366,6 +2075,18 @@ func GetS3Bucket(c echo.Context) error {
 			cblog.Infof("Handling DELETE cors for bucket: %s", name)
 			return deleteBucketCORS(c)
 		}
+		if c.QueryParams().Has("lifecycle") {
+			cblog.Infof("Handling DELETE lifecycle for bucket: %s", name)
+			return deleteBucketLifecycle(c)
+		}
+		if c.QueryParams().Has("tagging") {
+			cblog.Infof("Handling DELETE tagging for bucket: %s", name)
+			return deleteBucketTagging(c)
+		}
+		if c.QueryParams().Has("encryption") {
+			cblog.Infof("Handling DELETE encryption for bucket: %s", name)
+			return deleteBucketEncryption(c)
+		}
 
 		// If no query parameters, this is likely a delete bucket request
 		// but it should go to DeleteS3Bucket function instead
@@ -1506,6 +2227,9 @@ func GetS3BucketUsage(c echo.Context) error {
 // @Description **Operations:**
 // @Description - No query params: Delete bucket (must be empty)
 // @Description - ?cors: Delete CORS configuration
+// @Description - ?lifecycle: Delete lifecycle configuration
+// @Description - ?tagging: Delete bucket tags
+// @Description - ?encryption: Delete default encryption
 // @Description - ?empty: Force empty bucket (removes all objects)
 // @Description - ?force: Force delete bucket with all contents
 // @Tags [S3 Object Storage Management]
@@ -1514,6 +2238,9 @@ func GetS3BucketUsage(c echo.Context) error {
 // @Param ConnectionName query string true "Connection name"
 // @Param BucketName path string true "Bucket name"
 // @Param cors query string false "Delete CORS configuration"
+// @Param lifecycle query string false "Delete lifecycle configuration"
+// @Param tagging query string false "Delete bucket tags"
+// @Param encryption query string false "Delete default encryption"
 // @Param empty query string false "Force empty bucket"
 // @Param force query string false "Force delete bucket with all contents"
 // @Success 200 "CORS configuration deleted"
@@ -1540,6 +2267,10 @@ func DeleteS3Bucket(c echo.Context) error {
 		cblog.Infof("Policy delete request detected, redirecting to GetS3Bucket")
 		return GetS3Bucket(c)
 	}
+	if c.QueryParams().Has("lifecycle") || c.QueryParams().Has("tagging") || c.QueryParams().Has("encryption") {
+		cblog.Infof("Lifecycle/tagging/encryption delete request detected, redirecting to GetS3Bucket")
+		return GetS3Bucket(c)
+	}
 
 	// Check for force empty
 	if c.QueryParams().Has("empty") {
@@ -2028,6 +2759,17 @@ func GetS3ObjectInfo(c echo.Context) error {
 // @Description **Operations:**
 // @Description - No query params: Upload object (standard upload)
 // @Description - ?uploadId={id}&partNumber={num}: Upload a part for multipart upload
+// @Description - x-amz-copy-source header: Copy an object (CopyObject), or a part with ?uploadId&partNumber (UploadPartCopy)
+// @Description - ?tagging[&versionId={id}]: Replace the object tags with a Tagging body (not supported by GCP, Azure, OpenStack)
+// @Description - ?retention[&versionId={id}]: Set the object retention with a Retention body (object lock enabled bucket, x-amz-bypass-governance-retention: true to shorten GOVERNANCE retention)
+// @Description - ?legal-hold[&versionId={id}]: Set the object legal hold with a LegalHold body (Status: ON or OFF)
+// @Description
+// @Description **Copy Example:**
+// @Description - x-amz-copy-source: /{SourceBucketName}/{SourceObjectKey}[?versionId={id}]
+// @Description - x-amz-copy-source-range: bytes=0-5242879 (UploadPartCopy only, optional)
+// @Description - x-amz-metadata-directive: COPY(default) or REPLACE with Content-Type and x-amz-meta-* headers
+// @Description - SourceConnectionName query or x-spider-copy-source-connection header: Spider extension to copy from another connection (ex: AWS => GCP), streamed through Spider
+// @Description - Response: CopyObjectResult or CopyPartResult with ETag
 // @Description
 // @Description **Part Upload Example (Step 2 of multipart upload):**
 // @Description - uploadId: Use UploadId from initiate response (Step 1)
@@ -2043,6 +2785,12 @@ func GetS3ObjectInfo(c echo.Context) error {
 // @Param ObjectKey path string true "Object key (full path)"
 // @Param uploadId query string false "Upload ID for multipart upload"
 // @Param partNumber query int false "Part number (1-10000) for multipart upload"
+// @Param x-amz-copy-source header string false "Copy source: /{SourceBucketName}/{SourceObjectKey}[?versionId={id}]"
+// @Param x-amz-copy-source-range header string false "Byte range of the copy source for UploadPartCopy: bytes={first}-{last}"
+// @Param SourceConnectionName query string false "Connection name of the copy source (Spider extension, default: ConnectionName)"
+// @Param tagging query string false "Set object tags. Body: Tagging"
+// @Param retention query string false "Set object retention. Body: Retention"
+// @Param legal-hold query string false "Set object legal hold. Body: LegalHold"
 // @Param body body string true "File content (binary)"
 // @Success 200 "Object uploaded successfully (returns ETag in header)"
 // @Failure 400 {object} S3Error "Bad Request"
@@ -2058,6 +2806,23 @@ func PutS3ObjectFromFile(c echo.Context) error {
 		return HandleS3PresignedRequest(c)
 	}
 
+	if c.QueryParams().Has("tagging") {
+		return putObjectTagging(c)
+	}
+	if c.QueryParams().Has("retention") {
+		return putObjectRetention(c)
+	}
+	if c.QueryParams().Has("legal-hold") {
+		return putObjectLegalHold(c)
+	}
+
+	if c.Request().Header.Get("x-amz-copy-source") != "" {
+		if c.QueryParam("uploadId") != "" && c.QueryParam("partNumber") != "" {
+			return uploadPartCopy(c)
+		}
+		return copyObject(c)
+	}
+
 	if c.QueryParam("uploadId") != "" && c.QueryParam("partNumber") != "" {
 		return uploadPart(c)
 	}
@@ -2204,6 +2969,7 @@ func PutS3ObjectFromForm(c echo.Context) error {
 // @Description - No query params: Delete object (current version)
 // @Description - ?versionId={id}: Delete specific version
 // @Description - ?uploadId={id}: Abort multipart upload
+// @Description - ?tagging[&versionId={id}]: Delete the object tags
 // @Tags [S3 Object Storage Management]
 // @Accept  json
 // @Produce  json
@@ -2212,6 +2978,7 @@ func PutS3ObjectFromForm(c echo.Context) error {
 // @Param ObjectKey path string true "Object key (full path)"
 // @Param versionId query string false "Version ID to delete"
 // @Param uploadId query string false "Upload ID to abort"
+// @Param tagging query string false "Delete object tags"
 // @Success 204 "Object deleted or upload aborted successfully"
 // @Failure 404 {object} S3Error "Object not found"
 // @Failure 500 {object} S3Error "Internal Server Error"
@@ -2225,6 +2992,10 @@ func DeleteS3Object(c echo.Context) error {
 		return HandleS3PresignedRequest(c)
 	}
 
+	if c.QueryParams().Has("tagging") {
+		return deleteObjectTagging(c)
+	}
+
 	// Check if this is an abort multipart upload request
 	uploadID := c.QueryParam("uploadId")
 	if uploadID != "" {
@@ -2311,6 +3082,9 @@ func DeleteS3Object(c echo.Context) error {
 // @Description | *(none)* | `application/octet-stream` (binary) | Download object content |
 // @Description | `?versionId={id}` | `application/octet-stream` (binary) | Download specific object version |
 // @Description | `?uploadId={id}&list-type=parts` | `ListPartsResultJSON` | List parts of in-progress multipart upload |
+// @Description | `?tagging[&versionId={id}]` | `Tagging` | Object tags |
+// @Description | `?retention[&versionId={id}]` | `ObjectRetention` | Object retention mode and retain-until date |
+// @Description | `?legal-hold[&versionId={id}]` | `ObjectLegalHold` | Object legal hold status |
 // @Description
 // @Description **Note**: The example value below shows the `?uploadId&list-type=parts` (list parts JSON) response.
 // @Description For binary downloads, the response body is the raw file content.
@@ -2329,6 +3103,9 @@ func DeleteS3Object(c echo.Context) error {
 // @Param versionId query string false "Version ID for versioned object (binary download)"
 // @Param uploadId query string false "Upload ID for listing parts (use with list-type=parts). Returns: ListPartsResultJSON"
 // @Param list-type query string false "Must be 'parts' when listing multipart upload parts"
+// @Param tagging query string false "Get object tags. Returns: Tagging"
+// @Param retention query string false "Get object retention. Returns: ObjectRetention"
+// @Param legal-hold query string false "Get object legal hold. Returns: ObjectLegalHold"
 // @Success 200 {object} ListPartsResultJSON "?uploadId&list-type=parts → ListPartsResultJSON. No params / ?versionId → binary file download (application/octet-stream). See description table."
 // @Failure 404 {object} S3Error "Object not found"
 // @Failure 500 {object} S3Error "Internal Server Error"
@@ -2342,6 +3119,16 @@ func DownloadS3Object(c echo.Context) error {
 		return HandleS3PresignedRequest(c)
 	}
 
+	if c.QueryParams().Has("tagging") {
+		return getObjectTagging(c)
+	}
+	if c.QueryParams().Has("retention") {
+		return getObjectRetention(c)
+	}
+	if c.QueryParams().Has("legal-hold") {
+		return getObjectLegalHold(c)
+	}
+
 	// Check if this is a list parts request
 	uploadID := c.QueryParam("uploadId")
 	listType := c.QueryParam("list-type")
@@ -2932,6 +3719,183 @@ func uploadPart(c echo.Context) error {
 	return c.NoContent(http.StatusOK)
 }
 
+// CopyObjectResult is the response of CopyObject
+type CopyObjectResult struct {
+	XMLName      xml.Name  `xml:"CopyObjectResult" json:"-"`
+	Xmlns        string    `xml:"xmlns,attr" json:"-"`
+	LastModified time.Time `xml:"LastModified" json:"LastModified"`
+	ETag         string    `xml:"ETag" json:"ETag" example:"\"d8e8fca2dc0f896fd7cb4cb0031ba249\""`
+}
+
+// CopyPartResult is the response of UploadPartCopy
+type CopyPartResult struct {
+	XMLName      xml.Name  `xml:"CopyPartResult" json:"-"`
+	Xmlns        string    `xml:"xmlns,attr" json:"-"`
+	LastModified time.Time `xml:"LastModified" json:"LastModified"`
+	ETag         string    `xml:"ETag" json:"ETag" example:"\"d8e8fca2dc0f896fd7cb4cb0031ba249\""`
+}
+
+// parseCopySource parses the x-amz-copy-source header: [/]{bucket}/{key}[?versionId={id}]
+func parseCopySource(copySource string) (string, string, string, error) {
+	versionId := ""
+	if idx := strings.Index(copySource, "?"); idx >= 0 {
+		query, err := url.ParseQuery(copySource[idx+1:])
+		if err != nil {
+			return "", "", "", fmt.Errorf("invalid copy source query: %v", err)
+		}
+		versionId = query.Get("versionId")
+		copySource = copySource[:idx]
+	}
+
+	decoded, err := url.PathUnescape(copySource)
+	if err != nil {
+		decoded = copySource
+	}
+	decoded = strings.TrimPrefix(decoded, "/")
+
+	parts := strings.SplitN(decoded, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", fmt.Errorf("copy source must be in the form of {bucket}/{key}: %s", copySource)
+	}
+	return parts[0], parts[1], versionId, nil
+}
+
+// getCopySourceConnectionName returns the connection name of the copy source (Spider extension).
+// The default is the connection of the request.
+func getCopySourceConnectionName(c echo.Context, conn string) string {
+	if srcConn := c.QueryParam("SourceConnectionName"); srcConn != "" {
+		return srcConn
+	}
+	if srcConn := c.Request().Header.Get("x-spider-copy-source-connection"); srcConn != "" {
+		return srcConn
+	}
+	return conn
+}
+
+func copyErrorResponse(c echo.Context, err error, resource string) error {
+	errorCode := "InternalError"
+	statusCode := http.StatusInternalServerError
+
+	errResp := minio.ToErrorResponse(err)
+	if errResp.Code != "" && errResp.StatusCode != 0 {
+		errorCode = errResp.Code
+		statusCode = errResp.StatusCode
+	} else if strings.Contains(err.Error(), "not supported by") {
+		errorCode = "NotImplemented"
+		statusCode = http.StatusNotImplemented
+	} else if errors.Is(err, cmrt.ErrNoSuchS3Bucket) {
+		errorCode = "NoSuchBucket"
+		statusCode = http.StatusNotFound
+	}
+	return returnS3Error(c, statusCode, errorCode, err.Error(), resource)
+}
+
+// copyObject copies an object on the server side, or through Spider from another connection
+func copyObject(c echo.Context) error {
+	conn, _ := getConnectionName(c)
+	bucket := c.Param("BucketName")
+	key := c.Param("ObjectKey+")
+	decodedKey, err := url.PathUnescape(key)
+	if err != nil {
+		decodedKey = key
+	}
+	resource := "/" + bucket + "/" + decodedKey
+
+	srcBucket, srcKey, srcVersionId, err := parseCopySource(c.Request().Header.Get("x-amz-copy-source"))
+	if err != nil {
+		return returnS3Error(c, http.StatusBadRequest, "InvalidArgument", err.Error(), resource)
+	}
+	srcConn := getCopySourceConnectionName(c, conn)
+
+	var metadata *cmrt.S3CopyMetadata
+	if strings.EqualFold(c.Request().Header.Get("x-amz-metadata-directive"), "REPLACE") {
+		metadata = &cmrt.S3CopyMetadata{
+			ContentType:  c.Request().Header.Get("Content-Type"),
+			UserMetadata: map[string]string{},
+		}
+		for name, values := range c.Request().Header {
+			lower := strings.ToLower(name)
+			if strings.HasPrefix(lower, "x-amz-meta-") && len(values) > 0 {
+				metadata.UserMetadata[strings.TrimPrefix(lower, "x-amz-meta-")] = values[0]
+			}
+		}
+	}
+
+	var info minio.UploadInfo
+	if srcConn != conn {
+		info, err = cmrt.CopyS3ObjectAcrossConnections(srcConn, srcBucket, srcKey, srcVersionId, conn, bucket, decodedKey, metadata)
+	} else {
+		info, err = cmrt.CopyS3Object(conn, srcBucket, srcKey, srcVersionId, bucket, decodedKey, metadata)
+	}
+	if err != nil {
+		return copyErrorResponse(c, err, resource)
+	}
+
+	if info.VersionID != "" {
+		c.Response().Header().Set("x-amz-version-id", info.VersionID)
+	}
+	if srcVersionId != "" {
+		c.Response().Header().Set("x-amz-copy-source-version-id", srcVersionId)
+	}
+	lastModified := info.LastModified
+	if lastModified.IsZero() {
+		lastModified = time.Now().UTC()
+	}
+	return returnS3Response(c, http.StatusOK, CopyObjectResult{
+		Xmlns:        "http://s3.amazonaws.com/doc/2006-03-01/",
+		LastModified: lastModified,
+		ETag:         "\"" + strings.Trim(info.ETag, "\"") + "\"",
+	})
+}
+
+// uploadPartCopy uploads a part in a multipart upload by copying a range of an object
+func uploadPartCopy(c echo.Context) error {
+	conn, _ := getConnectionName(c)
+	bucket := c.Param("BucketName")
+	key := c.Param("ObjectKey+")
+	decodedKey, err := url.PathUnescape(key)
+	if err != nil {
+		decodedKey = key
+	}
+	resource := "/" + bucket + "/" + decodedKey
+	uploadID := c.QueryParam("uploadId")
+
+	partNumber, err := strconv.Atoi(c.QueryParam("partNumber"))
+	if err != nil {
+		return returnS3Error(c, http.StatusBadRequest, "InvalidArgument", "invalid partNumber", resource)
+	}
+
+	srcBucket, srcKey, srcVersionId, err := parseCopySource(c.Request().Header.Get("x-amz-copy-source"))
+	if err != nil {
+		return returnS3Error(c, http.StatusBadRequest, "InvalidArgument", err.Error(), resource)
+	}
+
+	// x-amz-copy-source-range: bytes={first}-{last}, the whole object if not set
+	startOffset, length := int64(0), int64(-1)
+	if copyRange := c.Request().Header.Get("x-amz-copy-source-range"); copyRange != "" {
+		var first, last int64
+		if _, err := fmt.Sscanf(copyRange, "bytes=%d-%d", &first, &last); err != nil || first < 0 || last < first {
+			return returnS3Error(c, http.StatusBadRequest, "InvalidArgument", "invalid x-amz-copy-source-range: "+copyRange, resource)
+		}
+		startOffset, length = first, last-first+1
+	}
+
+	etag, err := cmrt.UploadPartCopy(conn, bucket, decodedKey, uploadID, partNumber,
+		getCopySourceConnectionName(c, conn), srcBucket, srcKey, srcVersionId, startOffset, length)
+	if err != nil {
+		return copyErrorResponse(c, err, resource)
+	}
+
+	if srcVersionId != "" {
+		c.Response().Header().Set("x-amz-copy-source-version-id", srcVersionId)
+	}
+	return returnS3Response(c, http.StatusOK, CopyPartResult{
+		Xmlns:        "http://s3.amazonaws.com/doc/2006-03-01/",
+		LastModified: time.Now().UTC(),
+		ETag:         "\"" + strings.Trim(etag, "\"") + "\"",
+	})
+}
+
 // ForceEmptyS3Bucket forcefully empties a bucket but keeps the bucket
 func ForceEmptyS3Bucket(c echo.Context) error {
 	conn, _ := getConnectionName(c)
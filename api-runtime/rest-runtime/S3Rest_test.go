@@ -0,0 +1,50 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package restruntime
+
+import (
+	"testing"
+)
+
+func TestParseCopySource(t *testing.T) {
+	testList := []struct {
+		copySource string
+		bucket     string
+		key        string
+		versionId  string
+	}{
+		{"/src-bucket/object.txt", "src-bucket", "object.txt", ""},
+		{"src-bucket/object.txt", "src-bucket", "object.txt", ""},
+		{"/src-bucket/dir/sub/object.txt", "src-bucket", "dir/sub/object.txt", ""},
+		{"/src-bucket/object.txt?versionId=v-01", "src-bucket", "object.txt", "v-01"},
+		{"/src-bucket/my%20object%2B1.txt", "src-bucket", "my object+1.txt", ""},
+		{"src-bucket%2Fdir%2Fobject.txt", "src-bucket", "dir/object.txt", ""},
+		{"/src-bucket/100%.txt", "src-bucket", "100%.txt", ""},
+	}
+
+	for _, test := range testList {
+		bucket, key, versionId, err := parseCopySource(test.copySource)
+		if err != nil {
+			t.Errorf("%s: %v", test.copySource, err)
+			continue
+		}
+		if bucket != test.bucket || key != test.key || versionId != test.versionId {
+			t.Errorf("%s: expected (%s, %s, %s), got (%s, %s, %s)", test.copySource,
+				test.bucket, test.key, test.versionId, bucket, key, versionId)
+		}
+	}
+}
+
+func TestParseCopySourceInvalid(t *testing.T) {
+	for _, copySource := range []string{"", "/", "/src-bucket", "/src-bucket/", "//object.txt", "/src-bucket/object.txt?versionId=%zz"} {
+		if _, _, _, err := parseCopySource(copySource); err == nil {
+			t.Errorf("%q: expected an error", copySource)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package restruntime
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+
+	// REST API (echo)
+	"github.com/labstack/echo/v4"
+)
+
+//================ S3 Sync Management
+
+// S3SyncJobListResponse represents the response body structure for listing S3 sync Jobs.
+type S3SyncJobListResponse struct {
+	Result []*cmrt.S3SyncJobInfo `json:"s3sync" validate:"required"`
+}
+
+// startS3Sync godoc
+// @ID start-s3-sync
+// @Summary Start S3 Sync
+// @Description Start a background job mirroring the objects of a source bucket(and prefix) to a target bucket, possibly of another Connection. <br>
+// @Description With Incremental, objects with the same size and ETag in the target are skipped (same size and not modified after the target copy if an ETag is not an MD5 digest). With DeleteExtraneous, target objects not in the source are deleted. <br>
+// @Description A failed object is tried up to 3 times and listed in FailedObjectList. The checkpoint does not move past a failed object. <br>
+// @Description The progress is kept in the meta DB, and an interrupted job resumes from its checkpoint after a Spider server restart.
+// @Tags [S3 Sync Management]
+// @Accept  json
+// @Produce  json
+// @Param S3SyncReqInfo body cmrt.S3SyncReqInfo true "Request body for starting an S3 sync job"
+// @Success 202 {object} cmrt.S3SyncJobInfo "Submitted S3 sync job"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure, missing fields, invalid Parallelism or a bucket not registered"
+// @Failure 409 {object} SimpleMsg "Conflict, the target bucket is busy with another S3 sync job"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /s3sync [post]
+func StartS3Sync(c echo.Context) error {
+	cblog.Info("call StartS3Sync()")
+
+	var req cmrt.S3SyncReqInfo
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.StartS3Sync(req)
+	if err != nil {
+		if errors.Is(err, cmrt.ErrInvalidS3SyncRequest) || errors.Is(err, cmrt.ErrNoSuchS3Bucket) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		if errors.Is(err, cmrt.ErrS3SyncTargetBusy) {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	c.Response().Header().Set(echo.HeaderLocation, "/spider/s3sync/"+result.JobId)
+	return c.JSON(http.StatusAccepted, result)
+}
+
+// listS3Sync godoc
+// @ID list-s3-sync
+// @Summary List S3 Sync Jobs
+// @Description Retrieve a list of S3 sync jobs, newest first.
+// @Tags [S3 Sync Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionName query string false "The name of the Connection used as the source or the target. If empty, jobs of all Connections are listed."
+// @Success 200 {object} S3SyncJobListResponse "List of S3 sync jobs"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /s3sync [get]
+func ListS3Sync(c echo.Context) error {
+	cblog.Info("call ListS3Sync()")
+
+	// Call common-runtime API
+	result, err := cmrt.ListS3SyncJob(c.QueryParam("ConnectionName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	jobListResponse := S3SyncJobListResponse{
+		Result: result,
+	}
+
+	return c.JSON(http.StatusOK, &jobListResponse)
+}
+
+// getS3Sync godoc
+// @ID get-s3-sync
+// @Summary Get S3 Sync Job
+// @Description Retrieve the state and the progress of an S3 sync job.
+// @Tags [S3 Sync Management]
+// @Accept  json
+// @Produce  json
+// @Param Id path string true "The ID of the S3 sync job to retrieve"
+// @Success 200 {object} cmrt.S3SyncJobInfo "Details of the S3 sync job"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /s3sync/{Id} [get]
+func GetS3Sync(c echo.Context) error {
+	cblog.Info("call GetS3Sync()")
+
+	// Call common-runtime API
+	result, err := cmrt.GetS3SyncJob(c.Param("Id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// cancelS3Sync godoc
+// @ID cancel-s3-sync
+// @Summary Cancel S3 Sync Job
+// @Description Cancel a Pending or Running S3 sync job. The objects in transfer are completed, and the job becomes Failed.
+// @Tags [S3 Sync Management]
+// @Accept  json
+// @Produce  json
+// @Param Id path string true "The ID of the S3 sync job to cancel"
+// @Success 200 {object} BooleanInfo "Result of the cancel operation"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 409 {object} SimpleMsg "Conflict, the job is already finished"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /s3sync/{Id}/cancel [put]
+func CancelS3Sync(c echo.Context) error {
+	cblog.Info("call CancelS3Sync()")
+
+	// Call common-runtime API
+	result, err := cmrt.CancelS3SyncJob(c.Param("Id"))
+	if err != nil {
+		if errors.Is(err, cmrt.ErrS3SyncJobNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		if errors.Is(err, cmrt.ErrS3SyncJobStatus) {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+// deleteS3Sync godoc
+// @ID delete-s3-sync
+// @Summary Delete S3 Sync Job
+// @Description Delete a finished(Succeeded or Failed) S3 sync job from the job list. The synced objects are not deleted.
+// @Tags [S3 Sync Management]
+// @Accept  json
+// @Produce  json
+// @Param Id path string true "The ID of the S3 sync job to delete"
+// @Success 200 {object} BooleanInfo "Result of the delete operation"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 409 {object} SimpleMsg "Conflict, the job is Pending or Running"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /s3sync/{Id} [delete]
+func DeleteS3Sync(c echo.Context) error {
+	cblog.Info("call DeleteS3Sync()")
+
+	// Call common-runtime API
+	result, err := cmrt.DeleteS3SyncJob(c.Param("Id"))
+	if err != nil {
+		if errors.Is(err, cmrt.ErrS3SyncJobNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		if errors.Is(err, cmrt.ErrS3SyncJobStatus) {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
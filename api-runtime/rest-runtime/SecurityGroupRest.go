@@ -155,7 +155,8 @@ func CreateSecurity(c echo.Context) error {
 
 // SecurityGroupListResponse represents the response body for listing SecurityGroups.
 type SecurityGroupListResponse struct {
-	Result []*cres.SecurityInfo `json:"securitygroup" validate:"required" description:"A list of security group information"`
+	Result    []*cres.SecurityInfo `json:"securitygroup" validate:"required" description:"A list of security group information"`
+	NextToken string               `json:"NextToken,omitempty" validate:"omitempty" description:"The token of the next page, empty at the last page"`
 }
 
 // listSecurity godoc
@@ -166,6 +167,11 @@ type SecurityGroupListResponse struct {
 // @Accept  json
 // @Produce  json
 // @Param ConnectionName query string true "The name of the Connection to list SecurityGroups for"
+// @Param limit query int false "Max number of the items of a page(1~1000). ex) 50"
+// @Param nextToken query string false "The token of the next page returned by the previous page"
+// @Param sort query string false "Sort field: name(default), zone, status, created, with optional '-' prefix for the descending order. ex) -created"
+// @Param prefix query string false "Filter by the name prefix. ex) web-"
+// @Param tag query string false "Filter by the tag, 'key=value' or 'key'(any value). ex) env=prod"
 // @Success 200 {object} restruntime.SecurityGroupListResponse "List of SecurityGroups"
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid query parameter"
 // @Failure 404 {object} SimpleMsg "Resource Not Found"
@@ -184,13 +190,25 @@ func ListSecurity(c echo.Context) error {
 		req.ConnectionName = c.QueryParam("ConnectionName")
 	}
 
-	result, err := cmrt.ListSecurity(req.ConnectionName, SG)
+	opt, err := getListOption(c)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var result []*cres.SecurityInfo
+	nextToken := ""
+	if opt.IsEmpty() {
+		result, err = cmrt.ListSecurity(req.ConnectionName, SG)
+	} else {
+		result, nextToken, err = cmrt.ListSecurityWithOption(req.ConnectionName, SG, opt)
+	}
+	if err != nil {
+		return echo.NewHTTPError(listErrorStatus(err), err.Error())
 	}
 
 	jsonResult := SecurityGroupListResponse{
-		Result: result,
+		Result:    result,
+		NextToken: nextToken,
 	}
 
 	return c.JSON(http.StatusOK, &jsonResult)
@@ -204,6 +222,10 @@ func ListSecurity(c echo.Context) error {
 // @Accept  json
 // @Produce  json
 // @Param ConnectionName query string true "The name of the Connection to list Security Groups for"
+// @Param limit query int false "Max number of the items of a page(1~1000). The items are ordered by MappedList, OnlySpiderList and OnlyCSPList. ex) 50"
+// @Param nextToken query string false "The token of the next page returned by the previous page"
+// @Param sort query string false "Sort by the name: name(default) or -name"
+// @Param prefix query string false "Filter by the name prefix, the CSP ID for OnlyCSPList. ex) web-"
 // @Success 200 {object} AllResourceListResponse "List of all Security Groups within the specified connection, including Security Groups in CB-Spider only, CSP only, and mapped between both."
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
 // @Failure 404 {object} SimpleMsg "Resource Not Found"
@@ -222,11 +244,24 @@ func ListAllSecurity(c echo.Context) error {
 		req.ConnectionName = c.QueryParam("ConnectionName")
 	}
 
+	opt, err := getListOption(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
 	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, SG)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
+	if !opt.IsEmpty() {
+		page, nextToken, err := cmrt.PageAllResourceList(allResourceList, opt)
+		if err != nil {
+			return echo.NewHTTPError(listErrorStatus(err), err.Error())
+		}
+		return c.JSON(http.StatusOK, &AllResourceListPageResponse{AllResourceList: page, NextToken: nextToken})
+	}
+
 	return c.JSON(http.StatusOK, &allResourceList)
 }
 
@@ -409,6 +444,10 @@ type RuleControlRequest struct {
 			FromPort   string `json:"FromPort" validate:"required" example:"22"`
 			ToPort     string `json:"ToPort" validate:"required" example:"22"`
 			CIDR       string `json:"CIDR,omitempty" validate:"omitempty" example:"0.0.0.0/0(default)"`
+
+			CIDRv6                 string    `json:"CIDRv6,omitempty" validate:"omitempty" example:"::/0"`
+			SourceSecurityGroupIID *cres.IID `json:"SourceSecurityGroupIID,omitempty" validate:"omitempty"` // set NameId only
+			Description            string    `json:"Description,omitempty" validate:"omitempty" example:"ssh from office"`
 		} `json:"RuleInfoList" validate:"required"`
 	} `json:"ReqInfo" validate:"required"`
 }
@@ -417,6 +456,8 @@ type RuleControlRequest struct {
 // @ID add-rule
 // @Summary Add Rules to SecurityGroup
 // @Description Add new rules to a Security Group.
+// @Description A rule allows one of CIDR, CIDRv6 or SourceSecurityGroupIID(NameId), with an optional Description.
+// @Description The rule options not supported by the driver of the connection are rejected.
 // @Tags [SecurityGroup Management]
 // @Accept  json
 // @Produce  json
@@ -444,6 +485,10 @@ func AddRules(c echo.Context) error {
 			FromPort:   info.FromPort,
 			ToPort:     info.ToPort,
 			CIDR:       info.CIDR,
+
+			CIDRv6:                 info.CIDRv6,
+			SourceSecurityGroupIID: info.SourceSecurityGroupIID,
+			Description:            info.Description,
 		}
 		reqRuleInfoList = append(reqRuleInfoList, ruleInfo)
 	}
@@ -487,6 +532,10 @@ func RemoveRules(c echo.Context) error {
 			FromPort:   info.FromPort,
 			ToPort:     info.ToPort,
 			CIDR:       info.CIDR,
+
+			CIDRv6:                 info.CIDRv6,
+			SourceSecurityGroupIID: info.SourceSecurityGroupIID,
+			Description:            info.Description,
 		}
 		reqRuleInfoList = append(reqRuleInfoList, ruleInfo)
 	}
@@ -503,6 +552,57 @@ func RemoveRules(c echo.Context) error {
 	return c.JSON(http.StatusOK, &resultInfo)
 }
 
+// syncRules godoc
+// @ID sync-rule
+// @Summary Sync Rules of SecurityGroup
+// @Description Make the rules of a Security Group the same as the desired rule set.
+// @Description The rules are normalized across the CSP conventions(protocol case, port "-1" vs "1-65535", ...) and the Description is not compared.
+// @Description The missing rules are added and the extra rules are removed under the SecurityGroup lock. With '?dryRun=true', only the diff is returned.
+// @Description The current rules with a target that is not a CIDR(ex: Azure service tag) are kept as they are and returned in SkippedRuleList.
+// @Tags [SecurityGroup Management]
+// @Accept  json
+// @Produce  json
+// @Param SGName path string true "The name of the SecurityGroup to sync rules"
+// @Param RuleControlRequest body restruntime.RuleControlRequest true "Request body with the full desired rule set"
+// @Param dryRun query string false "Return the diff without applying it" Enums(true, false)
+// @Success 200 {object} cmrt.SecurityRuleDiffInfo "The diff applied, or to be applied on dry-run"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /securitygroup/{SGName}/rules [put]
+func SyncRules(c echo.Context) error {
+	cblog.Info("call SyncRules()")
+
+	req := RuleControlRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	reqRuleInfoList := []cres.SecurityRuleInfo{}
+	for _, info := range req.ReqInfo.RuleInfoList {
+		ruleInfo := cres.SecurityRuleInfo{
+			Direction:  info.Direction,
+			IPProtocol: info.IPProtocol,
+			FromPort:   info.FromPort,
+			ToPort:     info.ToPort,
+			CIDR:       info.CIDR,
+
+			CIDRv6:                 info.CIDRv6,
+			SourceSecurityGroupIID: info.SourceSecurityGroupIID,
+			Description:            info.Description,
+		}
+		reqRuleInfoList = append(reqRuleInfoList, ruleInfo)
+	}
+
+	result, err := cmrt.SyncRules(req.ConnectionName, c.Param("SGName"), reqRuleInfoList, isDryRunRequest(c))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
 // countAllSecurityGroups godoc
 // @ID count-all-securitygroup
 // @Summary Count All SecurityGroups
@@ -12,6 +12,7 @@ import (
 	"os"
 
 	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
 	"github.com/labstack/echo/v4"
 )
 
@@ -126,3 +127,38 @@ func FetchResourceUsage(c echo.Context) error {
 	// Default response in JSON format
 	return c.JSON(http.StatusOK, resourceUsage)
 }
+
+// FetchConnectionCacheStats godoc
+// @ID fetch-connection-cache-stats
+// @Summary Fetch Connection Cache Stats
+// @Description Retrieve the usage of the CloudConnection cache, such as size, hits, misses, evictions and invalidations.
+// @Description The cache is configured with SPIDER_CONNECTION_CACHE_TTL and SPIDER_CONNECTION_CACHE_SIZE.
+// @Tags [Utility]
+// @Accept json
+// @Produce json
+// @Success 200 {object} ccm.CloudConnectionCacheStats "Connection Cache Stats"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /sysstats/connectioncache [get]
+func FetchConnectionCacheStats(c echo.Context) error {
+	cblog.Info("call FetchConnectionCacheStats()")
+
+	var stats ccm.CloudConnectionCacheStats = cmrt.FetchConnectionCacheStats()
+	return c.JSON(http.StatusOK, &stats)
+}
+
+// ClearConnectionCache godoc
+// @ID clear-connection-cache
+// @Summary Clear Connection Cache
+// @Description Remove all cached CloudConnections. ex) after the secrets of the credentials are changed in the secret backend.
+// @Tags [Utility]
+// @Produce json
+// @Success 200 {object} BooleanInfo "Result of the clear operation"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /sysstats/connectioncache [delete]
+func ClearConnectionCache(c echo.Context) error {
+	cblog.Info("call ClearConnectionCache()")
+
+	cmrt.ClearConnectionCache()
+
+	return c.JSON(http.StatusOK, &BooleanInfo{Result: "true"})
+}
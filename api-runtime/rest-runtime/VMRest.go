@@ -9,6 +9,8 @@
 package restruntime
 
 import (
+	"errors"
+
 	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 
@@ -168,6 +170,11 @@ type VMStartRequest struct {
 		VMUserId     string `json:"VMUserId,omitempty" validate:"omitempty" example:"Administrator"`    // Administrator, Windows Only
 		VMUserPasswd string `json:"VMUserPasswd,omitempty" validate:"omitempty" example:"password1234"` // Windows Only
 
+		UserData string `json:"UserData,omitempty" validate:"omitempty" example:"#!/bin/bash\necho hello"` // cloud-init script or cloud-config, plain text or base64 (max 16KB), Linux Only
+
+		PurchaseOption string `json:"PurchaseOption,omitempty" validate:"omitempty" example:"OnDemand"` // OnDemand or Spot, if not specified, OnDemand is used
+		SpotMaxPrice   string `json:"SpotMaxPrice,omitempty" validate:"omitempty" example:"0.05"`       // Max price per hour for Spot VM, if not specified, up to the on-demand price
+
 		TagList []cres.KeyValue `json:"TagList,omitempty" validate:"omitempty"`
 	} `json:"ReqInfo" validate:"required"`
 }
@@ -180,7 +187,10 @@ type VMStartRequest struct {
 // @Accept  json
 // @Produce  json
 // @Param VMStartRequest body restruntime.VMStartRequest true "Request body for starting a VM"
-// @Success 200 {object} cres.VMInfo "Details of the started VM"
+// @Param async query string false "Run as an asynchronous Job and return the Job info(202) at once. ex) true or false(default: false)"
+// @Param dryRun query string false "Validate the request without creating the resource and return the plan(cmrt.CreatePlanInfo) with the blocking problems. The quota headroom check is best-effort and reported as a warning. ex) true or false(default: false)"
+// @Success 200 {object} cres.VMInfo "Details of the started VM, or the plan(cmrt.CreatePlanInfo) with '?dryRun=true'"
+// @Success 202 {object} cmrt.JobInfo "Job info of the asynchronous operation, poll it with GET /job/{Id}"
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
 // @Failure 404 {object} SimpleMsg "Resource Not Found"
 // @Failure 500 {object} SimpleMsg "Internal Server Error"
@@ -228,10 +238,28 @@ func StartVM(c echo.Context) error {
 
 		VMUserId:     req.ReqInfo.VMUserId,
 		VMUserPasswd: req.ReqInfo.VMUserPasswd,
+		UserData:     req.ReqInfo.UserData,
+
+		PurchaseOption: cres.VMPurchaseOption(req.ReqInfo.PurchaseOption),
+		SpotMaxPrice:   req.ReqInfo.SpotMaxPrice,
 
 		TagList: req.ReqInfo.TagList,
 	}
 
+	if isDryRunRequest(c) {
+		result, err := cmrt.PlanStartVM(req.ConnectionName, VM, reqInfo)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, result)
+	}
+
+	if isAsyncRequest(c) {
+		return submitJob(c, req.ConnectionName, VM, "StartVM", reqInfo.IId.NameId, func() (interface{}, error) {
+			return cmrt.StartVM(req.ConnectionName, VM, reqInfo, req.IDTransformMode)
+		})
+	}
+
 	// Call common-runtime API
 	result, err := cmrt.StartVM(req.ConnectionName, VM, reqInfo, req.IDTransformMode)
 	if err != nil {
@@ -243,7 +271,8 @@ func StartVM(c echo.Context) error {
 
 // VMListResponse represents the response body structure for listing VMs.
 type VMListResponse struct {
-	VMs []*cres.VMInfo `json:"vm" validate:"required"`
+	VMs       []*cres.VMInfo `json:"vm" validate:"required"`
+	NextToken string         `json:"NextToken,omitempty" validate:"omitempty" description:"The token of the next page, empty at the last page"`
 }
 
 // listVM godoc
@@ -254,6 +283,13 @@ type VMListResponse struct {
 // @Accept  json
 // @Produce  json
 // @Param ConnectionName query string true "The name of the Connection to list VMs for"
+// @Param limit query int false "Max number of the items of a page(1~1000). ex) 50"
+// @Param nextToken query string false "The token of the next page returned by the previous page"
+// @Param sort query string false "Sort field: name(default), zone, status, created, with optional '-' prefix for the descending order. ex) -created"
+// @Param prefix query string false "Filter by the name prefix. ex) web-"
+// @Param zone query string false "Filter by the zone. ex) ap-northeast-2a"
+// @Param status query string false "Filter by the VM status. ex) Running"
+// @Param tag query string false "Filter by the tag, 'key=value' or 'key'(any value). ex) env=prod"
 // @Success 200 {object} VMListResponse "List of VMs"
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid query parameter"
 // @Failure 404 {object} SimpleMsg "Resource Not Found"
@@ -273,14 +309,26 @@ func ListVM(c echo.Context) error {
 		req.ConnectionName = c.QueryParam("ConnectionName")
 	}
 
+	opt, err := getListOption(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
 	// Call common-runtime API
-	result, err := cmrt.ListVM(req.ConnectionName, VM)
+	var result []*cres.VMInfo
+	nextToken := ""
+	if opt.IsEmpty() {
+		result, err = cmrt.ListVM(req.ConnectionName, VM)
+	} else {
+		result, nextToken, err = cmrt.ListVMWithOption(req.ConnectionName, VM, opt)
+	}
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(listErrorStatus(err), err.Error())
 	}
 
 	jsonResult := VMListResponse{
-		VMs: result,
+		VMs:       result,
+		NextToken: nextToken,
 	}
 
 	return c.JSON(http.StatusOK, &jsonResult)
@@ -294,6 +342,10 @@ func ListVM(c echo.Context) error {
 // @Accept  json
 // @Produce  json
 // @Param ConnectionName query string true "The name of the Connection to list VMs for"
+// @Param limit query int false "Max number of the items of a page(1~1000). The items are ordered by MappedList, OnlySpiderList and OnlyCSPList. ex) 50"
+// @Param nextToken query string false "The token of the next page returned by the previous page"
+// @Param sort query string false "Sort by the name: name(default) or -name"
+// @Param prefix query string false "Filter by the name prefix, the CSP ID for OnlyCSPList. ex) web-"
 // @Success 200 {object} AllResourceListResponse "List of all VMs within the specified connection, including VMs in CB-Spider only, CSP only, and mapped between both."
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
 // @Failure 404 {object} SimpleMsg "Resource Not Found"
@@ -313,12 +365,25 @@ func ListAllVM(c echo.Context) error {
 		req.ConnectionName = c.QueryParam("ConnectionName")
 	}
 
+	opt, err := getListOption(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
 	// Call common-runtime API
 	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, VM)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
+	if !opt.IsEmpty() {
+		page, nextToken, err := cmrt.PageAllResourceList(allResourceList, opt)
+		if err != nil {
+			return echo.NewHTTPError(listErrorStatus(err), err.Error())
+		}
+		return c.JSON(http.StatusOK, &AllResourceListPageResponse{AllResourceList: page, NextToken: nextToken})
+	}
+
 	return c.JSON(http.StatusOK, &allResourceList)
 }
 
@@ -421,7 +486,9 @@ func GetCSPVM(c echo.Context) error {
 // @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body for terminating a VM"
 // @Param Name path string true "The name of the VM to terminate"
 // @Param force query string false "Force terminate the VM. ex) true or false(default: false)"
+// @Param async query string false "Run as an asynchronous Job and return the Job info(202) at once. ex) true or false(default: false)"
 // @Success 200 {object} VMStatusResponse "Result of the terminate operation"
+// @Success 202 {object} cmrt.JobInfo "Job info of the asynchronous operation, poll it with GET /job/{Id}"
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
 // @Failure 404 {object} SimpleMsg "Resource Not Found"
 // @Failure 500 {object} SimpleMsg "Internal Server Error"
@@ -435,6 +502,17 @@ func TerminateVM(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
+	if isAsyncRequest(c) {
+		nameID, force := c.Param("Name"), c.QueryParam("force")
+		return submitJob(c, req.ConnectionName, VM, "TerminateVM", nameID, func() (interface{}, error) {
+			_, result, err := cmrt.DeleteVM(req.ConnectionName, VM, nameID, force)
+			if err != nil {
+				return nil, err
+			}
+			return VMStatusResponse{Status: result}, nil
+		})
+	}
+
 	// Call common-runtime API
 	_, result, err := cmrt.DeleteVM(req.ConnectionName, VM, c.Param("Name"), c.QueryParam("force"))
 	if err != nil {
@@ -616,6 +694,54 @@ func ControlVM(c echo.Context) error {
 	return c.JSON(http.StatusOK, &resultInfo)
 }
 
+// VMSpecChangeRequest represents the request body for changing the VMSpec of a VM.
+type VMSpecChangeRequest struct {
+	ConnectionName string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	ReqInfo        struct {
+		VMSpecName string `json:"VMSpecName" validate:"required" example:"t2.small"`
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// changeVMSpec godoc
+// @ID change-vm-spec
+// @Summary Change VM Spec
+// @Description Change the VMSpec(instance type) of an existing VM. If the CSP requires a stopped VM, the running VM is suspended, changed and resumed.
+// @Tags [VM Management]
+// @Accept  json
+// @Produce  json
+// @Param VMSpecChangeRequest body restruntime.VMSpecChangeRequest true "Request body for changing the VMSpec"
+// @Param Name path string true "The name of the VM to change the VMSpec for"
+// @Success 200 {object} cres.VMInfo "Details of the VM with the changed VMSpec"
+// @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
+// @Failure 404 {object} SimpleMsg "Resource Not Found"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vm/{Name}/spec [put]
+func ChangeVMSpec(c echo.Context) error {
+	cblog.Info("call ChangeVMSpec()")
+
+	var req VMSpecChangeRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ChangeVMSpec(req.ConnectionName, VM, c.Param("Name"), req.ReqInfo.VMSpecName)
+	if err != nil {
+		if errors.Is(err, cmrt.ErrVMNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
 // countAllVMs godoc
 // @ID count-all-vm
 // @Summary Count All VMs
@@ -257,7 +257,8 @@ func CreateVPC(c echo.Context) error {
 }
 
 type VPCListResponse struct {
-	Result []*cres.VPCInfo `json:"vpc" validate:"required" description:"A list of VPC information"`
+	Result    []*cres.VPCInfo `json:"vpc" validate:"required" description:"A list of VPC information"`
+	NextToken string          `json:"NextToken,omitempty" validate:"omitempty" description:"The token of the next page, empty at the last page"`
 }
 
 // listVPC godoc
@@ -268,6 +269,11 @@ type VPCListResponse struct {
 // @Accept  json
 // @Produce  json
 // @Param ConnectionName query string true "The name of the Connection to list VPCs for"
+// @Param limit query int false "Max number of the items of a page(1~1000). ex) 50"
+// @Param nextToken query string false "The token of the next page returned by the previous page"
+// @Param sort query string false "Sort field: name(default), zone, status, created, with optional '-' prefix for the descending order. ex) -created"
+// @Param prefix query string false "Filter by the name prefix. ex) web-"
+// @Param tag query string false "Filter by the tag, 'key=value' or 'key'(any value). ex) env=prod"
 // @Success 200 {object} VPCListResponse "List of VPCs"
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid query parameter"
 // @Failure 404 {object} SimpleMsg "Resource Not Found"
@@ -287,14 +293,26 @@ func ListVPC(c echo.Context) error {
 		req.ConnectionName = c.QueryParam("ConnectionName")
 	}
 
+	opt, err := getListOption(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
 	// Call common-runtime API
-	result, err := cmrt.ListVPC(req.ConnectionName, VPC)
+	var result []*cres.VPCInfo
+	nextToken := ""
+	if opt.IsEmpty() {
+		result, err = cmrt.ListVPC(req.ConnectionName, VPC)
+	} else {
+		result, nextToken, err = cmrt.ListVPCWithOption(req.ConnectionName, VPC, opt)
+	}
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(listErrorStatus(err), err.Error())
 	}
 
 	jsonResult := VPCListResponse{
-		Result: result,
+		Result:    result,
+		NextToken: nextToken,
 	}
 
 	return c.JSON(http.StatusOK, &jsonResult)
@@ -308,6 +326,10 @@ func ListVPC(c echo.Context) error {
 // @Accept  json
 // @Produce  json
 // @Param ConnectionName query string true "The name of the Connection to list VPCs for"
+// @Param limit query int false "Max number of the items of a page(1~1000). The items are ordered by MappedList, OnlySpiderList and OnlyCSPList. ex) 50"
+// @Param nextToken query string false "The token of the next page returned by the previous page"
+// @Param sort query string false "Sort by the name: name(default) or -name"
+// @Param prefix query string false "Filter by the name prefix, the CSP ID for OnlyCSPList. ex) web-"
 // @Success 200 {object} AllResourceListResponse "List of all VPCs within the specified connection, including VPCs in CB-Spider only, CSP only, and mapped between both."
 // @Failure 400 {object} SimpleMsg "Bad Request, possibly due to invalid JSON structure or missing fields"
 // @Failure 404 {object} SimpleMsg "Resource Not Found"
@@ -327,12 +349,25 @@ func ListAllVPC(c echo.Context) error {
 		req.ConnectionName = c.QueryParam("ConnectionName")
 	}
 
+	opt, err := getListOption(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
 	// Call common-runtime API
 	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, VPC)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
+	if !opt.IsEmpty() {
+		page, nextToken, err := cmrt.PageAllResourceList(allResourceList, opt)
+		if err != nil {
+			return echo.NewHTTPError(listErrorStatus(err), err.Error())
+		}
+		return c.JSON(http.StatusOK, &AllResourceListPageResponse{AllResourceList: page, NextToken: nextToken})
+	}
+
 	return c.JSON(http.StatusOK, &allResourceList)
 }
 
@@ -563,7 +598,7 @@ func GetVPC(c echo.Context) error {
 // deleteVPC godoc
 // @ID delete-vpc
 // @Summary Delete VPC
-// @Description Delete a specified Virtual Private Cloud (VPC).
+// @Description Delete a specified Virtual Private Cloud (VPC). The VPC peerings of the VPC must be deleted first.
 // @Tags [VPC Management]
 // @Accept  json
 // @Produce  json
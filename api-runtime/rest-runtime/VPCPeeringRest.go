@@ -0,0 +1,266 @@
+// Cloud Control Manager's Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package restruntime
+
+import (
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+//================ VPC Peering Handler
+
+// VPCPeeringCreateRequest represents the request body for creating a VPC peering.
+type VPCPeeringCreateRequest struct {
+	ConnectionName  string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	IDTransformMode string `json:"IDTransformMode,omitempty" validate:"omitempty" example:"ON"`
+	ReqInfo         struct {
+		Name             string          `json:"Name" validate:"required" example:"peering-01"`
+		RequesterVPCName string          `json:"RequesterVPCName" validate:"required" example:"vpc-01"`
+		AccepterVPCName  string          `json:"AccepterVPCName" validate:"required" example:"vpc-02"`
+		TagList          []cres.KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// CreateVPCPeering godoc
+// @ID create-vpcpeering
+// @Summary Create VPC Peering
+// @Description Create a peering between two VPCs of a connection. <br> * The CIDRs of the two VPCs must not overlap. <br> * The peering is created in the 'PendingAcceptance' status in the CSPs requiring the acceptance.
+// @Tags [VPC Peering Management]
+// @Accept  json
+// @Produce  json
+// @Param VPCPeeringCreateRequest body restruntime.VPCPeeringCreateRequest true "Request body for creating a VPC peering"
+// @Success 200 {object} cres.VPCPeeringInfo "Details of the created VPC peering"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpcpeering [post]
+func CreateVPCPeering(c echo.Context) error {
+	cblog.Info("call CreateVPCPeering()")
+	req := VPCPeeringCreateRequest{}
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	reqInfo := cres.VPCPeeringReqInfo{
+		IId:             cres.IID{NameId: req.ReqInfo.Name},
+		RequesterVpcIID: cres.IID{NameId: req.ReqInfo.RequesterVPCName},
+		AccepterVpcIID:  cres.IID{NameId: req.ReqInfo.AccepterVPCName},
+		TagList:         req.ReqInfo.TagList,
+	}
+
+	result, err := cmrt.CreateVPCPeering(req.ConnectionName, VPCPEERING, reqInfo, req.IDTransformMode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// AcceptVPCPeering godoc
+// @ID accept-vpcpeering
+// @Summary Accept VPC Peering
+// @Description Accept a VPC peering in the 'PendingAcceptance' status.
+// @Tags [VPC Peering Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body containing the Connection Name"
+// @Param Name path string true "The name of the VPC peering"
+// @Success 200 {object} cres.VPCPeeringInfo "Details of the accepted VPC peering"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpcpeering/{Name}/accept [put]
+func AcceptVPCPeering(c echo.Context) error {
+	cblog.Info("call AcceptVPCPeering()")
+	var req ConnectionRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	result, err := cmrt.AcceptVPCPeering(req.ConnectionName, VPCPEERING, c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// VPCPeeringListResponse is the response body for listing VPC peerings.
+type VPCPeeringListResponse struct {
+	Result []*cres.VPCPeeringInfo `json:"vpcpeering"`
+}
+
+// ListVPCPeering godoc
+// @ID list-vpcpeering
+// @Summary List VPC Peerings
+// @Description Retrieve a list of VPC peerings.
+// @Tags [VPC Peering Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body containing the Connection Name"
+// @Success 200 {object} restruntime.VPCPeeringListResponse "List of VPC peerings"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpcpeering [get]
+func ListVPCPeering(c echo.Context) error {
+	cblog.Info("call ListVPCPeering()")
+	var req ConnectionRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	infoList, err := cmrt.ListVPCPeering(req.ConnectionName, VPCPEERING)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if infoList == nil {
+		infoList = []*cres.VPCPeeringInfo{}
+	}
+	return c.JSON(http.StatusOK, &VPCPeeringListResponse{Result: infoList})
+}
+
+// GetVPCPeering godoc
+// @ID get-vpcpeering
+// @Summary Get VPC Peering
+// @Description Retrieve details of a specific VPC peering.
+// @Tags [VPC Peering Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body containing the Connection Name"
+// @Param Name path string true "The name of the VPC peering"
+// @Success 200 {object} cres.VPCPeeringInfo "Details of the VPC peering"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpcpeering/{Name} [get]
+func GetVPCPeering(c echo.Context) error {
+	cblog.Info("call GetVPCPeering()")
+	var req ConnectionRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	result, err := cmrt.GetVPCPeering(req.ConnectionName, VPCPEERING, c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// DeleteVPCPeering godoc
+// @ID delete-vpcpeering
+// @Summary Delete VPC Peering
+// @Description Delete a VPC peering.
+// @Tags [VPC Peering Management]
+// @Accept  json
+// @Produce  json
+// @Param ConnectionRequest body restruntime.ConnectionRequest true "Request body containing the Connection Name"
+// @Param Name path string true "The name of the VPC peering to delete"
+// @Param force query string false "Force delete the VPC peering. ex) true or false(default: false)"
+// @Success 200 {object} BooleanInfo "Result of the delete operation"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpcpeering/{Name} [delete]
+func DeleteVPCPeering(c echo.Context) error {
+	cblog.Info("call DeleteVPCPeering()")
+	var req ConnectionRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	result, err := cmrt.DeleteVPCPeering(req.ConnectionName, VPCPEERING, c.Param("Name"), c.QueryParam("force"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, &BooleanInfo{Result: strconv.FormatBool(result)})
+}
+
+// VPCPeeringRoutesRequest represents the request body for adding or removing the peering routes.
+type VPCPeeringRoutesRequest struct {
+	ConnectionName string `json:"ConnectionName" validate:"required" example:"aws-connection"`
+	ReqInfo        struct {
+		SubnetNames []string `json:"SubnetNames" validate:"required" example:"subnet-01,subnet-02"` // Subnets of the requester VPC or the accepter VPC
+	} `json:"ReqInfo" validate:"required"`
+}
+
+// AddVPCPeeringRoutes godoc
+// @ID add-vpcpeering-routes
+// @Summary Add VPC Peering Routes
+// @Description Add the routes to the peer VPC into the route tables of the Subnets. <br> * A Subnet can belong to the requester VPC or the accepter VPC. <br> * The VPC peering must be 'Active'.
+// @Tags [VPC Peering Management]
+// @Accept  json
+// @Produce  json
+// @Param VPCPeeringRoutesRequest body restruntime.VPCPeeringRoutesRequest true "Request body for adding the peering routes"
+// @Param Name path string true "The name of the VPC peering"
+// @Success 200 {object} cres.VPCPeeringInfo "Updated VPC peering info"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpcpeering/{Name}/routes [post]
+func AddVPCPeeringRoutes(c echo.Context) error {
+	cblog.Info("call AddVPCPeeringRoutes()")
+	req := VPCPeeringRoutesRequest{}
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	result, err := cmrt.AddVPCPeeringRoutes(req.ConnectionName, c.Param("Name"), req.ReqInfo.SubnetNames)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// RemoveVPCPeeringRoutes godoc
+// @ID remove-vpcpeering-routes
+// @Summary Remove VPC Peering Routes
+// @Description Remove the routes to the peer VPC from the route tables of the Subnets.
+// @Tags [VPC Peering Management]
+// @Accept  json
+// @Produce  json
+// @Param VPCPeeringRoutesRequest body restruntime.VPCPeeringRoutesRequest true "Request body for removing the peering routes"
+// @Param Name path string true "The name of the VPC peering"
+// @Success 200 {object} BooleanInfo "Result of the remove operation"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /vpcpeering/{Name}/routes [delete]
+func RemoveVPCPeeringRoutes(c echo.Context) error {
+	cblog.Info("call RemoveVPCPeeringRoutes()")
+	req := VPCPeeringRoutesRequest{}
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	result, err := cmrt.RemoveVPCPeeringRoutes(req.ConnectionName, c.Param("Name"), req.ReqInfo.SubnetNames)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, &BooleanInfo{Result: strconv.FormatBool(result)})
+}
+
+// CountAllVPCPeerings godoc
+// @ID count-all-vpcpeerings
+// @Summary Count All VPC Peerings
+// @Description Get the total number of VPC peerings registered across all connections.
+// @Tags [VPC Peering Management]
+// @Produce  json
+// @Success 200 {object} CountResponse "Total count of VPC peerings"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /countvpcpeering [get]
+func CountAllVPCPeerings(c echo.Context) error {
+	count, err := cmrt.CountAllVPCPeerings()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, CountResponse{Count: int(count)})
+}
+
+// CountVPCPeeringsByConnection godoc
+// @ID count-vpcpeering-by-connection
+// @Summary Count VPC Peerings by Connection
+// @Description Get the total number of VPC peerings for a specific connection.
+// @Tags [VPC Peering Management]
+// @Produce  json
+// @Param ConnectionName path string true "The name of the Connection"
+// @Success 200 {object} CountResponse "Total count of VPC peerings for the connection"
+// @Failure 500 {object} SimpleMsg "Internal Server Error"
+// @Router /countvpcpeering/{ConnectionName} [get]
+func CountVPCPeeringsByConnection(c echo.Context) error {
+	count, err := cmrt.CountVPCPeeringsByConnection(c.Param("ConnectionName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, CountResponse{Count: int(count)})
+}
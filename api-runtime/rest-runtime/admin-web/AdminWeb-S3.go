@@ -28,6 +28,8 @@ type S3BucketInfo struct {
 	CreationDate     string `json:"CreationDate"`
 	VersioningStatus string `json:"VersioningStatus"`
 	CORSStatus       string `json:"CORSStatus"`
+	EncryptionStatus string `json:"EncryptionStatus"`
+	ObjectLockStatus string `json:"ObjectLockStatus"`
 }
 type S3ObjectInfo struct {
 	ETag         string `json:"ETag"`
@@ -187,15 +189,19 @@ func fetchS3Buckets(connConfig string) ([]S3BucketInfo, error) {
 	for _, bucket := range xmlResult.Buckets.Bucket {
 		// Check if bucket exists in CSP (creation date "0001-01-01" means metadata-only)
 		// For metadata-only buckets, skip CSP API calls to avoid errors and improve performance
-		var versioningStatus, corsStatus string
+		var versioningStatus, corsStatus, encryptionStatus, objectLockStatus string
 		if bucket.CreationDate == "0001-01-01T00:00:00Z" || bucket.CreationDate == "1-01-01 09:00:00 KST" {
 			// Metadata-only bucket (not in CSP) - use default values without CSP API calls
 			versioningStatus = "Suspended"
 			corsStatus = "Not configured"
+			encryptionStatus = "Not configured"
+			objectLockStatus = "Disabled"
 		} else {
 			// Bucket exists in CSP - fetch actual status
 			versioningStatus = fetchVersioningStatus(connConfig, bucket.Name)
 			corsStatus = fetchCORSStatus(connConfig, bucket.Name)
+			encryptionStatus = fetchEncryptionStatus(connConfig, bucket.Name)
+			objectLockStatus = fetchObjectLockStatus(connConfig, bucket.Name)
 		}
 		result = append(result, S3BucketInfo{
 			Name:             bucket.Name,
@@ -203,6 +209,8 @@ func fetchS3Buckets(connConfig string) ([]S3BucketInfo, error) {
 			BucketRegion:     "", // Region info not available in standard S3 list buckets response
 			VersioningStatus: versioningStatus,
 			CORSStatus:       corsStatus,
+			EncryptionStatus: encryptionStatus,
+			ObjectLockStatus: objectLockStatus,
 		})
 	}
 
@@ -271,13 +279,125 @@ func fetchCORSStatus(connConfig, bucketName string) string {
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
+	if resp.StatusCode == http.StatusNotFound {
 		return "Not configured"
 	}
+	if resp.StatusCode != http.StatusOK {
+		return "Error"
+	}
 
 	return "Configured"
 }
 
+// fetchEncryptionStatus returns the SSE algorithm (AES256, aws:kms), "Not configured", "Not supported" or "Error"
+func fetchEncryptionStatus(connConfig, bucketName string) string {
+	client := &http.Client{}
+	req, err := http.NewRequest("GET", fmt.Sprintf("http://localhost:1024/spider/s3/%s?encryption", bucketName), nil)
+	if err != nil {
+		return "Error"
+	}
+	setBasicAuthIfConfigured(req)
+
+	q := req.URL.Query()
+	q.Add("ConnectionName", connConfig)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "Error"
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotImplemented {
+		return "Not supported"
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return "Not configured"
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "Error"
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "Error"
+	}
+
+	type ServerSideEncryptionConfiguration struct {
+		Rules []struct {
+			SSEAlgorithm string `xml:"ApplyServerSideEncryptionByDefault>SSEAlgorithm"`
+		} `xml:"Rule"`
+	}
+
+	var encryptionConfig ServerSideEncryptionConfiguration
+	if err := xml.Unmarshal(body, &encryptionConfig); err != nil {
+		return "Error"
+	}
+	if len(encryptionConfig.Rules) == 0 || encryptionConfig.Rules[0].SSEAlgorithm == "" {
+		return "Not configured"
+	}
+
+	return encryptionConfig.Rules[0].SSEAlgorithm
+}
+
+// fetchObjectLockStatus returns "Enabled" with the default retention if set, "Disabled", "Not supported" or "Error"
+func fetchObjectLockStatus(connConfig, bucketName string) string {
+	client := &http.Client{}
+	req, err := http.NewRequest("GET", fmt.Sprintf("http://localhost:1024/spider/s3/%s?object-lock", bucketName), nil)
+	if err != nil {
+		return "Error"
+	}
+	setBasicAuthIfConfigured(req)
+
+	q := req.URL.Query()
+	q.Add("ConnectionName", connConfig)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "Error"
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotImplemented {
+		return "Not supported"
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return "Disabled"
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "Error"
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "Error"
+	}
+
+	type ObjectLockConfiguration struct {
+		ObjectLockEnabled string `xml:"ObjectLockEnabled"`
+		Mode              string `xml:"Rule>DefaultRetention>Mode"`
+		Days              int    `xml:"Rule>DefaultRetention>Days"`
+		Years             int    `xml:"Rule>DefaultRetention>Years"`
+	}
+
+	var lockConfig ObjectLockConfiguration
+	if err := xml.Unmarshal(body, &lockConfig); err != nil {
+		return "Error"
+	}
+	if lockConfig.ObjectLockEnabled != "Enabled" {
+		return "Disabled"
+	}
+
+	switch {
+	case lockConfig.Mode != "" && lockConfig.Years > 0:
+		return fmt.Sprintf("Enabled (%s %dy)", lockConfig.Mode, lockConfig.Years)
+	case lockConfig.Mode != "":
+		return fmt.Sprintf("Enabled (%s %dd)", lockConfig.Mode, lockConfig.Days)
+	}
+	return "Enabled"
+}
+
 func min(a, b int) int {
 	if a < b {
 		return a
@@ -16,6 +16,9 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
+
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 
 	"github.com/labstack/echo/v4"
 )
@@ -25,7 +28,7 @@ import (
 type TopoNode struct {
 	ID       string `json:"id"`
 	Label    string `json:"label"`
-	Kind     string `json:"kind"`     // vpc, subnet, sg, vm, nic, privateip, publicip
+	Kind     string `json:"kind"`     // vpc, subnet, sg, vm, nic, privateip, publicip, natgateway, routetable
 	Parent   string `json:"parent"`   // compound parent node id
 	PrivateIP string `json:"privateIP,omitempty"`
 	PublicIP  string `json:"publicIP,omitempty"`
@@ -36,7 +39,7 @@ type TopoEdge struct {
 	Source string `json:"source"`
 	Target string `json:"target"`
 	Label  string `json:"label,omitempty"`
-	Kind   string `json:"kind,omitempty"` // nic-vm, nic-sg, nic-pub, vm-pub
+	Kind   string `json:"kind,omitempty"` // nic-vm, nic-sg, nic-pub, vm-pub, nat-pub, rt-subnet, rt-target
 }
 
 // buildTopologyData builds nodes and edges from all cloud resources.
@@ -181,6 +184,75 @@ func buildTopologyData(connConfig string) ([]TopoNode, []TopoEdge, error) {
 		}
 	}
 
+	// ---- NAT Gateways ----
+	nats, _ := fetchNATGateways(connConfig)
+	natNodeIDs := make(map[string]bool)
+	for _, nat := range nats {
+		natID := "nat-" + nat.IId.NameId
+		natNodeIDs[natID] = true
+		parentSubnet := ""
+		if nat.SubnetIID.NameId != "" {
+			parentSubnet = "subnet-" + nat.SubnetIID.NameId
+		}
+		nodes = append(nodes, TopoNode{
+			ID:        natID,
+			Label:     nat.IId.NameId,
+			Kind:      "natgateway",
+			Parent:    parentSubnet,
+			PrivateIP: nat.PrivateIP,
+			PublicIP:  nat.PublicIP,
+			Status:    string(nat.Status),
+		})
+		if nat.PublicIP != "" {
+			edges = append(edges, TopoEdge{Source: natID, Target: "pub-" + nat.PublicIP, Kind: "nat-pub"})
+		}
+	}
+
+	// ---- Route Tables ----
+	// Routes to InternetGateway and VPCPeering targets have no node, they are shown in the node label.
+	rts, _ := fetchRouteTables(connConfig)
+	for _, rt := range rts {
+		rtID := "rt-" + rt.IId.NameId
+		parentVPC := ""
+		if rt.VpcIID.NameId != "" {
+			parentVPC = "vpc-" + rt.VpcIID.NameId
+		}
+		label := rt.IId.NameId
+		for _, route := range rt.RouteList {
+			if route.TargetType == cres.RouteTargetInternetGateway || route.TargetType == cres.RouteTargetVPCPeering {
+				label += "\n" + route.DestinationCIDR + " → " + string(route.TargetType)
+			}
+		}
+		nodes = append(nodes, TopoNode{ID: rtID, Label: label, Kind: "routetable", Parent: parentVPC})
+
+		for _, sn := range rt.SubnetIIDs {
+			if sn.NameId != "" {
+				edges = append(edges, TopoEdge{Source: rtID, Target: "subnet-" + sn.NameId, Kind: "rt-subnet"})
+			}
+		}
+		for _, route := range rt.RouteList {
+			target := ""
+			switch route.TargetType {
+			case cres.RouteTargetNATGateway:
+				target = "nat-" + route.TargetIID.NameId
+				if !natNodeIDs[target] {
+					target = ""
+				}
+			case cres.RouteTargetNIC:
+				target = "nic-" + route.TargetIID.SystemId
+				if !nicNodeIDs[target] {
+					target = ""
+				}
+			}
+			if target != "" {
+				edges = append(edges, TopoEdge{
+					Source: rtID, Target: target,
+					Label: route.DestinationCIDR, Kind: "rt-target",
+				})
+			}
+		}
+	}
+
 	// ---- Public IPs (registered in Spider) ----
 	pips, _ := fetchPublicIPs(connConfig)
 	pubIPNodeIDs := make(map[string]bool)
@@ -213,6 +285,48 @@ func buildTopologyData(connConfig string) ([]TopoNode, []TopoEdge, error) {
 	return nodes, edges, nil
 }
 
+// fetchNATGateways fetches the NAT Gateways of a connection.
+func fetchNATGateways(connConfig string) ([]*cres.NATGatewayInfo, error) {
+	resBody, err := getResourceList_with_Connection_JsonByte(connConfig, "natgateway")
+	if err != nil {
+		return nil, fmt.Errorf("error fetching NAT Gateways: %v", err)
+	}
+
+	var info struct {
+		ResultList []*cres.NATGatewayInfo `json:"natgateway"`
+	}
+	if err := json.Unmarshal(resBody, &info); err != nil {
+		return nil, fmt.Errorf("error decoding NAT Gateways: %v", err)
+	}
+
+	sort.Slice(info.ResultList, func(i, j int) bool {
+		return info.ResultList[i].IId.NameId < info.ResultList[j].IId.NameId
+	})
+
+	return info.ResultList, nil
+}
+
+// fetchRouteTables fetches the route tables of a connection.
+func fetchRouteTables(connConfig string) ([]*cres.RouteTableInfo, error) {
+	resBody, err := getResourceList_with_Connection_JsonByte(connConfig, "routetable")
+	if err != nil {
+		return nil, fmt.Errorf("error fetching Route Tables: %v", err)
+	}
+
+	var info struct {
+		ResultList []*cres.RouteTableInfo `json:"routetable"`
+	}
+	if err := json.Unmarshal(resBody, &info); err != nil {
+		return nil, fmt.Errorf("error decoding Route Tables: %v", err)
+	}
+
+	sort.Slice(info.ResultList, func(i, j int) bool {
+		return info.ResultList[i].IId.NameId < info.ResultList[j].IId.NameId
+	})
+
+	return info.ResultList, nil
+}
+
 // TopologyManagement renders the topology visualization page.
 func TopologyManagement(c echo.Context) error {
 	connConfig := c.Param("ConnectConfig")
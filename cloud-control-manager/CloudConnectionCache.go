@@ -0,0 +1,282 @@
+// Cloud Driver Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// CloudConnection Cache — reuses the CloudConnections across requests,
+// so that the credential decryption and cldDriver.ConnectCloud() are not repeated on every request.
+//
+// by CB-Spider Team, 2026.10.
+
+package clouddriverhandler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	cblogger "github.com/cloud-barista/cb-log"
+	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
+	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
+	dim "github.com/cloud-barista/cb-spider/cloud-info-manager/driver-info-manager"
+	rim "github.com/cloud-barista/cb-spider/cloud-info-manager/region-info-manager"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// ====================================================================
+// SPIDER_CONNECTION_CACHE_TTL: how long a CloudConnection is reused. (default: 10m, 0: no cache)
+//
+//	The TTL must be shorter than the lifetime of the CSP tokens and contexts kept in the connections.
+//
+// SPIDER_CONNECTION_CACHE_SIZE: max number of the cached CloudConnections. (default: 256)
+//
+//	The least recently used connection is evicted when the cache is full.
+const CONNECTION_CACHE_TTL_ENV = "SPIDER_CONNECTION_CACHE_TTL"
+const CONNECTION_CACHE_SIZE_ENV = "SPIDER_CONNECTION_CACHE_SIZE"
+
+const defaultConnectionCacheTTL = 10 * time.Minute
+const defaultConnectionCacheSize = 256
+
+// CloudConnectionCacheStats represents the usage of the CloudConnection cache.
+type CloudConnectionCacheStats struct {
+	Enabled       bool   `json:"Enabled" example:"true"`
+	TTL           string `json:"TTL" example:"10m0s"`
+	MaxSize       int    `json:"MaxSize" example:"256"`
+	Size          int    `json:"Size" example:"12"`
+	Hits          uint64 `json:"Hits" example:"1024"`
+	Misses        uint64 `json:"Misses" example:"36"`
+	HitRatio      string `json:"HitRatio" example:"96.61%"`
+	Evictions     uint64 `json:"Evictions" example:"2"`     // evicted by the size bound
+	Expirations   uint64 `json:"Expirations" example:"20"`  // expired by the TTL
+	Invalidations uint64 `json:"Invalidations" example:"4"` // invalidated by the changes of the connection config, credential, region or driver
+}
+
+type cloudConnectionCacheEntry struct {
+	conn        icon.CloudConnection
+	fingerprint string
+	createdAt   time.Time
+	lastUsedAt  time.Time
+}
+
+type cloudConnectionCache struct {
+	lock    sync.Mutex
+	entries map[string]*cloudConnectionCacheEntry // "<connection name>/<target zone>" => entry
+	ttl     time.Duration
+	maxSize int
+
+	hits          uint64
+	misses        uint64
+	evictions     uint64
+	expirations   uint64
+	invalidations uint64
+}
+
+var connectionCache = newCloudConnectionCache()
+
+func newCloudConnectionCache() *cloudConnectionCache {
+	// cblog is not set yet at the package variable initialization
+	logger := cblogger.GetLogger("CLOUD-BARISTA")
+
+	cache := &cloudConnectionCache{
+		entries: map[string]*cloudConnectionCacheEntry{},
+		ttl:     defaultConnectionCacheTTL,
+		maxSize: defaultConnectionCacheSize,
+	}
+
+	if ttl := strings.TrimSpace(os.Getenv(CONNECTION_CACHE_TTL_ENV)); ttl != "" {
+		duration, err := time.ParseDuration(ttl)
+		if err != nil || duration < 0 {
+			logger.Errorf("invalid %s(%s), use the default value(%v)", CONNECTION_CACHE_TTL_ENV, ttl, defaultConnectionCacheTTL)
+		} else {
+			cache.ttl = duration
+		}
+	}
+	if size := strings.TrimSpace(os.Getenv(CONNECTION_CACHE_SIZE_ENV)); size != "" {
+		maxSize, err := strconv.Atoi(size)
+		if err != nil || maxSize <= 0 {
+			logger.Errorf("invalid %s(%s), use the default value(%d)", CONNECTION_CACHE_SIZE_ENV, size, defaultConnectionCacheSize)
+		} else {
+			cache.maxSize = maxSize
+		}
+	}
+	return cache
+}
+
+//====================================================================
+
+// getCachedCloudConnection returns the cached CloudConnection or a new CloudConnection.
+// A cached connection is reused only if the connection config, credential, region and driver
+// are not changed or deleted since the connection was made.
+func getCachedCloudConnection(cloudConnectName string, targetZoneName string) (icon.CloudConnection, error) {
+	if connectionCache.ttl <= 0 {
+		return commonGetCloudConnection(cloudConnectName, targetZoneName)
+	}
+
+	// a deleted connection config, credential, region or driver fails here
+	fingerprint, err := connectionFingerprint(cloudConnectName)
+	if err != nil {
+		InvalidateCloudConnectionCache(cloudConnectName)
+		return nil, err
+	}
+
+	key := cloudConnectName + "/" + targetZoneName
+	if conn, ok := connectionCache.get(key, fingerprint); ok {
+		return conn, nil
+	}
+
+	conn, err := commonGetCloudConnection(cloudConnectName, targetZoneName)
+	if err != nil {
+		return nil, err
+	}
+	connectionCache.put(key, fingerprint, conn)
+	return conn, nil
+}
+
+func (cache *cloudConnectionCache) get(key string, fingerprint string) (icon.CloudConnection, bool) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	entry, ok := cache.entries[key]
+	if !ok {
+		cache.misses++
+		return nil, false
+	}
+	if entry.fingerprint != fingerprint {
+		delete(cache.entries, key)
+		cache.invalidations++
+		cache.misses++
+		return nil, false
+	}
+	if time.Since(entry.createdAt) > cache.ttl {
+		delete(cache.entries, key)
+		cache.expirations++
+		cache.misses++
+		return nil, false
+	}
+
+	entry.lastUsedAt = time.Now()
+	cache.hits++
+	return entry.conn, true
+}
+
+// The evicted connections are not closed. They can be in use by other requests.
+func (cache *cloudConnectionCache) put(key string, fingerprint string, conn icon.CloudConnection) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	now := time.Now()
+	if _, ok := cache.entries[key]; !ok && len(cache.entries) >= cache.maxSize {
+		// remove the expired connections first
+		for k, entry := range cache.entries {
+			if now.Sub(entry.createdAt) > cache.ttl {
+				delete(cache.entries, k)
+				cache.expirations++
+			}
+		}
+		// remove the least recently used connection
+		if len(cache.entries) >= cache.maxSize {
+			var lruKey string
+			var lruTime time.Time
+			for k, entry := range cache.entries {
+				if lruKey == "" || entry.lastUsedAt.Before(lruTime) {
+					lruKey, lruTime = k, entry.lastUsedAt
+				}
+			}
+			delete(cache.entries, lruKey)
+			cache.evictions++
+		}
+	}
+
+	cache.entries[key] = &cloudConnectionCacheEntry{
+		conn:        conn,
+		fingerprint: fingerprint,
+		createdAt:   now,
+		lastUsedAt:  now,
+	}
+}
+
+// connectionFingerprint returns the hash of the stored connection config, credential, region and driver.
+// The credential is hashed as stored(encrypted), not decrypted.
+func connectionFingerprint(cloudConnectName string) (string, error) {
+	cccInfo, err := ccim.GetConnectionConfig(cloudConnectName)
+	if err != nil {
+		return "", err
+	}
+
+	cldDrvInfo, err := dim.GetCloudDriver(cccInfo.DriverName)
+	if err != nil {
+		return "", err
+	}
+
+	var crdInfo cim.CredentialInfo
+	err = infostore.Get(&crdInfo, cim.KEY_COLUMN_NAME, cccInfo.CredentialName)
+	if err != nil {
+		return "", err
+	}
+
+	rgnInfo, err := rim.GetRegion(cccInfo.RegionName)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal([]interface{}{cccInfo, cldDrvInfo, crdInfo, rgnInfo})
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// InvalidateCloudConnectionCache removes the cached CloudConnections of the connection config(all target zones).
+func InvalidateCloudConnectionCache(cloudConnectName string) {
+	connectionCache.lock.Lock()
+	defer connectionCache.lock.Unlock()
+
+	prefix := cloudConnectName + "/"
+	for key := range connectionCache.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(connectionCache.entries, key)
+			connectionCache.invalidations++
+		}
+	}
+}
+
+// ClearCloudConnectionCache removes all cached CloudConnections.
+func ClearCloudConnectionCache() {
+	connectionCache.lock.Lock()
+	defer connectionCache.lock.Unlock()
+
+	connectionCache.invalidations += uint64(len(connectionCache.entries))
+	connectionCache.entries = map[string]*cloudConnectionCacheEntry{}
+}
+
+// GetCloudConnectionCacheStats returns the usage of the CloudConnection cache.
+func GetCloudConnectionCacheStats() CloudConnectionCacheStats {
+	connectionCache.lock.Lock()
+	defer connectionCache.lock.Unlock()
+
+	hitRatio := "0.00%"
+	if total := connectionCache.hits + connectionCache.misses; total > 0 {
+		hitRatio = strconv.FormatFloat(float64(connectionCache.hits)*100/float64(total), 'f', 2, 64) + "%"
+	}
+
+	return CloudConnectionCacheStats{
+		Enabled:       connectionCache.ttl > 0,
+		TTL:           connectionCache.ttl.String(),
+		MaxSize:       connectionCache.maxSize,
+		Size:          len(connectionCache.entries),
+		Hits:          connectionCache.hits,
+		Misses:        connectionCache.misses,
+		HitRatio:      hitRatio,
+		Evictions:     connectionCache.evictions,
+		Expirations:   connectionCache.expirations,
+		Invalidations: connectionCache.invalidations,
+	}
+}
@@ -79,7 +79,7 @@ func GetCloudConnection(cloudConnectName string) (icon.CloudConnection, error) {
 	var err error
 
 	for i := 0; i < 3; i++ {
-		conn, err = commonGetCloudConnection(cloudConnectName, "")
+		conn, err = getCachedCloudConnection(cloudConnectName, "")
 		if err == nil {
 			return conn, nil
 		}
@@ -98,7 +98,7 @@ func GetZoneLevelCloudConnection(cloudConnectName string, targetZoneName string)
 	var err error
 
 	for i := 0; i < 3; i++ {
-		conn, err = commonGetCloudConnection(cloudConnectName, targetZoneName)
+		conn, err = getCachedCloudConnection(cloudConnectName, targetZoneName)
 		if err == nil {
 			return conn, nil
 		}
@@ -142,6 +142,7 @@ func createConnectionInfo(cloudConnectName string, targetZoneName string) (idrv.
 	}
 
 	// Get decrypted credential information
+	// Secret references(ex: vault://path#key) are resolved at connect time, with short-lived caching.
 	crdInfo, err := cim.GetCredentialDecrypt(cccInfo.CredentialName)
 	if err != nil {
 		return idrv.ConnectionInfo{}, err
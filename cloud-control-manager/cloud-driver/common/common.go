@@ -106,7 +106,7 @@ func init() {
 
 func AddKey(providerName string, hashString string, keyPairNameId string, privateKey string) error {
 
-	encPrivateKey, err := enc.Encrypt(enc.SPIDER_KEY, []byte(privateKey))
+	encPrivateKey, err := enc.EncryptWithMasterKey([]byte(privateKey))
 	if err != nil {
 		return err
 	}
@@ -132,7 +132,7 @@ func ListKey(providerName string, hashString string) ([]*irs.KeyValue, error) {
 	var keyValueList []*irs.KeyValue
 	for _, iidInfo := range iidInfoList {
 
-		decPrivateKey, err := enc.Decrypt(enc.SPIDER_KEY, []byte(iidInfo.PrivateKey))
+		decPrivateKey, err := enc.DecryptWithMasterKey([]byte(iidInfo.PrivateKey))
 		if err != nil {
 			return nil, err
 		}
@@ -157,7 +157,7 @@ func GetKey(providerName string, hashString string, keyPairNameId string) (*irs.
 		return nil, err
 	}
 
-	decPrivateKey, err := enc.Decrypt(enc.SPIDER_KEY, []byte(localKeyInfo.PrivateKey))
+	decPrivateKey, err := enc.DecryptWithMasterKey([]byte(localKeyInfo.PrivateKey))
 	if err != nil {
 		return nil, err
 	}
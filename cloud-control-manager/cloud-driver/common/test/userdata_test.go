@@ -0,0 +1,84 @@
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package validatetest
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	cdcom "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/common"
+)
+
+func TestValidateUserData(t *testing.T) {
+	script := "#!/bin/bash\necho hello\n"
+
+	validList := []string{
+		"",
+		script,
+		"#cloud-config\npackages:\n  - nginx\n",
+		base64.StdEncoding.EncodeToString([]byte(script)),
+	}
+	for _, userData := range validList {
+		if err := cdcom.ValidateUserData(userData); err != nil {
+			t.Errorf("%q: expected valid, got %v", userData, err)
+		}
+	}
+
+	invalidList := []string{
+		"echo hello",
+		"#!/bin/bash\n" + strings.Repeat("a", cdcom.MaxUserDataSize),
+	}
+	for _, userData := range invalidList {
+		if err := cdcom.ValidateUserData(userData); err == nil {
+			t.Errorf("%.20q: expected invalid, got nil", userData)
+		}
+	}
+}
+
+func TestMergeUserData(t *testing.T) {
+	spiderInit := "#cloud-config\nusers:\n  - name: cb-user\n"
+	userData := "#!/bin/bash\necho hello\n"
+
+	merged, err := cdcom.MergeUserData(spiderInit, "")
+	if err != nil || merged != spiderInit {
+		t.Errorf("empty UserData: expected Spider's init script as is, got %q, %v", merged, err)
+	}
+
+	merged, err = cdcom.MergeUserData(spiderInit, base64.StdEncoding.EncodeToString([]byte(userData)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(merged, "multipart/mixed") ||
+		!strings.Contains(merged, "text/cloud-config") || !strings.Contains(merged, "text/x-shellscript") {
+		t.Errorf("expected multi-part archive, got %q", merged)
+	}
+	if strings.Index(merged, "cb-user") > strings.Index(merged, "echo hello") {
+		t.Errorf("Spider's init script must run before user's UserData")
+	}
+
+	if _, err = cdcom.MergeUserData("<powershell>\n</powershell>", userData); err == nil {
+		t.Errorf("expected error for Windows init script, got nil")
+	}
+}
+
+func TestAppendUserDataScript(t *testing.T) {
+	spiderInit := "#!/bin/bash\nuseradd cb-user\n"
+
+	script, err := cdcom.AppendUserDataScript(spiderInit, "#!/bin/bash\necho hello\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(script, spiderInit) || !strings.Contains(script, "echo hello") {
+		t.Errorf("unexpected script: %q", script)
+	}
+
+	if _, err = cdcom.AppendUserDataScript(spiderInit, "#cloud-config\n"); err == nil {
+		t.Errorf("expected error for cloud-config, got nil")
+	}
+}
@@ -0,0 +1,180 @@
+// common package of CB-Spider's Cloud Drivers
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package common
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+	"unicode/utf8"
+)
+
+// Max size of user's UserData after base64 decoding.
+// 16KB is the smallest limit among CSPs(AWS, Alibaba, Tencent, ...).
+const MaxUserDataSize = 16 * 1024
+
+// DecodeUserData returns the plain text of user's UserData.
+// UserData can be plain text or base64 encoded text.
+func DecodeUserData(userData string) (string, error) {
+	trimmed := strings.TrimSpace(userData)
+	if trimmed == "" {
+		return "", nil
+	}
+
+	// plain text script or cloud-config
+	if strings.HasPrefix(trimmed, "#") {
+		return userData, nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(trimmed)
+	if err != nil || !utf8.Valid(decoded) {
+		// not base64, use as is
+		return userData, nil
+	}
+	return string(decoded), nil
+}
+
+// ValidateUserData checks the size and format of user's UserData.
+func ValidateUserData(userData string) error {
+	decoded, err := DecodeUserData(userData)
+	if err != nil {
+		return err
+	}
+	if len(decoded) > MaxUserDataSize {
+		return fmt.Errorf("UserData size(%d bytes) exceeds the max size(%d bytes)", len(decoded), MaxUserDataSize)
+	}
+	if decoded == "" {
+		return nil
+	}
+	if _, err := userDataContentType(decoded); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MergeUserData merges Spider's cloud-init script and user's UserData into one
+// cloud-init multi-part archive, so that cb-user setup runs before user's UserData.
+// If user's UserData is empty, Spider's cloud-init script is returned as is.
+//
+// ex) userData, err := MergeUserData(string(fileDataCloudInit), vmReqInfo.UserData)
+func MergeUserData(spiderInit string, userData string) (string, error) {
+	decoded, err := DecodeUserData(userData)
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(decoded) == "" {
+		return spiderInit, nil
+	}
+	if len(decoded) > MaxUserDataSize {
+		return "", fmt.Errorf("UserData size(%d bytes) exceeds the max size(%d bytes)", len(decoded), MaxUserDataSize)
+	}
+
+	userType, err := userDataContentType(decoded)
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(spiderInit) == "" {
+		return decoded, nil
+	}
+	spiderType, err := userDataContentType(spiderInit)
+	if err != nil {
+		// ex) Windows <powershell> script
+		return "", fmt.Errorf("UserData can not be merged with this VM's init script: %v", err)
+	}
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	buf.WriteString("Content-Type: multipart/mixed; boundary=\"" + writer.Boundary() + "\"\n")
+	buf.WriteString("MIME-Version: 1.0\n\n")
+
+	parts := []struct {
+		contentType string
+		fileName    string
+		body        string
+	}{
+		{spiderType, "cb-spider-init", spiderInit},
+		{userType, "user-data", decoded},
+	}
+	for _, part := range parts {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part.contentType+"; charset=\"us-ascii\"")
+		header.Set("MIME-Version", "1.0")
+		header.Set("Content-Transfer-Encoding", "7bit")
+		header.Set("Content-Disposition", "attachment; filename=\""+part.fileName+"\"")
+		w, err := writer.CreatePart(header)
+		if err != nil {
+			return "", err
+		}
+		if _, err := w.Write([]byte(part.body)); err != nil {
+			return "", err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// AppendUserDataScript appends user's UserData shell script to Spider's init script.
+// It is used for CSPs that run the init script as a plain shell script, not by cloud-init.
+// User's script is saved as a file and executed after Spider's init script.
+func AppendUserDataScript(spiderInit string, userData string) (string, error) {
+	decoded, err := DecodeUserData(userData)
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(decoded) == "" {
+		return spiderInit, nil
+	}
+	if len(decoded) > MaxUserDataSize {
+		return "", fmt.Errorf("UserData size(%d bytes) exceeds the max size(%d bytes)", len(decoded), MaxUserDataSize)
+	}
+	if !strings.HasPrefix(strings.TrimLeft(decoded, " \t\r\n"), "#!") {
+		return "", fmt.Errorf("unsupported UserData format: only shell script('#!') is supported on this CSP")
+	}
+
+	const userDataPath = "/var/lib/cb-spider-user-data"
+	const eofMark = "CB_SPIDER_USER_DATA_EOF"
+	if strings.Contains(decoded, eofMark) {
+		return "", fmt.Errorf("UserData can not include the reserved word '%s'", eofMark)
+	}
+
+	script := strings.TrimRight(spiderInit, "\n") + "\n\n" +
+		"#### run user's UserData\n" +
+		"cat > " + userDataPath + " <<'" + eofMark + "'\n" +
+		strings.TrimRight(decoded, "\n") + "\n" +
+		eofMark + "\n" +
+		"chmod 700 " + userDataPath + "\n" +
+		userDataPath + "\n"
+
+	return script, nil
+}
+
+// userDataContentType returns the cloud-init part type of the given UserData.
+func userDataContentType(userData string) (string, error) {
+	trimmed := strings.TrimLeft(userData, " \t\r\n")
+	switch {
+	case strings.HasPrefix(trimmed, "#!"):
+		return "text/x-shellscript", nil
+	case strings.HasPrefix(trimmed, "#cloud-config"):
+		return "text/cloud-config", nil
+	case strings.HasPrefix(trimmed, "#cloud-boothook"):
+		return "text/cloud-boothook", nil
+	case strings.HasPrefix(trimmed, "#include"):
+		return "text/x-include-url", nil
+	case strings.HasPrefix(trimmed, "#part-handler"):
+		return "text/part-handler", nil
+	}
+	return "", fmt.Errorf("unsupported UserData format: UserData must start with '#!', '#cloud-config', '#cloud-boothook', '#include' or '#part-handler'")
+}
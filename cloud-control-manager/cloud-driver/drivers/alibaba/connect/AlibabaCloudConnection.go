@@ -125,6 +125,22 @@ func (cloudConn *AlibabaCloudConnection) CreateNICHandler() (irs.NICHandler, err
 	return &handler, nil
 }
 
+func (cloudConn *AlibabaCloudConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, errors.New("Alibaba Cloud Driver: VPCPeeringHandler not supported")
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, errors.New("Alibaba Cloud Driver: RouteTableHandler not supported")
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, errors.New("Alibaba Cloud Driver: NATGatewayHandler not supported")
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, errors.New("Alibaba Cloud Driver: DiskSnapshotHandler not supported")
+}
+
 func (cloudConn *AlibabaCloudConnection) CreateVMHandler() (irs.VMHandler, error) {
 	cblogger.Info("Alibaba Cloud Driver: called CreateVMHandler()!")
 	vmHandler := alirs.AlibabaVMHandler{cloudConn.Region, cloudConn.VMClient, cloudConn.VpcClient}
@@ -653,6 +653,38 @@ func (handler *AlibabaRDBMSHandler) DeleteRDBMS(rdbmsIID irs.IID) (bool, error)
 	return true, nil
 }
 
+func (handler *AlibabaRDBMSHandler) ChangeSpec(rdbmsIID irs.IID, newSpec string) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("Alibaba Cloud Driver Does not support ChangeSpec() yet!!")
+}
+
+func (handler *AlibabaRDBMSHandler) ChangeStorageSize(rdbmsIID irs.IID, newSize string) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("Alibaba Cloud Driver Does not support ChangeStorageSize() yet!!")
+}
+
+func (handler *AlibabaRDBMSHandler) StartRDBMS(rdbmsIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("Alibaba Cloud Driver Does not support StartRDBMS() yet!!")
+}
+
+func (handler *AlibabaRDBMSHandler) StopRDBMS(rdbmsIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("Alibaba Cloud Driver Does not support StopRDBMS() yet!!")
+}
+
+func (handler *AlibabaRDBMSHandler) CreateSnapshot(rdbmsIID irs.IID, snapshotName string) (irs.RDBMSSnapshotInfo, error) {
+	return irs.RDBMSSnapshotInfo{}, fmt.Errorf("Alibaba Cloud Driver Does not support CreateSnapshot() yet!!")
+}
+
+func (handler *AlibabaRDBMSHandler) ListSnapshot(rdbmsIID irs.IID) ([]*irs.RDBMSSnapshotInfo, error) {
+	return nil, fmt.Errorf("Alibaba Cloud Driver Does not support ListSnapshot() yet!!")
+}
+
+func (handler *AlibabaRDBMSHandler) DeleteSnapshot(snapshotIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("Alibaba Cloud Driver Does not support DeleteSnapshot() yet!!")
+}
+
+func (handler *AlibabaRDBMSHandler) RestoreSnapshot(snapshotIID irs.IID, rdbmsReqInfo irs.RDBMSInfo) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("Alibaba Cloud Driver Does not support RestoreSnapshot() yet!!")
+}
+
 // ===== Helper Functions =====
 
 func (handler *AlibabaRDBMSHandler) getDBInstanceAttribute(dbInstanceId string) (irs.RDBMSInfo, error) {
@@ -35,6 +35,11 @@ const (
 )
 
 func (securityHandler *AlibabaSecurityHandler) CreateSecurity(securityReqInfo irs.SecurityReqInfo) (irs.SecurityInfo, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityReqInfo.SecurityRules, false, false, false); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	cblogger.Infof("securityReqInfo : ", securityReqInfo)
 	//cblogger.Debug(securityReqInfo)
 
@@ -149,6 +154,11 @@ func (securityHandler *AlibabaSecurityHandler) CreateSecurity(securityReqInfo ir
 // func (securityHandler *AlibabaSecurityHandler) AuthorizeSecurityRules(securityGroupId string, vpcId string, securityRuleInfos *[]irs.SecurityRuleInfo) (*[]irs.SecurityRuleInfo, error) {
 // func (securityHandler *AlibabaSecurityHandler) AuthorizeSecurityRules(securityGroupId string, securityRuleInfos *[]irs.SecurityRuleInfo) (*[]irs.SecurityRuleInfo, error) {
 func (securityHandler *AlibabaSecurityHandler) AddRules(securityIID irs.IID, reqSecurityRules *[]irs.SecurityRuleInfo) (irs.SecurityInfo, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(reqSecurityRules, false, false, false); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	securityGroupId := securityIID.SystemId
 	cblogger.Infof("securityGroupId : [%s]  / securityRuleInfos : [%v]", securityGroupId, reqSecurityRules)
 	//cblogger.Info("AuthorizeSecurityRules ", securityRuleInfos)
@@ -228,6 +238,11 @@ func (securityHandler *AlibabaSecurityHandler) AddRules(securityIID irs.IID, req
 // If the security group rule to be deleted does not exist, the RevokeSecurityGroup operation succeeds but no rule is deleted.
 // func (securityHandler *AlibabaSecurityHandler) RevokeSecurityRules(securityGroupId string, securityRuleInfos *[]irs.SecurityRuleInfo) (*[]irs.SecurityRuleInfo, error) {
 func (securityHandler *AlibabaSecurityHandler) RemoveRules(securityIID irs.IID, reqSecurityRules *[]irs.SecurityRuleInfo) (bool, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(reqSecurityRules, false, false, false); err != nil {
+		return false, err
+	}
+
 	securityGroupId := securityIID.SystemId
 	cblogger.Infof("securityGroupId : [%s]  / securityRuleInfos : [%v]", securityGroupId, reqSecurityRules)
 	cblogger.Debug(reqSecurityRules)
@@ -168,7 +168,15 @@ func (vmHandler *AlibabaVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 		cblogger.Error(err)
 		return irs.VMInfo{}, err
 	}
-	userData := string(fileDataCloudInit)
+	if isWindows && vmReqInfo.UserData != "" {
+		return irs.VMInfo{}, errors.New("UserData is not supported for Windows VM")
+	}
+	// merge user's UserData with Spider's cloud-init
+	userData, err := cdcom.MergeUserData(string(fileDataCloudInit), vmReqInfo.UserData)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VMInfo{}, err
+	}
 	//userData = strings.ReplaceAll(userData, "{{username}}", CBDefaultVmUserName)
 	//userData = strings.ReplaceAll(userData, "{{public_key}}", keyPairInfo.PublicKey)
 	userDataBase64 := base64.StdEncoding.EncodeToString([]byte(userData))
@@ -196,6 +204,20 @@ func (vmHandler *AlibabaVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 	request.Scheme = "https"
 
 	request.InstanceChargeType = "PostPaid" //저렴한 실시간 요금으로 설정 //PrePaid: subscription.  / PostPaid: pay-as-you-go. Default value: PostPaid.
+
+	// Preemptible(Spot) Instance 처리 - PostPaid만 지원
+	if vmReqInfo.PurchaseOption == irs.SpotVM {
+		request.SpotStrategy = "SpotAsPriceGo" // 시장 가격으로 자동 입찰(최대 On-Demand 가격)
+		if vmReqInfo.SpotMaxPrice != "" {
+			spotPriceLimit, err := strconv.ParseFloat(vmReqInfo.SpotMaxPrice, 64)
+			if err != nil || spotPriceLimit <= 0 {
+				return irs.VMInfo{}, errors.New("invalid SpotMaxPrice : " + vmReqInfo.SpotMaxPrice)
+			}
+			request.SpotStrategy = "SpotWithPriceLimit"
+			request.SpotPriceLimit = requests.NewFloat(spotPriceLimit)
+		}
+		request.SpotInterruptionBehavior = "Stop" // 회수시 Release 대신 Stop 처리(Interrupted 상태 조회를 위함)
+	}
 	request.ImageId = vmReqInfo.ImageIID.SystemId
 	//request.SecurityGroupIds *[]string
 	request.SecurityGroupIds = &newSecurityGroupIds
@@ -745,6 +767,43 @@ func (vmHandler *AlibabaVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, err
 	return irs.VMStatus("Terminating"), nil
 }
 
+// ChangeVMSpec changes the instance type of the stopped pay-as-you-go(PostPaid) instance.
+func (vmHandler *AlibabaVMHandler) ChangeVMSpec(vmIID irs.IID, vmSpecName string) (irs.VMInfo, error) {
+	cblogger.Infof("vmID : [%s] / vmSpecName : [%s]", vmIID.SystemId, vmSpecName)
+
+	request := ecs.CreateModifyInstanceSpecRequest()
+	request.Scheme = "https"
+	request.InstanceId = vmIID.SystemId
+	request.InstanceType = vmSpecName
+
+	// logger for HisCall
+	callogger := call.GetLogger("HISCALL")
+	callLogInfo := call.CLOUDLOGSCHEMA{
+		CloudOS:      call.ALIBABA,
+		RegionZone:   vmHandler.Region.Zone,
+		ResourceType: call.VM,
+		ResourceName: vmIID.SystemId,
+		CloudOSAPI:   "ModifyInstanceSpec()",
+		ElapsedTime:  "",
+		ErrorMSG:     "",
+	}
+
+	callLogStart := call.Start()
+	response, err := vmHandler.Client.ModifyInstanceSpec(request)
+	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+
+	if err != nil {
+		callLogInfo.ErrorMSG = err.Error()
+		callogger.Error(call.String(callLogInfo))
+		cblogger.Error(err.Error())
+		return irs.VMInfo{}, err
+	}
+	callogger.Debug(call.String(callLogInfo))
+	cblogger.Debug(response)
+
+	return vmHandler.GetVM(vmIID)
+}
+
 func (vmHandler *AlibabaVMHandler) GetVM(vmIID irs.IID) (irs.VMInfo, error) {
 	cblogger.Infof("vmID : [%s]", vmIID.SystemId)
 
@@ -823,6 +882,12 @@ func (vmHandler *AlibabaVMHandler) ExtractDescribeInstances(instanceInfo *ecs.In
 
 		KeyValueList: []irs.KeyValue{{Key: "", Value: ""}},
 	}
+
+	vmInfo.PurchaseOption = irs.OnDemandVM
+	if instanceInfo.SpotStrategy != "" && instanceInfo.SpotStrategy != "NoSpot" {
+		vmInfo.PurchaseOption = irs.SpotVM
+	}
+
 	tagList := []irs.KeyValue{}
 
 	for _, aliTag := range instanceInfo.Tags.Tag {
@@ -1128,12 +1193,33 @@ func (vmHandler *AlibabaVMHandler) GetVMStatus(vmIID irs.IID) (irs.VMStatus, err
 			cblogger.Error(errStatus.Error())
 			return irs.VMStatus("Failed"), errStatus
 		}
+
+		// 회수된 Preemptible Instance 확인
+		if vmStatus == irs.Suspended {
+			instanceInfo, err := DescribeInstanceById(vmHandler.Client, vmHandler.Region, vmIID)
+			if err == nil && isInterruptedSpotInstance(instanceInfo) {
+				return irs.Interrupted, nil
+			}
+		}
 		return vmStatus, errStatus
 	}
 
 	return irs.VMStatus("Failed"), errors.New("No status information found.")
 }
 
+// isInterruptedSpotInstance returns true if the Preemptible(Spot) Instance is stopped by reclaim of Alibaba.
+func isInterruptedSpotInstance(instanceInfo ecs.Instance) bool {
+	if instanceInfo.SpotStrategy == "" || instanceInfo.SpotStrategy == "NoSpot" {
+		return false
+	}
+	for _, lockReason := range instanceInfo.OperationLocks.LockReason {
+		if strings.EqualFold(lockReason.LockReason, "Recycling") {
+			return true
+		}
+	}
+	return false
+}
+
 //알리 클라우드 라이프 사이클 : https://www.alibabacloud.com/help/doc-detail/25380.htm
 /*
 const (
@@ -1227,6 +1313,13 @@ func (vmHandler *AlibabaVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
 			cblogger.Error(errStatus.Error())
 			return nil, errStatus
 		}
+		// 회수된 Preemptible Instance 확인
+		if vmStatus == irs.Suspended {
+			instanceInfo, err := DescribeInstanceById(vmHandler.Client, vmHandler.Region, irs.IID{SystemId: vm.InstanceId})
+			if err == nil && isInterruptedSpotInstance(instanceInfo) {
+				vmStatus = irs.Interrupted
+			}
+		}
 		curVmStatusInfo := irs.VMStatusInfo{IId: irs.IID{SystemId: vm.InstanceId}, VmStatus: vmStatus}
 		vmInfoList = append(vmInfoList, &curVmStatusInfo)
 	}
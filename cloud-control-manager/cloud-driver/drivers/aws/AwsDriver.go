@@ -84,6 +84,19 @@ func (AwsDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	drvCapabilityInfo.TagSupportResourceType = []ires.RSType{ires.VPC, ires.SUBNET, ires.SG, ires.KEY, ires.VM, ires.NLB, ires.DISK, ires.MYIMAGE, ires.CLUSTER, ires.FILESYSTEM}
 
 	drvCapabilityInfo.VPC_CIDR = true
+	drvCapabilityInfo.VM_USER_DATA = true
+	drvCapabilityInfo.SPOT_VM = true
+	drvCapabilityInfo.VM_SPEC_CHANGE = true
+	drvCapabilityInfo.VM_SPEC_CHANGE_NEEDS_STOP = true
+
+	drvCapabilityInfo.SG_RULE_DESCRIPTION = true
+	drvCapabilityInfo.SG_RULE_IPV6_CIDR = true
+	drvCapabilityInfo.SG_RULE_SOURCE_SG = true
+
+	drvCapabilityInfo.NLB_MULTI_LISTENER = false
+	drvCapabilityInfo.NLB_HTTPS_CERTIFICATE = false
+	drvCapabilityInfo.NLB_HEALTH_CHECK_PATH = true
+	drvCapabilityInfo.NLB_HTTP_PROTOCOL = false
 
 	return drvCapabilityInfo
 }
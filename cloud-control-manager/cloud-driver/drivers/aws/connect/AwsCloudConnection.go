@@ -11,6 +11,8 @@
 package connect
 
 import (
+	"errors"
+
 	cblog "github.com/cloud-barista/cb-log"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 
@@ -154,6 +156,22 @@ func (cloudConn *AwsCloudConnection) CreateNICHandler() (irs.NICHandler, error)
 	return &handler, nil
 }
 
+func (cloudConn *AwsCloudConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, errors.New("AWS Driver: VPCPeeringHandler not supported")
+}
+
+func (cloudConn *AwsCloudConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, errors.New("AWS Driver: RouteTableHandler not supported")
+}
+
+func (cloudConn *AwsCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, errors.New("AWS Driver: NATGatewayHandler not supported")
+}
+
+func (cloudConn *AwsCloudConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, errors.New("AWS Driver: DiskSnapshotHandler not supported")
+}
+
 func (cloudConn *AwsCloudConnection) CreateVMSpecHandler() (irs.VMSpecHandler, error) {
 	handler := ars.AwsVmSpecHandler{Region: cloudConn.Region, Client: cloudConn.VmSpecClient}
 	return &handler, nil
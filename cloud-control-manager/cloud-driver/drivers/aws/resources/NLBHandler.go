@@ -223,6 +223,11 @@ func (NLBHandler *AwsNLBHandler) CreateTargetGroup(nlbReqInfo irs.NLBInfo) (*elb
 		input.HealthCheckTimeoutSeconds = aws.Int64(int64(nlbReqInfo.HealthChecker.Timeout))
 	}
 
+	// Path 설정 - HTTP, HTTPS 헬스체크만 지원
+	if nlbReqInfo.HealthChecker.Path != "" {
+		input.HealthCheckPath = aws.String(nlbReqInfo.HealthChecker.Path)
+	}
+
 	// Threshold 설정
 	if nlbReqInfo.HealthChecker.Threshold > 0 {
 		input.HealthyThresholdCount = aws.Int64(int64(nlbReqInfo.HealthChecker.Threshold))
@@ -929,6 +934,9 @@ func (NLBHandler *AwsNLBHandler) ExtractVMGroupInfo(nlbIID irs.IID) (TargetGroup
 			Timeout:   int(*result.TargetGroups[0].HealthCheckTimeoutSeconds),
 			Threshold: int(*result.TargetGroups[0].HealthyThresholdCount),
 		}
+		if result.TargetGroups[0].HealthCheckPath != nil {
+			targetGroupInfo.HealthChecker.Path = *result.TargetGroups[0].HealthCheckPath
+		}
 
 		//================
 		//Key Value 처리
@@ -1728,6 +1736,11 @@ func (NLBHandler *AwsNLBHandler) ChangeHealthCheckerInfo(nlbIID irs.IID, healthC
 		input.HealthCheckTimeoutSeconds = aws.Int64(int64(healthChecker.Timeout))
 	}
 
+	// Path 설정 - HTTP, HTTPS 헬스체크만 지원
+	if healthChecker.Path != "" {
+		input.HealthCheckPath = aws.String(healthChecker.Path)
+	}
+
 	// Threshold 설정
 	if healthChecker.Threshold > 0 {
 		input.HealthyThresholdCount = aws.Int64(int64(healthChecker.Threshold))
@@ -141,6 +141,10 @@ func (handler *AwsRDBMSHandler) GetMetaInfo(dbEngine string) (irs.RDBMSMetaInfo,
 	if err != nil {
 		return irs.RDBMSMetaInfo{}, err
 	}
+	metaInfo.SupportsChangeSpec = true
+	metaInfo.SupportsChangeStorageSize = true
+	metaInfo.SupportsStartStop = true
+	metaInfo.SupportsSnapshot = true
 
 	hiscallInfo.ElapsedTime = call.Elapsed(start)
 	calllogger.Info(call.String(hiscallInfo))
@@ -556,6 +560,243 @@ func (handler *AwsRDBMSHandler) DeleteRDBMS(rdbmsIID irs.IID) (bool, error) {
 	return true, nil
 }
 
+// ChangeSpec changes the DB instance class of an RDBMS instance.
+// The change is applied immediately, so the instance is unavailable during the modification.
+func (handler *AwsRDBMSHandler) ChangeSpec(rdbmsIID irs.IID, newSpec string) (irs.RDBMSInfo, error) {
+	hiscallInfo := GetCallLogScheme(handler.Region, call.RDBMS, rdbmsIID.NameId, "ModifyDBInstance()")
+	start := call.Start()
+
+	if newSpec == "" {
+		return irs.RDBMSInfo{}, errors.New("DBInstanceSpec is required")
+	}
+
+	input := &rds.ModifyDBInstanceInput{
+		DBInstanceIdentifier: aws.String(rdbmsIID.SystemId),
+		DBInstanceClass:      aws.String(newSpec),
+		ApplyImmediately:     aws.Bool(true),
+	}
+	result, err := handler.Client.ModifyDBInstance(input)
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return irs.RDBMSInfo{}, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	return handler.convertDBInstanceToRDBMSInfo(result.DBInstance), nil
+}
+
+// ChangeStorageSize expands the allocated storage of an RDBMS instance.
+// AWS RDS does not allow shrinking the storage.
+func (handler *AwsRDBMSHandler) ChangeStorageSize(rdbmsIID irs.IID, newSize string) (irs.RDBMSInfo, error) {
+	hiscallInfo := GetCallLogScheme(handler.Region, call.RDBMS, rdbmsIID.NameId, "ModifyDBInstance()")
+	start := call.Start()
+
+	storageSize, err := strconv.ParseInt(newSize, 10, 64)
+	if err != nil {
+		return irs.RDBMSInfo{}, fmt.Errorf("invalid StorageSize: %s", newSize)
+	}
+
+	current, err := handler.GetRDBMS(rdbmsIID)
+	if err != nil {
+		return irs.RDBMSInfo{}, err
+	}
+	currentSize, _ := strconv.ParseInt(current.StorageSize, 10, 64)
+	if storageSize <= currentSize {
+		return irs.RDBMSInfo{}, fmt.Errorf("new StorageSize(%d GB) must be larger than the current StorageSize(%d GB)", storageSize, currentSize)
+	}
+
+	input := &rds.ModifyDBInstanceInput{
+		DBInstanceIdentifier: aws.String(rdbmsIID.SystemId),
+		AllocatedStorage:     aws.Int64(storageSize),
+		ApplyImmediately:     aws.Bool(true),
+	}
+	result, err := handler.Client.ModifyDBInstance(input)
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return irs.RDBMSInfo{}, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	rdbmsInfo := handler.convertDBInstanceToRDBMSInfo(result.DBInstance)
+	// AllocatedStorage is updated after the modification; report the requested size
+	if result.DBInstance.PendingModifiedValues != nil && result.DBInstance.PendingModifiedValues.AllocatedStorage != nil {
+		rdbmsInfo.StorageSize = strconv.FormatInt(aws.Int64Value(result.DBInstance.PendingModifiedValues.AllocatedStorage), 10)
+	}
+	return rdbmsInfo, nil
+}
+
+// StartRDBMS starts a stopped RDBMS instance.
+func (handler *AwsRDBMSHandler) StartRDBMS(rdbmsIID irs.IID) (bool, error) {
+	hiscallInfo := GetCallLogScheme(handler.Region, call.RDBMS, rdbmsIID.NameId, "StartDBInstance()")
+	start := call.Start()
+
+	_, err := handler.Client.StartDBInstance(&rds.StartDBInstanceInput{
+		DBInstanceIdentifier: aws.String(rdbmsIID.SystemId),
+	})
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return false, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	return true, nil
+}
+
+// StopRDBMS stops an RDBMS instance.
+// AWS automatically starts a stopped instance after 7 days.
+func (handler *AwsRDBMSHandler) StopRDBMS(rdbmsIID irs.IID) (bool, error) {
+	hiscallInfo := GetCallLogScheme(handler.Region, call.RDBMS, rdbmsIID.NameId, "StopDBInstance()")
+	start := call.Start()
+
+	_, err := handler.Client.StopDBInstance(&rds.StopDBInstanceInput{
+		DBInstanceIdentifier: aws.String(rdbmsIID.SystemId),
+	})
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return false, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	return true, nil
+}
+
+// CreateSnapshot takes a manual DB snapshot of an RDBMS instance.
+func (handler *AwsRDBMSHandler) CreateSnapshot(rdbmsIID irs.IID, snapshotName string) (irs.RDBMSSnapshotInfo, error) {
+	hiscallInfo := GetCallLogScheme(handler.Region, call.RDBMS, snapshotName, "CreateDBSnapshot()")
+	start := call.Start()
+
+	if snapshotName == "" {
+		return irs.RDBMSSnapshotInfo{}, errors.New("snapshot name is required")
+	}
+
+	result, err := handler.Client.CreateDBSnapshot(&rds.CreateDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(rdbmsIID.SystemId),
+		DBSnapshotIdentifier: aws.String(snapshotName),
+		Tags: []*rds.Tag{
+			{Key: aws.String("Name"), Value: aws.String(snapshotName)},
+		},
+	})
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return irs.RDBMSSnapshotInfo{}, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	return convertDBSnapshotToRDBMSSnapshotInfo(result.DBSnapshot), nil
+}
+
+// ListSnapshot returns the manual DB snapshots of an RDBMS instance.
+func (handler *AwsRDBMSHandler) ListSnapshot(rdbmsIID irs.IID) ([]*irs.RDBMSSnapshotInfo, error) {
+	hiscallInfo := GetCallLogScheme(handler.Region, call.RDBMS, rdbmsIID.NameId, "DescribeDBSnapshots()")
+	start := call.Start()
+
+	input := &rds.DescribeDBSnapshotsInput{
+		DBInstanceIdentifier: aws.String(rdbmsIID.SystemId),
+		SnapshotType:         aws.String("manual"),
+	}
+	var snapshotList []*irs.RDBMSSnapshotInfo
+	err := handler.Client.DescribeDBSnapshotsPages(input, func(page *rds.DescribeDBSnapshotsOutput, lastPage bool) bool {
+		for _, snapshot := range page.DBSnapshots {
+			snapshotInfo := convertDBSnapshotToRDBMSSnapshotInfo(snapshot)
+			snapshotList = append(snapshotList, &snapshotInfo)
+		}
+		return true
+	})
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return nil, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	return snapshotList, nil
+}
+
+// DeleteSnapshot deletes a manual DB snapshot.
+func (handler *AwsRDBMSHandler) DeleteSnapshot(snapshotIID irs.IID) (bool, error) {
+	hiscallInfo := GetCallLogScheme(handler.Region, call.RDBMS, snapshotIID.NameId, "DeleteDBSnapshot()")
+	start := call.Start()
+
+	_, err := handler.Client.DeleteDBSnapshot(&rds.DeleteDBSnapshotInput{
+		DBSnapshotIdentifier: aws.String(snapshotIID.SystemId),
+	})
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return false, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	return true, nil
+}
+
+// RestoreSnapshot creates a new RDBMS instance from a DB snapshot.
+// The engine, version and storage come from the snapshot.
+func (handler *AwsRDBMSHandler) RestoreSnapshot(snapshotIID irs.IID, rdbmsReqInfo irs.RDBMSInfo) (irs.RDBMSInfo, error) {
+	hiscallInfo := GetCallLogScheme(handler.Region, call.RDBMS, rdbmsReqInfo.IId.NameId, "RestoreDBInstanceFromDBSnapshot()")
+	start := call.Start()
+
+	if rdbmsReqInfo.IId.NameId == "" {
+		return irs.RDBMSInfo{}, errors.New("RDBMS NameId is required")
+	}
+
+	// Create DB Subnet Group if VPC and Subnets are provided
+	subnetGroupName := ""
+	if rdbmsReqInfo.VpcIID.SystemId != "" && len(rdbmsReqInfo.SubnetIIDs) > 0 {
+		subnetGroupName = "cb-spider-" + rdbmsReqInfo.IId.NameId
+		err := handler.createDBSubnetGroup(subnetGroupName, rdbmsReqInfo.SubnetIIDs)
+		if err != nil {
+			return irs.RDBMSInfo{}, fmt.Errorf("failed to create DB subnet group: %w", err)
+		}
+	}
+
+	input := &rds.RestoreDBInstanceFromDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(rdbmsReqInfo.IId.NameId),
+		DBSnapshotIdentifier: aws.String(snapshotIID.SystemId),
+		MultiAZ:              aws.Bool(rdbmsReqInfo.HighAvailability),
+		PubliclyAccessible:   aws.Bool(rdbmsReqInfo.PublicAccess),
+		DeletionProtection:   aws.Bool(rdbmsReqInfo.DeletionProtection),
+		Tags: []*rds.Tag{
+			{Key: aws.String("Name"), Value: aws.String(rdbmsReqInfo.IId.NameId)},
+		},
+	}
+	if subnetGroupName != "" {
+		input.DBSubnetGroupName = aws.String(subnetGroupName)
+	}
+	if rdbmsReqInfo.DBInstanceSpec != "" {
+		input.DBInstanceClass = aws.String(rdbmsReqInfo.DBInstanceSpec)
+	}
+	for _, sg := range rdbmsReqInfo.SecurityGroupIIDs {
+		input.VpcSecurityGroupIds = append(input.VpcSecurityGroupIds, aws.String(sg.SystemId))
+	}
+
+	result, err := handler.Client.RestoreDBInstanceFromDBSnapshot(input)
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		if subnetGroupName != "" {
+			handler.deleteDBSubnetGroup(subnetGroupName)
+		}
+		return irs.RDBMSInfo{}, err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	return handler.convertDBInstanceToRDBMSInfo(result.DBInstance), nil
+}
+
 // ===== Helper Functions =====
 
 // ensureVPCDnsHostnames checks whether the VPC has EnableDnsHostnames enabled.
@@ -754,3 +995,36 @@ func convertRDSStatusToRDBMSStatus(rdsStatus string) irs.RDBMSStatus {
 		return irs.RDBMSError
 	}
 }
+
+func convertDBSnapshotToRDBMSSnapshotInfo(snapshot *rds.DBSnapshot) irs.RDBMSSnapshotInfo {
+	snapshotId := aws.StringValue(snapshot.DBSnapshotIdentifier)
+	dbId := aws.StringValue(snapshot.DBInstanceIdentifier)
+	snapshotInfo := irs.RDBMSSnapshotInfo{
+		IId:             irs.IID{NameId: snapshotId, SystemId: snapshotId},
+		SourceRDBMS:     irs.IID{NameId: dbId, SystemId: dbId},
+		DBEngine:        aws.StringValue(snapshot.Engine),
+		DBEngineVersion: aws.StringValue(snapshot.EngineVersion),
+		Status:          convertRDSSnapshotStatusToRDBMSSnapshotStatus(aws.StringValue(snapshot.Status)),
+	}
+	if snapshot.AllocatedStorage != nil {
+		snapshotInfo.StorageSize = strconv.FormatInt(aws.Int64Value(snapshot.AllocatedStorage), 10)
+	}
+	if snapshot.SnapshotCreateTime != nil {
+		snapshotInfo.CreatedTime = *snapshot.SnapshotCreateTime
+	}
+	snapshotInfo.KeyValueList = irs.StructToKeyValueList(snapshot)
+	return snapshotInfo
+}
+
+func convertRDSSnapshotStatusToRDBMSSnapshotStatus(rdsStatus string) irs.RDBMSSnapshotStatus {
+	switch strings.ToLower(rdsStatus) {
+	case "creating":
+		return irs.RDBMSSnapshotCreating
+	case "available":
+		return irs.RDBMSSnapshotAvailable
+	case "deleting":
+		return irs.RDBMSSnapshotDeleting
+	default:
+		return irs.RDBMSSnapshotError
+	}
+}
@@ -33,6 +33,30 @@ type AwsSecurityHandler struct {
 	TagHandler *AwsTagHandler // 2024-07-18 TagHandler add
 }
 
+// setIpPermissionTarget sets the CIDR, IPv6 CIDR or source SecurityGroup of a rule.
+// The description is not set for the revoke, the rule is matched without it.
+func setIpPermissionTarget(ipPermission *ec2.IpPermission, ruleInfo irs.SecurityRuleInfo, withDescription bool) {
+	var description *string
+	if withDescription && ruleInfo.Description != "" {
+		description = aws.String(ruleInfo.Description)
+	}
+
+	switch {
+	case ruleInfo.SourceSecurityGroupIID != nil:
+		ipPermission.SetUserIdGroupPairs([]*ec2.UserIdGroupPair{
+			{GroupId: aws.String(ruleInfo.SourceSecurityGroupIID.SystemId), Description: description},
+		})
+	case ruleInfo.CIDRv6 != "":
+		ipPermission.SetIpv6Ranges([]*ec2.Ipv6Range{
+			{CidrIpv6: aws.String(ruleInfo.CIDRv6), Description: description},
+		})
+	default:
+		ipPermission.SetIpRanges([]*ec2.IpRange{
+			{CidrIp: aws.String(ruleInfo.CIDR), Description: description},
+		})
+	}
+}
+
 // 2019-11-16부로 CB-Driver 전체 로직이 NameId 기반으로 변경됨. (보안 그룹은 그룹명으로 처리 가능하기 때문에 Name 태깅시 에러는 무시함)
 // @TODO : 존재하는 보안 그룹에 정책 추가하는 기능 필요
 // VPC 생략 시 활성화된 세션의 기본 VPC를 이용 함.
@@ -494,8 +518,9 @@ func ExtractIpPermissions(ipPermissions []*ec2.IpPermission, direction string) [
 		for _, ipv4 := range ip.IpRanges {
 			cblogger.Debug("Inbound/Outbound information retrieval: ", *ip.IpProtocol)
 			securityRuleInfo := irs.SecurityRuleInfo{
-				Direction: direction, // "inbound | outbound"
-				CIDR:      *ipv4.CidrIp,
+				Direction:   direction, // "inbound | outbound"
+				CIDR:        *ipv4.CidrIp,
+				Description: aws.StringValue(ipv4.Description),
 			}
 			cblogger.Debug(*ipv4.CidrIp)
 
@@ -506,8 +531,9 @@ func ExtractIpPermissions(ipPermissions []*ec2.IpPermission, direction string) [
 		//ipv6 처리
 		for _, ipv6 := range ip.Ipv6Ranges {
 			securityRuleInfo := irs.SecurityRuleInfo{
-				Direction: direction, // "inbound | outbound"
-				CIDR:      *ipv6.CidrIpv6,
+				Direction:   direction, // "inbound | outbound"
+				CIDRv6:      *ipv6.CidrIpv6,
+				Description: aws.StringValue(ipv6.Description),
 			}
 			cblogger.Debug(*ipv6.CidrIpv6)
 
@@ -518,10 +544,11 @@ func ExtractIpPermissions(ipPermissions []*ec2.IpPermission, direction string) [
 		//ELB나 보안그룹 참조 방식 처리
 		for _, userIdGroup := range ip.UserIdGroupPairs {
 			securityRuleInfo := irs.SecurityRuleInfo{
-				Direction: direction, // "inbound | outbound"
-				CIDR:      *userIdGroup.GroupId,
+				Direction:              direction, // "inbound | outbound"
+				SourceSecurityGroupIID: &irs.IID{SystemId: aws.StringValue(userIdGroup.GroupId)},
+				Description:            aws.StringValue(userIdGroup.Description),
 			}
-			cblogger.Debug(*userIdGroup.UserId)
+			cblogger.Debug(aws.StringValue(userIdGroup.UserId))
 
 			ExtractIpPermissionCommon(ip, &securityRuleInfo) //IP & Port & Protocol 추출
 			results = append(results, securityRuleInfo)
@@ -689,11 +716,7 @@ func (securityHandler *AwsSecurityHandler) ProcessAddRules(newGroupId *string, s
 			//ipPermission.SetToPort(0)
 		}
 
-		ipPermission.SetIpRanges([]*ec2.IpRange{
-			(&ec2.IpRange{}).
-				SetCidrIp(ip.CIDR),
-			//SetCidrIp("0.0.0.0/0"),
-		})
+		setIpPermissionTarget(ipPermission, ip, true)
 		// cblogger.Debug("===>변환완료")
 		// cblogger.Debug(ipPermission)
 
@@ -756,11 +779,7 @@ func (securityHandler *AwsSecurityHandler) ProcessAddRules(newGroupId *string, s
 			//ipPermission.SetToPort(0)
 		}
 
-		ipPermission.SetIpRanges([]*ec2.IpRange{
-			(&ec2.IpRange{}).
-				SetCidrIp(ip.CIDR),
-			//SetCidrIp("0.0.0.0/0"),
-		})
+		setIpPermissionTarget(ipPermission, ip, true)
 		//ipPermissions = append(ipPermissions, ipPermission)
 		ipPermissionsEgress = append(ipPermissionsEgress, ipPermission)
 	}
@@ -848,11 +867,7 @@ func (securityHandler *AwsSecurityHandler) ProcessRemoveRules(newGroupId *string
 			//ipPermission.SetToPort(0)
 		}
 
-		ipPermission.SetIpRanges([]*ec2.IpRange{
-			(&ec2.IpRange{}).
-				SetCidrIp(ip.CIDR),
-			//SetCidrIp("0.0.0.0/0"),
-		})
+		setIpPermissionTarget(ipPermission, ip, false)
 		// cblogger.Debug("===>변환완료")
 		// cblogger.Debug(ipPermission)
 
@@ -915,11 +930,7 @@ func (securityHandler *AwsSecurityHandler) ProcessRemoveRules(newGroupId *string
 			//ipPermission.SetToPort(0)
 		}
 
-		ipPermission.SetIpRanges([]*ec2.IpRange{
-			(&ec2.IpRange{}).
-				SetCidrIp(ip.CIDR),
-			//SetCidrIp("0.0.0.0/0"),
-		})
+		setIpPermissionTarget(ipPermission, ip, false)
 		//ipPermissions = append(ipPermissions, ipPermission)
 		ipPermissionsEgress = append(ipPermissionsEgress, ipPermission)
 	}
@@ -310,10 +310,18 @@ func (vmHandler *AwsVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 
 	//OS 종류에 따른 Cloud Init Data 처리
 	if isWindowsImage {
+		if vmReqInfo.UserData != "" {
+			return irs.VMInfo{}, errors.New("UserData is not supported for Windows VM")
+		}
 		userData = strings.Replace(string(fileDataCloudInit), "*PASSWORD*", vmReqInfo.VMUserPasswd, 1)
 		cblogger.Debugf("Windows Cloud-Init : [%s]", userData)
 	} else {
-		userData = string(fileDataCloudInit)
+		// merge user's UserData with Spider's cloud-init
+		userData, err = cdcom.MergeUserData(string(fileDataCloudInit), vmReqInfo.UserData)
+		if err != nil {
+			cblogger.Error(err)
+			return irs.VMInfo{}, err
+		}
 	}
 
 	//userData = strings.ReplaceAll(userData, "{{username}}", CBDefaultVmUserName)
@@ -375,6 +383,24 @@ func (vmHandler *AwsVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 		TagSpecifications: tagSpecifications,
 	}
 
+	//=============================
+	// Spot Instance 처리
+	//=============================
+	if vmReqInfo.PurchaseOption == irs.SpotVM {
+		spotOptions := &ec2.SpotMarketOptions{
+			SpotInstanceType:             aws.String(ec2.SpotInstanceTypeOneTime),
+			InstanceInterruptionBehavior: aws.String(ec2.InstanceInterruptionBehaviorTerminate),
+		}
+		// 미지정시 On-Demand 가격이 최대 가격으로 사용 됨.
+		if vmReqInfo.SpotMaxPrice != "" {
+			spotOptions.MaxPrice = aws.String(vmReqInfo.SpotMaxPrice)
+		}
+		input.InstanceMarketOptions = &ec2.InstanceMarketOptionsRequest{
+			MarketType:  aws.String(ec2.MarketTypeSpot),
+			SpotOptions: spotOptions,
+		}
+	}
+
 	//=============================
 	// SystemDisk 처리 - 이슈 #348에 의해 RootDisk 기능 지원
 	//=============================
@@ -838,6 +864,43 @@ func (vmHandler *AwsVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, error)
 	return irs.VMStatus("Terminating"), nil
 }
 
+// ChangeVMSpec changes the instance type of the stopped EC2 instance.
+func (vmHandler *AwsVMHandler) ChangeVMSpec(vmIID irs.IID, vmSpecName string) (irs.VMInfo, error) {
+	cblogger.Infof("vmID : [%s] / vmSpecName : [%s]", vmIID.SystemId, vmSpecName)
+
+	input := &ec2.ModifyInstanceAttributeInput{
+		InstanceId: aws.String(vmIID.SystemId),
+		InstanceType: &ec2.AttributeValue{
+			Value: aws.String(vmSpecName),
+		},
+	}
+
+	// logger for HisCall
+	callogger := call.GetLogger("HISCALL")
+	callLogInfo := call.CLOUDLOGSCHEMA{
+		CloudOS:      call.AWS,
+		RegionZone:   vmHandler.Region.Zone,
+		ResourceType: call.VM,
+		ResourceName: vmIID.SystemId,
+		CloudOSAPI:   "ModifyInstanceAttribute()",
+		ElapsedTime:  "",
+		ErrorMSG:     "",
+	}
+	callLogStart := call.Start()
+	result, err := vmHandler.Client.ModifyInstanceAttribute(input)
+	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	if err != nil {
+		callLogInfo.ErrorMSG = err.Error()
+		callogger.Error(call.String(callLogInfo))
+		cblogger.Error(err)
+		return irs.VMInfo{}, err
+	}
+	callogger.Info(call.String(callLogInfo))
+	cblogger.Debug(result)
+
+	return vmHandler.GetVM(vmIID)
+}
+
 // https://docs.aws.amazon.com/ko_kr/AWSEC2/latest/APIReference/API_GetPasswordData.html
 // https://awscli.amazonaws.com/v2/documentation/api/latest/reference/ec2/get-password-data.html
 // @TODO : ssh key를 이용해서 암호가 해독된 Password를 조회해야 함.
@@ -1012,6 +1075,11 @@ func (vmHandler *AwsVMHandler) ExtractDescribeInstanceToVmInfo(instance *ec2.Ins
 	vmInfo.StartTime = *instance.LaunchTime
 	//}
 
+	vmInfo.PurchaseOption = irs.OnDemandVM
+	if instance.InstanceLifecycle != nil && *instance.InstanceLifecycle == ec2.InstanceLifecycleTypeSpot {
+		vmInfo.PurchaseOption = irs.SpotVM
+	}
+
 	//cblogger.Info("=======>타입 : ", reflect.TypeOf(*reservation.Instances[0]))
 	//cblogger.Info("===> PublicIpAddress TypeOf : ", reflect.TypeOf(reservation.Instances[0].PublicIpAddress))
 	//cblogger.Info("===> PublicIpAddress ValueOf : ", reflect.ValueOf(reservation.Instances[0].PublicIpAddress))
@@ -1547,6 +1615,20 @@ func ConvertVMStatusString(vmStatus string) (irs.VMStatus, error) {
 	return irs.VMStatus(resultStatus), nil
 }
 
+// convertInstanceStatus converts the EC2 instance state to VMStatus.
+// Spot Instance stopped or terminated by AWS is returned as Interrupted.
+func convertInstanceStatus(instance *ec2.Instance) (irs.VMStatus, error) {
+	if instance.InstanceLifecycle != nil && *instance.InstanceLifecycle == ec2.InstanceLifecycleTypeSpot &&
+		instance.StateReason != nil && instance.StateReason.Code != nil {
+		switch *instance.StateReason.Code {
+		case "Server.SpotInstanceShutdown", "Server.SpotInstanceTermination":
+			cblogger.Infof("Spot Instance [%s] is interrupted : [%s]", *instance.InstanceId, *instance.StateReason.Code)
+			return irs.Interrupted, nil
+		}
+	}
+	return ConvertVMStatusString(*instance.State.Name)
+}
+
 // SHUTTING-DOWN / TERMINATED
 // func (vmHandler *AwsVMHandler) GetVMStatus(vmNameId string) (irs.VMStatus, error) {
 func (vmHandler *AwsVMHandler) GetVMStatus(vmIID irs.IID) (irs.VMStatus, error) {
@@ -1609,7 +1691,7 @@ func (vmHandler *AwsVMHandler) GetVMStatus(vmIID irs.IID) (irs.VMStatus, error)
 		for _, vm := range i.Instances {
 			//vmStatus := strings.ToUpper(*vm.State.Name)
 			cblogger.Info(vmID, " EC2 Status : ", *vm.State.Name)
-			vmStatus, errStatus := ConvertVMStatusString(*vm.State.Name)
+			vmStatus, errStatus := convertInstanceStatus(vm)
 			return vmStatus, errStatus
 			//return irs.VMStatus(vmStatus), nil
 		}
@@ -1666,7 +1748,7 @@ func (vmHandler *AwsVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
 			//*vm.State.Name
 			//*vm.InstanceId
 
-			vmStatus, _ := ConvertVMStatusString(*vm.State.Name)
+			vmStatus, _ := convertInstanceStatus(vm)
 			tmpVmName = ExtractVmName(vm.Tags)
 			/*
 				if tmpVmName == "" {
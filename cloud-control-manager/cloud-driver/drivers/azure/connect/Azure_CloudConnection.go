@@ -137,6 +137,22 @@ func (cloudConn *AzureCloudConnection) CreateNICHandler() (irs.NICHandler, error
 	return &handler, nil
 }
 
+func (cloudConn *AzureCloudConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, errors.New("Azure Cloud Driver: VPCPeeringHandler not supported")
+}
+
+func (cloudConn *AzureCloudConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, errors.New("Azure Cloud Driver: RouteTableHandler not supported")
+}
+
+func (cloudConn *AzureCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, errors.New("Azure Cloud Driver: NATGatewayHandler not supported")
+}
+
+func (cloudConn *AzureCloudConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, errors.New("Azure Cloud Driver: DiskSnapshotHandler not supported")
+}
+
 func (cloudConn *AzureCloudConnection) CreateVMHandler() (irs.VMHandler, error) {
 	cblogger.Info("Azure Cloud Driver: called CreateVMHandler()!")
 	vmHandler := azrs.AzureVMHandler{
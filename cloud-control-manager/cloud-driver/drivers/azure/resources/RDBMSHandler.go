@@ -561,6 +561,38 @@ func (handler *AzureRDBMSHandler) DeleteRDBMS(rdbmsIID irs.IID) (bool, error) {
 	return true, nil
 }
 
+func (handler *AzureRDBMSHandler) ChangeSpec(rdbmsIID irs.IID, newSpec string) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("Azure Driver Does not support ChangeSpec() yet!!")
+}
+
+func (handler *AzureRDBMSHandler) ChangeStorageSize(rdbmsIID irs.IID, newSize string) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("Azure Driver Does not support ChangeStorageSize() yet!!")
+}
+
+func (handler *AzureRDBMSHandler) StartRDBMS(rdbmsIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("Azure Driver Does not support StartRDBMS() yet!!")
+}
+
+func (handler *AzureRDBMSHandler) StopRDBMS(rdbmsIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("Azure Driver Does not support StopRDBMS() yet!!")
+}
+
+func (handler *AzureRDBMSHandler) CreateSnapshot(rdbmsIID irs.IID, snapshotName string) (irs.RDBMSSnapshotInfo, error) {
+	return irs.RDBMSSnapshotInfo{}, fmt.Errorf("Azure Driver Does not support CreateSnapshot() yet!!")
+}
+
+func (handler *AzureRDBMSHandler) ListSnapshot(rdbmsIID irs.IID) ([]*irs.RDBMSSnapshotInfo, error) {
+	return nil, fmt.Errorf("Azure Driver Does not support ListSnapshot() yet!!")
+}
+
+func (handler *AzureRDBMSHandler) DeleteSnapshot(snapshotIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("Azure Driver Does not support DeleteSnapshot() yet!!")
+}
+
+func (handler *AzureRDBMSHandler) RestoreSnapshot(snapshotIID irs.IID, rdbmsReqInfo irs.RDBMSInfo) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("Azure Driver Does not support RestoreSnapshot() yet!!")
+}
+
 // ===== Helper Functions =====
 
 // ensureSubnetDelegation checks whether the subnet is delegated to Microsoft.DBforMySQL/flexibleServers.
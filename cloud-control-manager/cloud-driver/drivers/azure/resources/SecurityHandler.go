@@ -94,6 +94,11 @@ func (securityHandler *AzureSecurityHandler) setterSec(securityGroup *armnetwork
 }
 
 func (securityHandler *AzureSecurityHandler) CreateSecurity(securityReqInfo irs.SecurityReqInfo) (irs.SecurityInfo, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityReqInfo.SecurityRules, false, false, false); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	// log HisCall
 	hiscallInfo := GetCallLogScheme(securityHandler.Region, call.SECURITYGROUP, securityReqInfo.IId.NameId, "CreateSecurity()")
 
@@ -236,6 +241,11 @@ func (securityHandler *AzureSecurityHandler) DeleteSecurity(securityIID irs.IID)
 }
 
 func (securityHandler *AzureSecurityHandler) AddRules(sgIID irs.IID, securityRules *[]irs.SecurityRuleInfo) (irs.SecurityInfo, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityRules, false, false, false); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	hiscallInfo := GetCallLogScheme(securityHandler.Region, call.SECURITYGROUP, sgIID.NameId, "AddRules()")
 
 	start := call.Start()
@@ -318,6 +328,11 @@ func (securityHandler *AzureSecurityHandler) AddRules(sgIID irs.IID, securityRul
 }
 
 func (securityHandler *AzureSecurityHandler) RemoveRules(sgIID irs.IID, securityRules *[]irs.SecurityRuleInfo) (bool, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityRules, false, false, false); err != nil {
+		return false, err
+	}
+
 	hiscallInfo := GetCallLogScheme(securityHandler.Region, call.SECURITYGROUP, sgIID.NameId, "RemoveRules()")
 
 	start := call.Start()
@@ -12,6 +12,7 @@ package resources
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -162,6 +163,36 @@ func (vmHandler *AzureVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, e
 			rawDataDiskList[i] = disk
 		}
 	}
+	// 1-4. Check UserData (Azure CustomData)
+	customData := ""
+	if vmReqInfo.UserData != "" {
+		if imageOsType == irs.WINDOWS {
+			createErr := errors.New("Failed to Start VM. err = UserData is not supported for Windows VM")
+			cblogger.Error(createErr.Error())
+			LoggingError(hiscallInfo, createErr)
+			return irs.VMInfo{}, createErr
+		}
+		userData, err := cdcom.MergeUserData("", vmReqInfo.UserData)
+		if err != nil {
+			createErr := errors.New(fmt.Sprintf("Failed to Start VM. err = %s", err.Error()))
+			cblogger.Error(createErr.Error())
+			LoggingError(hiscallInfo, createErr)
+			return irs.VMInfo{}, createErr
+		}
+		customData = base64.StdEncoding.EncodeToString([]byte(userData))
+	}
+
+	// 1-5. Check Spot VM (Azure Spot Virtual Machine)
+	spotMaxPrice := float64(-1) // -1: up to the on-demand price
+	if vmReqInfo.PurchaseOption == irs.SpotVM && vmReqInfo.SpotMaxPrice != "" {
+		spotMaxPrice, err = strconv.ParseFloat(vmReqInfo.SpotMaxPrice, 64)
+		if err != nil || spotMaxPrice <= 0 {
+			createErr := errors.New(fmt.Sprintf("Failed to Start VM. err = invalid SpotMaxPrice : %s", vmReqInfo.SpotMaxPrice))
+			cblogger.Error(createErr.Error())
+			LoggingError(hiscallInfo, createErr)
+			return irs.VMInfo{}, createErr
+		}
+	}
 
 	cleanVMClientSet := CleanVMClientSet{
 		VPCName:    vmReqInfo.VpcIID.NameId,
@@ -230,6 +261,20 @@ func (vmHandler *AzureVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, e
 		},
 	}
 
+	if customData != "" {
+		vmOpts.Properties.OSProfile.CustomData = toStrPtr(customData)
+	}
+
+	if vmReqInfo.PurchaseOption == irs.SpotVM {
+		priority := armcompute.VirtualMachinePriorityTypesSpot
+		evictionPolicy := armcompute.VirtualMachineEvictionPolicyTypesDeallocate
+		vmOpts.Properties.Priority = &priority
+		vmOpts.Properties.EvictionPolicy = &evictionPolicy
+		vmOpts.Properties.BillingProfile = &armcompute.BillingProfile{
+			MaxPrice: &spotMaxPrice,
+		}
+	}
+
 	// Setting zone if available
 	if vmHandler.Region.TargetZone != "" {
 		vmOpts.Zones = []*string{
@@ -763,6 +808,48 @@ func (vmHandler *AzureVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, error
 	return irs.NotExist, nil
 }
 
+func (vmHandler *AzureVMHandler) ChangeVMSpec(vmIID irs.IID, vmSpecName string) (irs.VMInfo, error) {
+	// log HisCall
+	hiscallInfo := GetCallLogScheme(vmHandler.Region, call.VM, vmIID.NameId, "ChangeVMSpec()")
+
+	convertedIID, err := ConvertVMIID(vmIID, vmHandler.CredentialInfo, vmHandler.Region)
+	if err != nil {
+		changeErr := errors.New(fmt.Sprintf("Failed to Change VMSpec. err = %s", err))
+		cblogger.Error(changeErr.Error())
+		LoggingError(hiscallInfo, changeErr)
+		return irs.VMInfo{}, changeErr
+	}
+
+	// Azure resizes the running VM with restart
+	vmSize := armcompute.VirtualMachineSizeTypes(vmSpecName)
+	updateOpts := armcompute.VirtualMachineUpdate{
+		Properties: &armcompute.VirtualMachineProperties{
+			HardwareProfile: &armcompute.HardwareProfile{
+				VMSize: &vmSize,
+			},
+		},
+	}
+
+	start := call.Start()
+	poller, err := vmHandler.Client.BeginUpdate(vmHandler.Ctx, vmHandler.Region.Region, convertedIID.NameId, updateOpts, nil)
+	if err != nil {
+		changeErr := errors.New(fmt.Sprintf("Failed to Change VMSpec. err = %s", err))
+		cblogger.Error(changeErr.Error())
+		LoggingError(hiscallInfo, changeErr)
+		return irs.VMInfo{}, changeErr
+	}
+	_, err = poller.PollUntilDone(vmHandler.Ctx, nil)
+	if err != nil {
+		changeErr := errors.New(fmt.Sprintf("Failed to Change VMSpec. err = %s", err))
+		cblogger.Error(changeErr.Error())
+		LoggingError(hiscallInfo, changeErr)
+		return irs.VMInfo{}, changeErr
+	}
+	LoggingInfo(hiscallInfo, start)
+
+	return vmHandler.GetVM(vmIID)
+}
+
 func (vmHandler *AzureVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
 	// log HisCall
 	hiscallInfo := GetCallLogScheme(vmHandler.Region, call.VM, VM, "ListVMStatus()")
@@ -793,7 +880,7 @@ func (vmHandler *AzureVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
 
 	for _, vm := range vmList {
 		if vm.Properties.InstanceView != nil {
-			statusStr := getVmStatus(*vm.Properties.InstanceView)
+			statusStr := getSpotVmStatus(*vm, *vm.Properties.InstanceView)
 			status := statusStr
 			vmStatusInfo := irs.VMStatusInfo{
 				IId: irs.IID{
@@ -843,6 +930,14 @@ func (vmHandler *AzureVMHandler) GetVMStatus(vmIID irs.IID) (irs.VMStatus, error
 
 	// Get powerState, provisioningState
 	vmStatus := getVmStatus(resp.VirtualMachineInstanceView)
+
+	// Check eviction of Spot VM
+	if isDeallocated(resp.VirtualMachineInstanceView) {
+		vm, err := GetRawVM(convertedIID, vmHandler.Region.Region, vmHandler.Client, vmHandler.Ctx)
+		if err == nil {
+			vmStatus = getSpotVmStatus(vm, resp.VirtualMachineInstanceView)
+		}
+	}
 	return vmStatus, nil
 }
 
@@ -967,6 +1062,25 @@ func getVmStatus(instanceView armcompute.VirtualMachineInstanceView) irs.VMStatu
 	return resultStatus
 }
 
+// getSpotVmStatus returns Interrupted if the Spot VM is evicted(deallocated) by Azure.
+// SuspendVM of Spider uses PowerOff(stopped), so a deallocated Spot VM means eviction.
+func getSpotVmStatus(vm armcompute.VirtualMachine, instanceView armcompute.VirtualMachineInstanceView) irs.VMStatus {
+	if vm.Properties != nil && vm.Properties.Priority != nil &&
+		*vm.Properties.Priority == armcompute.VirtualMachinePriorityTypesSpot && isDeallocated(instanceView) {
+		return irs.Interrupted
+	}
+	return getVmStatus(instanceView)
+}
+
+func isDeallocated(instanceView armcompute.VirtualMachineInstanceView) bool {
+	for _, stat := range instanceView.Statuses {
+		if stat.Code != nil && strings.EqualFold(*stat.Code, "PowerState/deallocated") {
+			return true
+		}
+	}
+	return false
+}
+
 func (vmHandler *AzureVMHandler) cleanDeleteVm(vmIId irs.IID) error {
 	convertedIID, err := ConvertVMIID(vmIId, vmHandler.CredentialInfo, vmHandler.Region)
 	exist, err := CheckExistVM(convertedIID, vmHandler.Region.Region, vmHandler.Client, vmHandler.Ctx)
@@ -1049,6 +1163,12 @@ func (vmHandler *AzureVMHandler) mappingServerInfo(server armcompute.VirtualMach
 		VMBlockDisk:    "Not visible in Azure",
 	}
 
+	// Set VM Purchase Option
+	vmInfo.PurchaseOption = irs.OnDemandVM
+	if server.Properties.Priority != nil && *server.Properties.Priority == armcompute.VirtualMachinePriorityTypesSpot {
+		vmInfo.PurchaseOption = irs.SpotVM
+	}
+
 	// Set VM Zone
 	if server.Zones != nil && len(server.Zones) > 0 {
 		vmInfo.Region.Zone = *server.Zones[0]
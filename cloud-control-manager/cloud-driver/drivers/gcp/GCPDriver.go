@@ -81,6 +81,19 @@ func (GCPDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	drvCapabilityInfo.NICHandler = false
 
 	drvCapabilityInfo.VPC_CIDR = false
+	drvCapabilityInfo.VM_USER_DATA = true
+	drvCapabilityInfo.SPOT_VM = true
+	drvCapabilityInfo.VM_SPEC_CHANGE = true
+	drvCapabilityInfo.VM_SPEC_CHANGE_NEEDS_STOP = true
+
+	drvCapabilityInfo.SG_RULE_DESCRIPTION = false
+	drvCapabilityInfo.SG_RULE_IPV6_CIDR = false
+	drvCapabilityInfo.SG_RULE_SOURCE_SG = false
+
+	drvCapabilityInfo.NLB_MULTI_LISTENER = false
+	drvCapabilityInfo.NLB_HTTPS_CERTIFICATE = false
+	drvCapabilityInfo.NLB_HEALTH_CHECK_PATH = false
+	drvCapabilityInfo.NLB_HTTP_PROTOCOL = false
 
 	return drvCapabilityInfo
 }
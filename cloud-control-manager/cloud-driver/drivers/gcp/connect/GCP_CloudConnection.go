@@ -13,6 +13,7 @@ package connect
 
 import (
 	"context"
+	"errors"
 
 	filestore "cloud.google.com/go/filestore/apiv1"
 	cblog "github.com/cloud-barista/cb-log"
@@ -103,6 +104,22 @@ func (cloudConn *GCPCloudConnection) CreateNICHandler() (irs.NICHandler, error)
 	return &handler, nil
 }
 
+func (cloudConn *GCPCloudConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, errors.New("GCP Cloud Driver: VPCPeeringHandler not supported")
+}
+
+func (cloudConn *GCPCloudConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, errors.New("GCP Cloud Driver: RouteTableHandler not supported")
+}
+
+func (cloudConn *GCPCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, errors.New("GCP Cloud Driver: NATGatewayHandler not supported")
+}
+
+func (cloudConn *GCPCloudConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, errors.New("GCP Cloud Driver: DiskSnapshotHandler not supported")
+}
+
 func (cloudConn *GCPCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
 	cblogger.Info("GCP Cloud Driver: called CreatePublicIPHandler()!")
 	handler := gcprs.GCPPublicIPHandler{
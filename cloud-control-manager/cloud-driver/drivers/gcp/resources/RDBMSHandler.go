@@ -62,6 +62,9 @@ func (handler *GCPRDBMSHandler) GetMetaInfo(dbEngine string) (irs.RDBMSMetaInfo,
 	if err != nil {
 		return irs.RDBMSMetaInfo{}, err
 	}
+	metaInfo.SupportsChangeSpec = true
+	metaInfo.SupportsChangeStorageSize = true
+	metaInfo.SupportsStartStop = true
 
 	hiscallInfo.ElapsedTime = call.Elapsed(start)
 	calllogger.Info(call.String(hiscallInfo))
@@ -618,6 +621,77 @@ func (handler *GCPRDBMSHandler) DeleteRDBMS(rdbmsIID irs.IID) (bool, error) {
 	return true, nil
 }
 
+// ChangeSpec changes the machine tier of a Cloud SQL instance. The instance restarts during the change.
+func (handler *GCPRDBMSHandler) ChangeSpec(rdbmsIID irs.IID, newSpec string) (irs.RDBMSInfo, error) {
+	if newSpec == "" {
+		return irs.RDBMSInfo{}, errors.New("DBInstanceSpec is required")
+	}
+
+	err := handler.patchSettings(rdbmsIID, "ChangeSpec", &sqladmin.Settings{Tier: newSpec})
+	if err != nil {
+		return irs.RDBMSInfo{}, err
+	}
+	return handler.GetRDBMS(rdbmsIID)
+}
+
+// ChangeStorageSize expands the data disk of a Cloud SQL instance. Cloud SQL does not allow shrinking the disk.
+func (handler *GCPRDBMSHandler) ChangeStorageSize(rdbmsIID irs.IID, newSize string) (irs.RDBMSInfo, error) {
+	storageSize, err := strconv.ParseInt(newSize, 10, 64)
+	if err != nil {
+		return irs.RDBMSInfo{}, fmt.Errorf("invalid StorageSize: %s", newSize)
+	}
+
+	current, err := handler.GetRDBMS(rdbmsIID)
+	if err != nil {
+		return irs.RDBMSInfo{}, err
+	}
+	currentSize, _ := strconv.ParseInt(current.StorageSize, 10, 64)
+	if storageSize <= currentSize {
+		return irs.RDBMSInfo{}, fmt.Errorf("new StorageSize(%d GB) must be larger than the current StorageSize(%d GB)", storageSize, currentSize)
+	}
+
+	err = handler.patchSettings(rdbmsIID, "ChangeStorageSize", &sqladmin.Settings{DataDiskSizeGb: storageSize})
+	if err != nil {
+		return irs.RDBMSInfo{}, err
+	}
+	return handler.GetRDBMS(rdbmsIID)
+}
+
+// StartRDBMS starts a Cloud SQL instance by setting the activation policy to ALWAYS.
+func (handler *GCPRDBMSHandler) StartRDBMS(rdbmsIID irs.IID) (bool, error) {
+	err := handler.patchSettings(rdbmsIID, "StartRDBMS", &sqladmin.Settings{ActivationPolicy: "ALWAYS"})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// StopRDBMS stops a Cloud SQL instance by setting the activation policy to NEVER.
+func (handler *GCPRDBMSHandler) StopRDBMS(rdbmsIID irs.IID) (bool, error) {
+	err := handler.patchSettings(rdbmsIID, "StopRDBMS", &sqladmin.Settings{ActivationPolicy: "NEVER"})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Cloud SQL restores a backup only into an existing instance, so Spider does not support snapshots for GCP.
+func (handler *GCPRDBMSHandler) CreateSnapshot(rdbmsIID irs.IID, snapshotName string) (irs.RDBMSSnapshotInfo, error) {
+	return irs.RDBMSSnapshotInfo{}, fmt.Errorf("GCP Driver Does not support CreateSnapshot() yet!!")
+}
+
+func (handler *GCPRDBMSHandler) ListSnapshot(rdbmsIID irs.IID) ([]*irs.RDBMSSnapshotInfo, error) {
+	return nil, fmt.Errorf("GCP Driver Does not support ListSnapshot() yet!!")
+}
+
+func (handler *GCPRDBMSHandler) DeleteSnapshot(snapshotIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("GCP Driver Does not support DeleteSnapshot() yet!!")
+}
+
+func (handler *GCPRDBMSHandler) RestoreSnapshot(snapshotIID irs.IID, rdbmsReqInfo irs.RDBMSInfo) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("GCP Driver Does not support RestoreSnapshot() yet!!")
+}
+
 // ===== Helper Functions =====
 
 func (handler *GCPRDBMSHandler) getProjectId() string {
@@ -635,6 +709,28 @@ func (handler *GCPRDBMSHandler) mapDatabaseVersion(engine, version string) strin
 	}
 }
 
+// patchSettings patches only the given settings fields of a Cloud SQL instance and waits for the operation.
+func (handler *GCPRDBMSHandler) patchSettings(rdbmsIID irs.IID, operation string, settings *sqladmin.Settings) error {
+	hiscallInfo := GetCallLogScheme(handler.Region, call.RDBMS, rdbmsIID.NameId, "Instances.Patch()")
+	start := call.Start()
+
+	projectId := handler.getProjectId()
+	op, err := handler.Client.Instances.Patch(projectId, rdbmsIID.SystemId, &sqladmin.DatabaseInstance{Settings: settings}).Do()
+	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	if err != nil {
+		cblogger.Error(err)
+		LoggingError(hiscallInfo, err)
+		return err
+	}
+	calllogger.Info(call.String(hiscallInfo))
+
+	err = handler.waitForOperation(projectId, op.Name)
+	if err != nil {
+		return fmt.Errorf("failed waiting for %s: %w", operation, err)
+	}
+	return nil
+}
+
 func (handler *GCPRDBMSHandler) waitForOperation(projectId, opName string) error {
 	maxWait := 30 * time.Minute
 	pollInterval := 15 * time.Second
@@ -749,6 +845,10 @@ func (handler *GCPRDBMSHandler) convertToRDBMSInfo(instance *sqladmin.DatabaseIn
 
 	// Status
 	rdbmsInfo.Status = convertGCPStatusToRDBMSStatus(instance.State)
+	// a stopped instance stays RUNNABLE with the activation policy NEVER
+	if rdbmsInfo.Status == irs.RDBMSAvailable && instance.Settings != nil && instance.Settings.ActivationPolicy == "NEVER" {
+		rdbmsInfo.Status = irs.RDBMSStopped
+	}
 
 	// Created Time
 	if instance.CreateTime != "" {
@@ -240,6 +240,11 @@ SecurityGroup 생성. GCP는 firewall 추가 시 tag = securityGroupName
 .사용자의 요청에서 outbound all open 이 있는 경우. default로 생성하므로 skip
 */
 func (securityHandler *GCPSecurityHandler) CreateSecurity(securityReqInfo irs.SecurityReqInfo) (irs.SecurityInfo, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityReqInfo.SecurityRules, false, false, false); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	cblogger.Debug(securityReqInfo)
 
 	var addFilewallList []compute.Firewall // 추가할 firewall 목록
@@ -836,6 +841,11 @@ func (securityHandler *GCPSecurityHandler) insertDefaultOutboundPolicy(projectID
 //}
 
 func (securityHandler *GCPSecurityHandler) AddRules(sgIID irs.IID, securityRules *[]irs.SecurityRuleInfo) (irs.SecurityInfo, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityRules, false, false, false); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	cblogger.Debug(*securityRules)
 
 	projectID := securityHandler.Credential.ProjectID
@@ -1112,6 +1122,11 @@ func (securityHandler *GCPSecurityHandler) AddRules(sgIID irs.IID, securityRules
 // 요청받은 Security 그룹안의 SecurityRule이 동일한 firewall 삭제
 // 추가가 allow만 가능 하므로 삭제도 allow만 가능
 func (securityHandler *GCPSecurityHandler) RemoveRules(sgIID irs.IID, securityRules *[]irs.SecurityRuleInfo) (bool, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityRules, false, false, false); err != nil {
+		return false, err
+	}
+
 	cblogger.Debug(*securityRules)
 
 	projectID := securityHandler.Credential.ProjectID
@@ -320,6 +320,25 @@ func (vmHandler *GCPVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 		instance.Metadata.Items = append(instance.Metadata.Items, &winOsPwd)
 	}
 
+	// user's UserData: shell script => startup-script, cloud-config => user-data(cloud-init)
+	if vmReqInfo.UserData != "" {
+		if isWindows {
+			return irs.VMInfo{}, errors.New("UserData is not supported for Windows VM")
+		}
+		err := cdcom.ValidateUserData(vmReqInfo.UserData)
+		if err != nil {
+			cblogger.Error(err)
+			return irs.VMInfo{}, err
+		}
+		userData, _ := cdcom.DecodeUserData(vmReqInfo.UserData)
+		metaKey := "startup-script"
+		if !strings.HasPrefix(strings.TrimSpace(userData), "#!") {
+			metaKey = "user-data"
+		}
+		userDataMeta := compute.MetadataItems{Key: metaKey, Value: &userData}
+		instance.Metadata.Items = append(instance.Metadata.Items, &userDataMeta)
+	}
+
 	// imageType이 MyImage인 경우 SourceMachineImage Setting
 	if isMyImage {
 		instance.SourceMachineImage = imageURL
@@ -431,6 +450,20 @@ func (vmHandler *GCPVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 		instance.Disks = append(instance.Disks, &disk)
 	}
 
+	// Spot VM
+	if vmReqInfo.PurchaseOption == irs.SpotVM {
+		if vmReqInfo.SpotMaxPrice != "" {
+			return irs.VMInfo{}, errors.New("GCP Spot VM does not support SpotMaxPrice. Spot VM uses the dynamic Spot price.")
+		}
+		// Spot VM does not support live migration and automatic restart.
+		instance.Scheduling = &compute.Scheduling{
+			ProvisioningModel:         "SPOT",
+			InstanceTerminationAction: "STOP",
+			OnHostMaintenance:         "TERMINATE",
+			AutomaticRestart:          googleapi.Bool(false),
+		}
+	}
+
 	cblogger.Info("VM Creation Started")
 	cblogger.Debug(instance)
 
@@ -462,9 +495,10 @@ func (vmHandler *GCPVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 			strings.Contains(errorLower, "not support live migration")
 		if ok && e.Code == http.StatusBadRequest && liveMigrationNotSupport {
 			cblogger.Info("vm creating with Scheduling struct to set live migration to TERMINATE")
-			instance.Scheduling = &compute.Scheduling{
-				OnHostMaintenance: "TERMINATE",
+			if instance.Scheduling == nil {
+				instance.Scheduling = &compute.Scheduling{}
 			}
+			instance.Scheduling.OnHostMaintenance = "TERMINATE"
 			op, err1 = vmHandler.Client.Instances.Insert(projectID, zone, instance).Do()
 
 			if err1 != nil {
@@ -867,6 +901,51 @@ func (vmHandler *GCPVMHandler) TerminateVM(vmID irs.IID) (irs.VMStatus, error) {
 	return irs.VMStatus("Terminating"), nil
 }
 
+// ChangeVMSpec changes the machine type of the stopped(TERMINATED) instance.
+func (vmHandler *GCPVMHandler) ChangeVMSpec(vmID irs.IID, vmSpecName string) (irs.VMInfo, error) {
+	projectID := vmHandler.Credential.ProjectID
+	region := vmHandler.Region.Region
+	zone := vmHandler.Region.Zone
+
+	// set zone if TargetZone is not empty
+	if vmHandler.Region.TargetZone != "" {
+		zone = vmHandler.Region.TargetZone
+	}
+
+	// logger for HisCall
+	callogger := call.GetLogger("HISCALL")
+	callLogInfo := call.CLOUDLOGSCHEMA{
+		CloudOS:      call.GCP,
+		RegionZone:   vmHandler.Region.Zone,
+		ResourceType: call.VM,
+		ResourceName: vmID.SystemId,
+		CloudOSAPI:   "SetMachineType()",
+		ElapsedTime:  "",
+		ErrorMSG:     "",
+	}
+	callLogStart := call.Start()
+	machineTypeReq := &compute.InstancesSetMachineTypeRequest{
+		MachineType: "zones/" + zone + "/machineTypes/" + vmSpecName,
+	}
+	op, err := vmHandler.Client.Instances.SetMachineType(projectID, zone, vmID.SystemId, machineTypeReq).Do()
+	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	if err != nil {
+		callLogInfo.ErrorMSG = err.Error()
+		callogger.Error(call.String(callLogInfo))
+		cblogger.Error(err)
+		return irs.VMInfo{}, err
+	}
+	callogger.Info(call.String(callLogInfo))
+
+	err = WaitOperationComplete(vmHandler.Client, projectID, region, zone, op.Name, OperationZone)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VMInfo{}, err
+	}
+
+	return vmHandler.GetVM(vmID)
+}
+
 func (vmHandler *GCPVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
 	projectID := vmHandler.Credential.ProjectID
 	regionID := vmHandler.Region.Region
@@ -997,6 +1076,12 @@ func (vmHandler *GCPVMHandler) GetVMStatus(vmID irs.IID) (irs.VMStatus, error) {
 	}
 	callogger.Info(call.String(callLogInfo))
 
+	// Spot VM stopped by GCP preemption
+	if strings.EqualFold(instanceView.Status, "TERMINATED") && isSpotInstance(instanceView) &&
+		vmHandler.isPreempted(projectID, zone, instanceView) {
+		return irs.Interrupted, nil
+	}
+
 	// Get powerState, provisioningState
 	//vmStatus := instanceView.Status
 	vmStatus, errStatus := ConvertVMStatusString(instanceView.Status)
@@ -1004,6 +1089,38 @@ func (vmHandler *GCPVMHandler) GetVMStatus(vmID irs.IID) (irs.VMStatus, error) {
 	return vmStatus, errStatus
 }
 
+// isSpotInstance returns true if the instance is a Spot VM or a preemptible VM.
+func isSpotInstance(instance *compute.Instance) bool {
+	if instance.Scheduling == nil {
+		return false
+	}
+	return instance.Scheduling.ProvisioningModel == "SPOT" || instance.Scheduling.Preemptible
+}
+
+// isPreempted checks whether the last stop of the instance was caused by GCP preemption.
+func (vmHandler *GCPVMHandler) isPreempted(projectID string, zone string, instance *compute.Instance) bool {
+	filter := fmt.Sprintf("(operationType = \"compute.instances.preempted\") AND (targetId = \"%d\")", instance.Id)
+	opList, err := vmHandler.Client.ZoneOperations.List(projectID, zone).Filter(filter).Do()
+	if err != nil {
+		cblogger.Error(err)
+		return false
+	}
+
+	lastStart, _ := time.Parse(time.RFC3339, instance.LastStartTimestamp)
+	for _, op := range opList.Items {
+		// preempted after the last start
+		preemptedAt, err := time.Parse(time.RFC3339, op.InsertTime)
+		if err != nil {
+			continue
+		}
+		if preemptedAt.After(lastStart) {
+			cblogger.Infof("Spot VM [%s] is preempted at %s", instance.Name, op.InsertTime)
+			return true
+		}
+	}
+	return false
+}
+
 func (vmHandler *GCPVMHandler) ListVM() ([]*irs.VMInfo, error) {
 	projectID := vmHandler.Credential.ProjectID
 	regionID := vmHandler.Region.Region
@@ -1294,6 +1411,11 @@ func (vmHandler *GCPVMHandler) mappingServerInfo(server *compute.Instance) irs.V
 
 	vmInfo.ImageType = vmHandler.getImageType(server.SourceMachineImage)
 
+	vmInfo.PurchaseOption = irs.OnDemandVM
+	if isSpotInstance(server) {
+		vmInfo.PurchaseOption = irs.SpotVM
+	}
+
 	arrVmSpec := strings.Split(server.MachineType, "/")
 	cblogger.Debug(arrVmSpec)
 	if len(arrVmSpec) > 1 {
@@ -57,6 +57,19 @@ func (IbmCloudDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	drvCapabilityInfo.TagSupportResourceType = []ires.RSType{ires.VPC, ires.SUBNET, ires.SG, ires.KEY, ires.VM, ires.NLB, ires.DISK, ires.MYIMAGE, ires.CLUSTER, ires.RDBMS}
 
 	drvCapabilityInfo.VPC_CIDR = false
+	drvCapabilityInfo.VM_USER_DATA = true
+	drvCapabilityInfo.SPOT_VM = false
+	drvCapabilityInfo.VM_SPEC_CHANGE = false
+	drvCapabilityInfo.VM_SPEC_CHANGE_NEEDS_STOP = false
+
+	drvCapabilityInfo.SG_RULE_DESCRIPTION = false
+	drvCapabilityInfo.SG_RULE_IPV6_CIDR = false
+	drvCapabilityInfo.SG_RULE_SOURCE_SG = false
+
+	drvCapabilityInfo.NLB_MULTI_LISTENER = false
+	drvCapabilityInfo.NLB_HTTPS_CERTIFICATE = false
+	drvCapabilityInfo.NLB_HEALTH_CHECK_PATH = false
+	drvCapabilityInfo.NLB_HTTP_PROTOCOL = false
 
 	return drvCapabilityInfo
 }
@@ -249,6 +249,22 @@ func (cloudConn *IbmCloudConnection) CreateNICHandler() (irs.NICHandler, error)
 	return &handler, nil
 }
 
+func (cloudConn *IbmCloudConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, errors.New("Ibm Cloud Driver: VPCPeeringHandler not supported")
+}
+
+func (cloudConn *IbmCloudConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, errors.New("Ibm Cloud Driver: RouteTableHandler not supported")
+}
+
+func (cloudConn *IbmCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, errors.New("Ibm Cloud Driver: NATGatewayHandler not supported")
+}
+
+func (cloudConn *IbmCloudConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, errors.New("Ibm Cloud Driver: DiskSnapshotHandler not supported")
+}
+
 func (cloudConn *IbmCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
 	cblogger.Info("Ibm Cloud Driver: called CreatePublicIPHandler()!")
 	handler := ibmrs.IbmPublicIPHandler{
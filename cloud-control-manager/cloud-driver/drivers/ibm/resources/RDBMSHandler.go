@@ -744,6 +744,38 @@ func (handler *IbmRDBMSHandler) DeleteRDBMS(rdbmsIID irs.IID) (bool, error) {
 	return true, nil
 }
 
+func (handler *IbmRDBMSHandler) ChangeSpec(rdbmsIID irs.IID, newSpec string) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("IBM Cloud VPC Driver Does not support ChangeSpec() yet!!")
+}
+
+func (handler *IbmRDBMSHandler) ChangeStorageSize(rdbmsIID irs.IID, newSize string) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("IBM Cloud VPC Driver Does not support ChangeStorageSize() yet!!")
+}
+
+func (handler *IbmRDBMSHandler) StartRDBMS(rdbmsIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("IBM Cloud VPC Driver Does not support StartRDBMS() yet!!")
+}
+
+func (handler *IbmRDBMSHandler) StopRDBMS(rdbmsIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("IBM Cloud VPC Driver Does not support StopRDBMS() yet!!")
+}
+
+func (handler *IbmRDBMSHandler) CreateSnapshot(rdbmsIID irs.IID, snapshotName string) (irs.RDBMSSnapshotInfo, error) {
+	return irs.RDBMSSnapshotInfo{}, fmt.Errorf("IBM Cloud VPC Driver Does not support CreateSnapshot() yet!!")
+}
+
+func (handler *IbmRDBMSHandler) ListSnapshot(rdbmsIID irs.IID) ([]*irs.RDBMSSnapshotInfo, error) {
+	return nil, fmt.Errorf("IBM Cloud VPC Driver Does not support ListSnapshot() yet!!")
+}
+
+func (handler *IbmRDBMSHandler) DeleteSnapshot(snapshotIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("IBM Cloud VPC Driver Does not support DeleteSnapshot() yet!!")
+}
+
+func (handler *IbmRDBMSHandler) RestoreSnapshot(snapshotIID irs.IID, rdbmsReqInfo irs.RDBMSInfo) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("IBM Cloud VPC Driver Does not support RestoreSnapshot() yet!!")
+}
+
 // ===== Helper Functions =====
 
 func (handler *IbmRDBMSHandler) listResourceInstancesByType(serviceID string) ([]*irs.IID, error) {
@@ -27,6 +27,11 @@ type IbmSecurityHandler struct {
 }
 
 func (securityHandler *IbmSecurityHandler) CreateSecurity(securityReqInfo irs.SecurityReqInfo) (irs.SecurityInfo, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityReqInfo.SecurityRules, false, false, false); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	hiscallInfo := GetCallLogScheme(securityHandler.Region, call.SECURITYGROUP, securityReqInfo.IId.NameId, "CreateSecurity()")
 	start := call.Start()
 
@@ -426,6 +431,11 @@ func checkSecurityReqInfo(securityReqInfo irs.SecurityReqInfo) error {
 }
 
 func (securityHandler *IbmSecurityHandler) AddRules(sgIID irs.IID, securityRules *[]irs.SecurityRuleInfo) (irs.SecurityInfo, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityRules, false, false, false); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	hiscallInfo := GetCallLogScheme(securityHandler.Region, call.SECURITYGROUP, sgIID.NameId, "GetSecurity()")
 	start := call.Start()
 
@@ -508,6 +518,11 @@ func (securityHandler *IbmSecurityHandler) AddRules(sgIID irs.IID, securityRules
 }
 
 func (securityHandler *IbmSecurityHandler) RemoveRules(sgIID irs.IID, securityRules *[]irs.SecurityRuleInfo) (bool, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityRules, false, false, false); err != nil {
+		return false, err
+	}
+
 	hiscallInfo := GetCallLogScheme(securityHandler.Region, call.SECURITYGROUP, sgIID.NameId, "RemoveRules()")
 	start := call.Start()
 
@@ -160,6 +160,12 @@ func (vmHandler *IbmVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 	// 1-3. cloud-init data set
 	var userData string
 	if isWindows {
+		if vmReqInfo.UserData != "" {
+			createErr := errors.New("Failed to Create VM. err = UserData is not supported for Windows VM")
+			cblogger.Error(createErr.Error())
+			LoggingError(hiscallInfo, createErr)
+			return irs.VMInfo{}, createErr
+		}
 		userId := vmReqInfo.VMUserId
 		if userId == "" {
 			userId = "Administrator"
@@ -183,10 +189,18 @@ func (vmHandler *IbmVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 		userData = string(fileDataCloudInit)
 		userData = strings.ReplaceAll(userData, "{{username}}", CBDefaultVmUserName)
 		userData = strings.ReplaceAll(userData, "{{public_key}}", *key.PublicKey)
+
+		// merge user's UserData with Spider's cloud-init
+		userData, err = cdcom.MergeUserData(userData, vmReqInfo.UserData)
+		if err != nil {
+			createErr := errors.New(fmt.Sprintf("Failed to Create VM. err = %s", err.Error()))
+			cblogger.Error(createErr.Error())
+			LoggingError(hiscallInfo, createErr)
+			return irs.VMInfo{}, createErr
+		}
 	}
 
 	// 2.Create VM
-	// TODO : UserData cloudInit
 	createInstanceOptions := &vpcv1.CreateInstanceOptions{}
 
 	var sgIdentities []vpcv1.SecurityGroupIdentityIntf
@@ -712,6 +726,10 @@ func (vmHandler *IbmVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, error)
 	return irs.Terminating, nil
 }
 
+func (vmHandler *IbmVMHandler) ChangeVMSpec(vmIID irs.IID, vmSpecName string) (irs.VMInfo, error) {
+	return irs.VMInfo{}, fmt.Errorf("IBM Cloud VPC Driver Does not support ChangeVMSpec() yet!!")
+}
+
 func (vmHandler *IbmVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
 	hiscallInfo := GetCallLogScheme(vmHandler.Region, call.VM, "VMStatus", "ListVMStatus()")
 	start := call.Start()
@@ -78,6 +78,19 @@ func (KTCloudVpcDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	drvCapabilityInfo.RDBMSHandler = false
 	drvCapabilityInfo.PublicIPHandler = false
 	drvCapabilityInfo.NICHandler = false
+	drvCapabilityInfo.VM_USER_DATA = true
+	drvCapabilityInfo.SPOT_VM = false
+	drvCapabilityInfo.VM_SPEC_CHANGE = false
+	drvCapabilityInfo.VM_SPEC_CHANGE_NEEDS_STOP = false
+
+	drvCapabilityInfo.SG_RULE_DESCRIPTION = false
+	drvCapabilityInfo.SG_RULE_IPV6_CIDR = false
+	drvCapabilityInfo.SG_RULE_SOURCE_SG = false
+
+	drvCapabilityInfo.NLB_MULTI_LISTENER = false
+	drvCapabilityInfo.NLB_HTTPS_CERTIFICATE = false
+	drvCapabilityInfo.NLB_HEALTH_CHECK_PATH = false
+	drvCapabilityInfo.NLB_HTTP_PROTOCOL = false
 
 	return drvCapabilityInfo
 }
@@ -158,6 +158,22 @@ func (cloudConn *KTCloudVpcConnection) CreateNICHandler() (irs.NICHandler, error
 	return &handler, nil
 }
 
+func (cloudConn *KTCloudVpcConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, fmt.Errorf("KT Cloud VPC Driver: VPCPeeringHandler not supported")
+}
+
+func (cloudConn *KTCloudVpcConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, fmt.Errorf("KT Cloud VPC Driver: RouteTableHandler not supported")
+}
+
+func (cloudConn *KTCloudVpcConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, fmt.Errorf("KT Cloud VPC Driver: NATGatewayHandler not supported")
+}
+
+func (cloudConn *KTCloudVpcConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, fmt.Errorf("KT Cloud VPC Driver: DiskSnapshotHandler not supported")
+}
+
 func (cloudConn *KTCloudVpcConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
 	cblogger.Info("KT Cloud VPC Driver: called CreatePublicIPHandler()!")
 	handler := ktvpcrs.KTVpcPublicIPHandler{
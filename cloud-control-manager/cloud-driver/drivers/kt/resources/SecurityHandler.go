@@ -77,6 +77,11 @@ type Security_Rule struct {
 }
 
 func (securityHandler *KTVpcSecurityHandler) CreateSecurity(securityReqInfo irs.SecurityReqInfo) (irs.SecurityInfo, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityReqInfo.SecurityRules, false, false, false); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	cblogger.Info("KT Cloud VPC driver: called CreateSecurity()!")
 	callLogInfo := getCallLogScheme(securityHandler.RegionInfo.Zone, call.SECURITYGROUP, securityReqInfo.IId.NameId, "CreateSecurity()")
 
@@ -280,7 +280,15 @@ func (vmHandler *KTVpcVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, e
 		}
 	}
 
-	vmCreateOpts.UserData = []byte(*initUserData) // Apply cloud-init script
+	// Merge user's UserData with the cloud-init script
+	mergedUserData, mergeErr := keycommon.MergeUserData(*initUserData, vmReqInfo.UserData)
+	if mergeErr != nil {
+		newErr := fmt.Errorf("Failed to Merge the UserData with the Cloud-Init Script : [%v]", mergeErr)
+		cblogger.Error(newErr.Error())
+		loggingError(callLogInfo, newErr)
+		return irs.VMInfo{}, newErr
+	}
+	vmCreateOpts.UserData = []byte(mergedUserData) // Apply cloud-init script
 	createOpts.CreateOptsBuilder = vmCreateOpts
 
 	// cblogger.Infof("# Image ID : [%s]", vmReqInfo.ImageIID.SystemId)
@@ -780,6 +788,10 @@ func (vmHandler *KTVpcVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, error
 	return irs.Terminating, nil
 }
 
+func (vmHandler *KTVpcVMHandler) ChangeVMSpec(vmIID irs.IID, vmSpecName string) (irs.VMInfo, error) {
+	return irs.VMInfo{}, fmt.Errorf("KT Cloud VPC Driver Does not support ChangeVMSpec() yet!!")
+}
+
 func (vmHandler *KTVpcVMHandler) GetVMStatus(vmIID irs.IID) (irs.VMStatus, error) {
 	cblogger.Info("KT Cloud VPC Driver: called GetVMStatus()")
 	callLogInfo := getCallLogScheme(vmHandler.RegionInfo.Zone, call.VM, vmIID.SystemId, "GetVMStatus()")
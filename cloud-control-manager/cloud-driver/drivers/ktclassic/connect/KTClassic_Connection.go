@@ -149,6 +149,22 @@ func (cloudConn *KtCloudConnection) CreateNICHandler() (irs.NICHandler, error) {
 	return nil, fmt.Errorf("KT Classic Cloud Driver: NICHandler not supported")
 }
 
+func (cloudConn *KtCloudConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, errors.New("KT Classic Cloud Driver: VPCPeeringHandler not supported")
+}
+
+func (cloudConn *KtCloudConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, errors.New("KT Classic Cloud Driver: RouteTableHandler not supported")
+}
+
+func (cloudConn *KtCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, errors.New("KT Classic Cloud Driver: NATGatewayHandler not supported")
+}
+
+func (cloudConn *KtCloudConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, errors.New("KT Classic Cloud Driver: DiskSnapshotHandler not supported")
+}
+
 func (cloudConn *KtCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
 	return nil, fmt.Errorf("KT Classic Cloud Driver: PublicIPHandler not supported")
 }
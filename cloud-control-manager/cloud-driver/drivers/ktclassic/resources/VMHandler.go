@@ -235,6 +235,16 @@ func (vmHandler *KtCloudVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 	}
 	// cblogger.Infof("init UserData : [%s]", *initUserData)
 
+	// Merge user's UserData with the cloud-init script
+	mergedUserData, mergeErr := keycommon.MergeUserData(*initUserData, vmReqInfo.UserData)
+	if mergeErr != nil {
+		newErr := fmt.Errorf("Failed to Merge the UserData with the Cloud-Init Script : [%v]", mergeErr)
+		cblogger.Error(newErr.Error())
+		LoggingError(callLogInfo, newErr)
+		return irs.VMInfo{}, newErr
+	}
+	initUserData = &mergedUserData
+
 	// # To Check if the Requested S/G exits
 	var sgSystemIDs []string
 	for _, sgIID := range vmReqInfo.SecurityGroupIIDs {
@@ -1123,6 +1133,10 @@ func (vmHandler *KtCloudVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, err
 	}
 }
 
+func (vmHandler *KtCloudVMHandler) ChangeVMSpec(vmIID irs.IID, vmSpecName string) (irs.VMInfo, error) {
+	return irs.VMInfo{}, fmt.Errorf("KT Cloud Driver Does not support ChangeVMSpec() yet!!")
+}
+
 /*
 # KT Cloud serverInstanceStatusName ??
 Stopped
@@ -49,14 +49,32 @@ func (MockDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	drvCapabilityInfo.KeyPairHandler = true
 	drvCapabilityInfo.VMHandler = true
 	drvCapabilityInfo.DiskHandler = true
+	drvCapabilityInfo.DiskSnapshotHandler = true
 	drvCapabilityInfo.MyImageHandler = true
 	drvCapabilityInfo.NLBHandler = true
 	drvCapabilityInfo.ClusterHandler = true
+	drvCapabilityInfo.VPCPeeringHandler = true
+	drvCapabilityInfo.RouteTableHandler = true
+	drvCapabilityInfo.NATGatewayHandler = true
+	drvCapabilityInfo.RDBMSHandler = true
 
 	drvCapabilityInfo.TagHandler = true
 	drvCapabilityInfo.TagSupportResourceType = []ires.RSType{ires.VPC, ires.SUBNET, ires.SG, ires.KEY, ires.VM, ires.NLB, ires.DISK, ires.MYIMAGE, ires.CLUSTER}
 
 	drvCapabilityInfo.VPC_CIDR = true
+	drvCapabilityInfo.VM_USER_DATA = true
+	drvCapabilityInfo.SPOT_VM = true
+	drvCapabilityInfo.VM_SPEC_CHANGE = true
+	drvCapabilityInfo.VM_SPEC_CHANGE_NEEDS_STOP = true
+
+	drvCapabilityInfo.SG_RULE_DESCRIPTION = true
+	drvCapabilityInfo.SG_RULE_IPV6_CIDR = true
+	drvCapabilityInfo.SG_RULE_SOURCE_SG = true
+
+	drvCapabilityInfo.NLB_MULTI_LISTENER = true
+	drvCapabilityInfo.NLB_HTTPS_CERTIFICATE = true
+	drvCapabilityInfo.NLB_HEALTH_CHECK_PATH = true
+	drvCapabilityInfo.NLB_HTTP_PROTOCOL = true
 
 	return drvCapabilityInfo
 }
@@ -90,6 +90,12 @@ func (cloudConn *MockConnection) CreateDiskHandler() (irs.DiskHandler, error) {
 	return &handler, nil
 }
 
+func (cloudConn *MockConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	cblogger.Info("Mock Driver: called CreateDiskSnapshotHandler()!")
+	handler := mkrs.MockDiskSnapshotHandler{cloudConn.MockName}
+	return &handler, nil
+}
+
 func (cloudConn *MockConnection) CreateClusterHandler() (irs.ClusterHandler, error) {
 	cblogger.Info("Mock Driver: called CreateClusterHandler()!")
 	handler := mkrs.MockClusterHandler{cloudConn.MockName}
@@ -137,13 +143,33 @@ func (cloudConn *MockConnection) CreateMonitoringHandler() (irs.MonitoringHandle
 }
 
 func (cloudConn *MockConnection) CreateRDBMSHandler() (irs.RDBMSHandler, error) {
-	return nil, fmt.Errorf("Mock Driver: RDBMSHandler not supported")
+	cblogger.Info("Mock Driver: called CreateRDBMSHandler()!")
+	handler := mkrs.MockRDBMSHandler{MockName: cloudConn.MockName}
+	return &handler, nil
 }
 
 func (cloudConn *MockConnection) CreateNICHandler() (irs.NICHandler, error) {
 	return nil, fmt.Errorf("Mock Driver: NICHandler not supported")
 }
 
+func (cloudConn *MockConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	cblogger.Info("Mock Driver: called CreateVPCPeeringHandler()!")
+	handler := mkrs.MockVPCPeeringHandler{MockName: cloudConn.MockName}
+	return &handler, nil
+}
+
+func (cloudConn *MockConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	cblogger.Info("Mock Driver: called CreateRouteTableHandler()!")
+	handler := mkrs.MockRouteTableHandler{MockName: cloudConn.MockName}
+	return &handler, nil
+}
+
+func (cloudConn *MockConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	cblogger.Info("Mock Driver: called CreateNATGatewayHandler()!")
+	handler := mkrs.MockNATGatewayHandler{MockName: cloudConn.MockName}
+	return &handler, nil
+}
+
 func (cloudConn *MockConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
 	return nil, fmt.Errorf("Mock Driver: PublicIPHandler not supported")
 }
@@ -44,6 +44,20 @@ func (diskHandler *MockDiskHandler) CreateDisk(diskReqInfo irs.DiskInfo) (irs.Di
 	diskReqInfo.Status = irs.DiskAvailable
 	diskReqInfo.CreatedTime = time.Now()
 
+	// restore from a DiskSnapshot
+	if diskReqInfo.SourceSnapshotIID.NameId != "" || diskReqInfo.SourceSnapshotIID.SystemId != "" {
+		diskSnapshotMapLock.RLock()
+		snapshotInfo, ok := getMockDiskSnapshot(mockName, diskReqInfo.SourceSnapshotIID)
+		diskSnapshotMapLock.RUnlock()
+		if !ok {
+			return irs.DiskInfo{}, fmt.Errorf("%s DiskSnapshot does not exist!!", diskReqInfo.SourceSnapshotIID.NameId)
+		}
+		diskReqInfo.SourceSnapshotIID = snapshotInfo.IId
+		if diskReqInfo.DiskSize == "default" || diskReqInfo.DiskSize == "" {
+			diskReqInfo.DiskSize = snapshotInfo.SnapshotSize
+		}
+	}
+
 	if diskReqInfo.DiskType == "default" || diskReqInfo.DiskType == "" {
 		diskReqInfo.DiskType = "SSD"
 	}
@@ -88,14 +102,16 @@ func CloneDiskInfo(srcInfo irs.DiskInfo) irs.DiskInfo {
 
 	// clone DiskInfo
 	clonedInfo := irs.DiskInfo{
-		IId:          irs.IID{srcInfo.IId.NameId, srcInfo.IId.SystemId},
-		DiskType:     srcInfo.DiskType,
-		DiskSize:     srcInfo.DiskSize,
-		Status:       srcInfo.Status,
-		OwnerVM:      irs.IID{srcInfo.OwnerVM.NameId, srcInfo.OwnerVM.SystemId},
-		CreatedTime:  srcInfo.CreatedTime,
-		TagList:      srcInfo.TagList,      // clone TagList
-		KeyValueList: srcInfo.KeyValueList, // now, do not need cloning
+		IId:               irs.IID{srcInfo.IId.NameId, srcInfo.IId.SystemId},
+		Zone:              srcInfo.Zone,
+		DiskType:          srcInfo.DiskType,
+		DiskSize:          srcInfo.DiskSize,
+		Status:            srcInfo.Status,
+		OwnerVM:           irs.IID{srcInfo.OwnerVM.NameId, srcInfo.OwnerVM.SystemId},
+		SourceSnapshotIID: irs.IID{srcInfo.SourceSnapshotIID.NameId, srcInfo.SourceSnapshotIID.SystemId},
+		CreatedTime:       srcInfo.CreatedTime,
+		TagList:           srcInfo.TagList,      // clone TagList
+		KeyValueList:      srcInfo.KeyValueList, // now, do not need cloning
 	}
 
 	return clonedInfo
@@ -0,0 +1,181 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Mock Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	cblog "github.com/cloud-barista/cb-log"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	_ "github.com/sirupsen/logrus"
+)
+
+var diskSnapshotInfoMap map[string][]*irs.DiskSnapshotInfo
+
+type MockDiskSnapshotHandler struct {
+	MockName string
+}
+
+func init() {
+	// cblog is a global variable.
+	diskSnapshotInfoMap = make(map[string][]*irs.DiskSnapshotInfo)
+}
+
+var diskSnapshotMapLock = new(sync.RWMutex)
+
+// (1) get the source diskInfo
+// (2) create diskSnapshotInfo object
+// (3) insert diskSnapshotInfo into global Map
+func (snapshotHandler *MockDiskSnapshotHandler) CreateSnapshot(snapshotReqInfo irs.DiskSnapshotInfo) (irs.DiskSnapshotInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called CreateSnapshot()!")
+
+	mockName := snapshotHandler.MockName
+
+	// (1) get the source diskInfo
+	diskHandler := MockDiskHandler{mockName}
+	diskInfo, err := diskHandler.GetDisk(snapshotReqInfo.SourceDisk)
+	if err != nil {
+		return irs.DiskSnapshotInfo{}, err
+	}
+
+	// (2) create diskSnapshotInfo object
+	snapshotReqInfo.IId.SystemId = snapshotReqInfo.IId.NameId
+	snapshotReqInfo.SourceDisk = diskInfo.IId
+	snapshotReqInfo.Zone = diskInfo.Zone
+	snapshotReqInfo.SnapshotSize = diskInfo.DiskSize
+	snapshotReqInfo.Status = irs.DiskSnapshotAvailable
+	snapshotReqInfo.CreatedTime = time.Now()
+
+	// (3) insert diskSnapshotInfo into global Map
+	diskSnapshotMapLock.Lock()
+	defer diskSnapshotMapLock.Unlock()
+	infoList, _ := diskSnapshotInfoMap[mockName]
+	for _, info := range infoList {
+		if info.IId.NameId == snapshotReqInfo.IId.NameId {
+			return irs.DiskSnapshotInfo{}, fmt.Errorf("%s DiskSnapshot already exists!!", snapshotReqInfo.IId.NameId)
+		}
+	}
+	infoList = append(infoList, &snapshotReqInfo)
+	diskSnapshotInfoMap[mockName] = infoList
+
+	return CloneDiskSnapshotInfo(snapshotReqInfo), nil
+}
+
+func CloneDiskSnapshotInfoList(srcInfoList []*irs.DiskSnapshotInfo) []*irs.DiskSnapshotInfo {
+	clonedInfoList := []*irs.DiskSnapshotInfo{}
+	for _, srcInfo := range srcInfoList {
+		clonedInfo := CloneDiskSnapshotInfo(*srcInfo)
+		clonedInfoList = append(clonedInfoList, &clonedInfo)
+	}
+	return clonedInfoList
+}
+
+func CloneDiskSnapshotInfo(srcInfo irs.DiskSnapshotInfo) irs.DiskSnapshotInfo {
+	// clone DiskSnapshotInfo
+	clonedInfo := irs.DiskSnapshotInfo{
+		IId:          irs.IID{srcInfo.IId.NameId, srcInfo.IId.SystemId},
+		SourceDisk:   irs.IID{srcInfo.SourceDisk.NameId, srcInfo.SourceDisk.SystemId},
+		Zone:         srcInfo.Zone,
+		SnapshotSize: srcInfo.SnapshotSize,
+		Status:       srcInfo.Status,
+		CreatedTime:  srcInfo.CreatedTime,
+		TagList:      srcInfo.TagList,      // clone TagList
+		KeyValueList: srcInfo.KeyValueList, // now, do not need cloning
+	}
+
+	return clonedInfo
+}
+
+func (snapshotHandler *MockDiskSnapshotHandler) ListSnapshot() ([]*irs.DiskSnapshotInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListSnapshot()!")
+
+	mockName := snapshotHandler.MockName
+	diskSnapshotMapLock.RLock()
+	defer diskSnapshotMapLock.RUnlock()
+	infoList, ok := diskSnapshotInfoMap[mockName]
+	if !ok {
+		return []*irs.DiskSnapshotInfo{}, nil
+	}
+	// cloning list of DiskSnapshot
+	return CloneDiskSnapshotInfoList(infoList), nil
+}
+
+func (snapshotHandler *MockDiskSnapshotHandler) GetSnapshot(iid irs.IID) (irs.DiskSnapshotInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetSnapshot()!")
+
+	mockName := snapshotHandler.MockName
+	diskSnapshotMapLock.RLock()
+	defer diskSnapshotMapLock.RUnlock()
+
+	info, ok := getMockDiskSnapshot(mockName, iid)
+	if !ok {
+		return irs.DiskSnapshotInfo{}, fmt.Errorf("%s DiskSnapshot does not exist!!", iid.NameId)
+	}
+	return CloneDiskSnapshotInfo(*info), nil
+}
+
+func (snapshotHandler *MockDiskSnapshotHandler) DeleteSnapshot(iid irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DeleteSnapshot()!")
+
+	mockName := snapshotHandler.MockName
+
+	diskSnapshotMapLock.Lock()
+	defer diskSnapshotMapLock.Unlock()
+
+	infoList, ok := diskSnapshotInfoMap[mockName]
+	if !ok {
+		return false, fmt.Errorf("%s DiskSnapshot does not exist!!", iid.NameId)
+	}
+
+	for idx, info := range infoList {
+		if info.IId.SystemId == iid.SystemId {
+			infoList = append(infoList[:idx], infoList[idx+1:]...)
+			diskSnapshotInfoMap[mockName] = infoList
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (snapshotHandler *MockDiskSnapshotHandler) ListIID() ([]*irs.IID, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListIID()!")
+
+	mockName := snapshotHandler.MockName
+	diskSnapshotMapLock.RLock()
+	defer diskSnapshotMapLock.RUnlock()
+	infoList, ok := diskSnapshotInfoMap[mockName]
+	if !ok {
+		return []*irs.IID{}, nil
+	}
+
+	iidList := []*irs.IID{}
+	for _, info := range infoList {
+		iidList = append(iidList, &irs.IID{info.IId.NameId, info.IId.SystemId})
+	}
+	return iidList, nil
+}
+
+// caller must hold diskSnapshotMapLock
+func getMockDiskSnapshot(mockName string, iid irs.IID) (*irs.DiskSnapshotInfo, bool) {
+	for _, info := range diskSnapshotInfoMap[mockName] {
+		if info.IId.NameId == iid.NameId || (iid.SystemId != "" && info.IId.SystemId == iid.SystemId) {
+			return info, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,166 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Mock Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	cblog "github.com/cloud-barista/cb-log"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+var natGatewayInfoMap map[string][]*irs.NATGatewayInfo
+
+type MockNATGatewayHandler struct {
+	MockName string
+}
+
+func init() {
+	natGatewayInfoMap = make(map[string][]*irs.NATGatewayInfo)
+}
+
+var natGatewayMapLock = new(sync.RWMutex)
+
+// (1) check the VPC and the Subnet
+// (2) create NATGatewayInfo object with Available
+// (3) insert NATGatewayInfo into global Map
+func (natHandler *MockNATGatewayHandler) CreateNATGateway(natGatewayReqInfo irs.NATGatewayReqInfo) (irs.NATGatewayInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called CreateNATGateway()!")
+
+	mockName := natHandler.MockName
+
+	// (1) check the VPC and the Subnet
+	vpcInfo, err := getMockVPC(mockName, natGatewayReqInfo.VpcIID)
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+	subnetIID, err := getMockSubnetIID(vpcInfo, natGatewayReqInfo.SubnetIID)
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+
+	// (2) create NATGatewayInfo object with Available
+	natInfo := irs.NATGatewayInfo{
+		IId:         irs.IID{NameId: natGatewayReqInfo.IId.NameId, SystemId: natGatewayReqInfo.IId.NameId},
+		VpcIID:      vpcInfo.IId,
+		SubnetIID:   subnetIID,
+		PublicIPIID: natGatewayReqInfo.PublicIPIID,
+		PublicIP:    "4.3.2.1",
+		PrivateIP:   "1.2.3.4",
+		Status:      irs.NATGatewayAvailable,
+		CreatedTime: time.Now(),
+		TagList:     natGatewayReqInfo.TagList,
+	}
+
+	// (3) insert NATGatewayInfo into global Map
+	natGatewayMapLock.Lock()
+	defer natGatewayMapLock.Unlock()
+	for _, info := range natGatewayInfoMap[mockName] {
+		if info.IId.NameId == natInfo.IId.NameId {
+			return irs.NATGatewayInfo{}, fmt.Errorf("%s NATGateway already exists!!", natInfo.IId.NameId)
+		}
+	}
+	natGatewayInfoMap[mockName] = append(natGatewayInfoMap[mockName], &natInfo)
+
+	return natInfo, nil
+}
+
+func getMockSubnetIID(vpcInfo irs.VPCInfo, subnetIID irs.IID) (irs.IID, error) {
+	for _, subnetInfo := range vpcInfo.SubnetInfoList {
+		if subnetInfo.IId.SystemId == subnetIID.SystemId || (subnetIID.SystemId == "" && subnetInfo.IId.NameId == subnetIID.NameId) {
+			return subnetInfo.IId, nil
+		}
+	}
+	return irs.IID{}, fmt.Errorf("%s Subnet does not exist in %s VPC!!", subnetIID.NameId, vpcInfo.IId.NameId)
+}
+
+func CloneNATGatewayInfoList(srcInfoList []*irs.NATGatewayInfo) []*irs.NATGatewayInfo {
+	clonedInfoList := []*irs.NATGatewayInfo{}
+	for _, srcInfo := range srcInfoList {
+		clonedInfo := *srcInfo
+		clonedInfoList = append(clonedInfoList, &clonedInfo)
+	}
+	return clonedInfoList
+}
+
+func (natHandler *MockNATGatewayHandler) ListNATGateway() ([]*irs.NATGatewayInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListNATGateway()!")
+
+	natGatewayMapLock.RLock()
+	defer natGatewayMapLock.RUnlock()
+	infoList, ok := natGatewayInfoMap[natHandler.MockName]
+	if !ok {
+		return []*irs.NATGatewayInfo{}, nil
+	}
+
+	return CloneNATGatewayInfoList(infoList), nil
+}
+
+func (natHandler *MockNATGatewayHandler) GetNATGateway(natGatewayIID irs.IID) (irs.NATGatewayInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetNATGateway()!")
+
+	natGatewayMapLock.RLock()
+	defer natGatewayMapLock.RUnlock()
+
+	info, err := getMockNATGateway(natHandler.MockName, natGatewayIID)
+	if err != nil {
+		return irs.NATGatewayInfo{}, err
+	}
+	return *info, nil
+}
+
+// getMockNATGateway is called with natGatewayMapLock.
+func getMockNATGateway(mockName string, natGatewayIID irs.IID) (*irs.NATGatewayInfo, error) {
+	for _, info := range natGatewayInfoMap[mockName] {
+		if info.IId.SystemId == natGatewayIID.SystemId || (natGatewayIID.SystemId == "" && info.IId.NameId == natGatewayIID.NameId) {
+			return info, nil
+		}
+	}
+	return nil, fmt.Errorf("%s NATGateway does not exist!!", natGatewayIID.NameId)
+}
+
+func (natHandler *MockNATGatewayHandler) DeleteNATGateway(natGatewayIID irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DeleteNATGateway()!")
+
+	natGatewayMapLock.Lock()
+	defer natGatewayMapLock.Unlock()
+
+	mockName := natHandler.MockName
+	infoList := natGatewayInfoMap[mockName]
+	for idx, info := range infoList {
+		if info.IId.SystemId == natGatewayIID.SystemId {
+			natGatewayInfoMap[mockName] = append(infoList[:idx], infoList[idx+1:]...)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("%s NATGateway does not exist!!", natGatewayIID.NameId)
+}
+
+func (natHandler *MockNATGatewayHandler) ListIID() ([]*irs.IID, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListIID()!")
+
+	natGatewayMapLock.RLock()
+	defer natGatewayMapLock.RUnlock()
+
+	iidList := []*irs.IID{}
+	for _, info := range natGatewayInfoMap[natHandler.MockName] {
+		iidList = append(iidList, &irs.IID{NameId: info.IId.NameId, SystemId: info.IId.SystemId})
+	}
+
+	return iidList, nil
+}
@@ -52,6 +52,15 @@ func (nlbHandler *MockNLBHandler) CreateNLB(nlbInfo irs.NLBInfo) (irs.NLBInfo, e
 	nlbInfo.Listener.CspID = nlbInfo.IId.NameId + "-Listener-" + xid.New().String()
 	nlbInfo.VMGroup.CspID = nlbInfo.IId.NameId + "-VMGroup-" + xid.New().String()
 	nlbInfo.HealthChecker.CspID = nlbInfo.IId.NameId + "-HealthChecker-" + xid.New().String()
+	nlbInfo.ListenerRules = CloneListenerRuleInfoList(nlbInfo.ListenerRules)
+	for idx := range nlbInfo.ListenerRules {
+		rule := &nlbInfo.ListenerRules[idx]
+		rule.Listener.IP = nlbInfo.Listener.IP
+		rule.Listener.DNSName = nlbInfo.Listener.DNSName
+		rule.Listener.CspID = nlbInfo.IId.NameId + "-Listener-" + xid.New().String()
+		rule.TargetGroup.CspID = nlbInfo.IId.NameId + "-TargetGroup-" + xid.New().String()
+		rule.HealthChecker.CspID = nlbInfo.IId.NameId + "-HealthChecker-" + xid.New().String()
+	}
 	clonedInfo := CloneNLBInfo(nlbInfo)
 	infoList = append(infoList, &clonedInfo)
 	nlbInfoMap[mockName] = infoList
@@ -84,6 +93,9 @@ func CloneNLBInfo(srcInfo irs.NLBInfo) irs.NLBInfo {
 			VMGroup         VMGroupInfo
 			HealthChecker   HealthCheckerInfo
 
+			//------ Additional Listeners
+			ListenerRules   []ListenerRuleInfo
+
 			CreatedTime     time.Time
 			KeyValueList []KeyValue
 		}
@@ -98,6 +110,7 @@ func CloneNLBInfo(srcInfo irs.NLBInfo) irs.NLBInfo {
 		Listener:      srcInfo.Listener,
 		VMGroup:       srcInfo.VMGroup,
 		HealthChecker: srcInfo.HealthChecker,
+		ListenerRules: CloneListenerRuleInfoList(srcInfo.ListenerRules),
 		CreatedTime:   srcInfo.CreatedTime,
 		TagList:       srcInfo.TagList, // clone TagList
 		KeyValueList:  srcInfo.KeyValueList,
@@ -106,6 +119,21 @@ func CloneNLBInfo(srcInfo irs.NLBInfo) irs.NLBInfo {
 	return clonedInfo
 }
 
+func CloneListenerRuleInfoList(srcInfoList []irs.ListenerRuleInfo) []irs.ListenerRuleInfo {
+	if srcInfoList == nil {
+		return nil
+	}
+	clonedInfoList := []irs.ListenerRuleInfo{}
+	for _, srcInfo := range srcInfoList {
+		clonedInfoList = append(clonedInfoList, irs.ListenerRuleInfo{
+			Listener:      CloneListenerInfo(srcInfo.Listener),
+			TargetGroup:   srcInfo.TargetGroup,
+			HealthChecker: CloneHealthCheckerInfo(srcInfo.HealthChecker),
+		})
+	}
+	return clonedInfoList
+}
+
 func (nlbHandler *MockNLBHandler) ListNLB() ([]*irs.NLBInfo, error) {
 	cblogger := cblog.GetLogger("CB-SPIDER")
 	cblogger.Info("Mock Driver: called ListNLB()!")
@@ -276,6 +304,7 @@ func (nlbHandler *MockNLBHandler) ChangeListener(nlbIID irs.IID, listener irs.Li
 		if info.IId.NameId == nlbIID.NameId {
 			info.Listener.Protocol = listener.Protocol
 			info.Listener.Port = listener.Port
+			info.Listener.CertificateID = listener.CertificateID
 			return CloneListenerInfo(info.Listener), nil
 		}
 	}
@@ -291,18 +320,21 @@ func CloneListenerInfo(srcInfo irs.ListenerInfo) irs.ListenerInfo {
 			Port            string  // 1-65535
 			DNSName         string  // Optional, Auto Generated and attached
 
+			CertificateID   string  // Optional, HTTPS only
+
 			CspID           string  // Optional, May be Used by Driver.
 			KeyValueList []KeyValue
 		}
 	*/
 
 	clonedInfo := irs.ListenerInfo{
-		Protocol:     srcInfo.Protocol,
-		IP:           srcInfo.IP,
-		Port:         srcInfo.Port,
-		DNSName:      srcInfo.DNSName,
-		CspID:        srcInfo.CspID,
-		KeyValueList: srcInfo.KeyValueList,
+		Protocol:      srcInfo.Protocol,
+		IP:            srcInfo.IP,
+		Port:          srcInfo.Port,
+		DNSName:       srcInfo.DNSName,
+		CertificateID: srcInfo.CertificateID,
+		CspID:         srcInfo.CspID,
+		KeyValueList:  srcInfo.KeyValueList,
 	}
 
 	return clonedInfo
@@ -388,6 +420,7 @@ func (nlbHandler *MockNLBHandler) ChangeHealthCheckerInfo(nlbIID irs.IID, health
 			info.HealthChecker.Interval = healthChecker.Interval
 			info.HealthChecker.Timeout = healthChecker.Timeout
 			info.HealthChecker.Threshold = healthChecker.Threshold
+			info.HealthChecker.Path = healthChecker.Path
 			return CloneHealthCheckerInfo(info.HealthChecker), nil
 		}
 	}
@@ -403,6 +436,7 @@ func CloneHealthCheckerInfo(srcInfo irs.HealthCheckerInfo) irs.HealthCheckerInfo
 			Interval        int     // secs, Interval time between health checks.
 			Timeout         int     // secs, Waiting time to decide an unhealthy VM when no response.
 			Threshold       int     // num, The number of continuous health checks to change the VM status.
+			Path            string  // Optional, HTTP|HTTPS only
 
 			CspID           string  // Optional, May be Used by Driver.
 			KeyValueList []KeyValue
@@ -415,6 +449,7 @@ func CloneHealthCheckerInfo(srcInfo irs.HealthCheckerInfo) irs.HealthCheckerInfo
 		Interval:     srcInfo.Interval,
 		Timeout:      srcInfo.Timeout,
 		Threshold:    srcInfo.Threshold,
+		Path:         srcInfo.Path,
 		CspID:        srcInfo.CspID,
 		KeyValueList: srcInfo.KeyValueList,
 	}
@@ -0,0 +1,380 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Mock Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	cblog "github.com/cloud-barista/cb-log"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	_ "github.com/sirupsen/logrus"
+)
+
+var rdbmsInfoMap map[string][]*irs.RDBMSInfo
+var rdbmsSnapshotInfoMap map[string][]*irs.RDBMSSnapshotInfo
+
+type MockRDBMSHandler struct {
+	MockName string
+}
+
+func init() {
+	// cblog is a global variable.
+	rdbmsInfoMap = make(map[string][]*irs.RDBMSInfo)
+	rdbmsSnapshotInfoMap = make(map[string][]*irs.RDBMSSnapshotInfo)
+}
+
+// rdbmsMapLock guards both rdbmsInfoMap and rdbmsSnapshotInfoMap
+var rdbmsMapLock = new(sync.RWMutex)
+
+func (rdbmsHandler *MockRDBMSHandler) GetMetaInfo(dbEngine string) (irs.RDBMSMetaInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetMetaInfo()!")
+
+	engine, err := irs.NormalizeRDBMSEngine(dbEngine)
+	if err != nil {
+		return irs.RDBMSMetaInfo{}, err
+	}
+
+	return irs.RDBMSMetaInfo{
+		DBEngine:                         engine,
+		SupportedVersions:                []string{"8.0"},
+		DBInstanceSpecOptions:            []string{"mock.small", "mock.large"},
+		StorageTypeOptions:               []string{"mock-ssd"},
+		StorageSizeRange:                 irs.StorageSizeRange{Min: 20, Max: 1000},
+		BackupRetentionRange:             "NA",
+		SupportsStorageTypeSelection:     true,
+		SupportsStorageSizeConfiguration: true,
+		SupportsChangeSpec:               true,
+		SupportsChangeStorageSize:        true,
+		SupportsStartStop:                true,
+		SupportsSnapshot:                 true,
+	}, nil
+}
+
+// (1) create rdbmsInfo object
+// (2) insert rdbmsInfo into global Map
+func (rdbmsHandler *MockRDBMSHandler) CreateRDBMS(rdbmsReqInfo irs.RDBMSInfo) (irs.RDBMSInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called CreateRDBMS()!")
+
+	// (1) create rdbmsInfo object
+	rdbmsReqInfo.IId.SystemId = rdbmsReqInfo.IId.NameId
+	if rdbmsReqInfo.DBEngine == "" {
+		rdbmsReqInfo.DBEngine = "mysql"
+	}
+	if rdbmsReqInfo.StorageSize == "" {
+		rdbmsReqInfo.StorageSize = "20"
+	}
+	rdbmsReqInfo.MasterUserPassword = ""
+	rdbmsReqInfo.Endpoint = rdbmsReqInfo.IId.NameId + ".mock.local"
+	rdbmsReqInfo.Status = irs.RDBMSAvailable
+	rdbmsReqInfo.CreatedTime = time.Now()
+
+	// (2) insert rdbmsInfo into global Map
+	return insertMockRDBMS(rdbmsHandler.MockName, rdbmsReqInfo)
+}
+
+// caller must not hold rdbmsMapLock
+func insertMockRDBMS(mockName string, rdbmsInfo irs.RDBMSInfo) (irs.RDBMSInfo, error) {
+	rdbmsMapLock.Lock()
+	defer rdbmsMapLock.Unlock()
+
+	infoList := rdbmsInfoMap[mockName]
+	for _, info := range infoList {
+		if info.IId.NameId == rdbmsInfo.IId.NameId {
+			return irs.RDBMSInfo{}, fmt.Errorf("%s RDBMS already exists!!", rdbmsInfo.IId.NameId)
+		}
+	}
+	rdbmsInfoMap[mockName] = append(infoList, &rdbmsInfo)
+
+	return CloneRDBMSInfo(rdbmsInfo), nil
+}
+
+func CloneRDBMSInfoList(srcInfoList []*irs.RDBMSInfo) []*irs.RDBMSInfo {
+	clonedInfoList := []*irs.RDBMSInfo{}
+	for _, srcInfo := range srcInfoList {
+		clonedInfo := CloneRDBMSInfo(*srcInfo)
+		clonedInfoList = append(clonedInfoList, &clonedInfo)
+	}
+	return clonedInfoList
+}
+
+func CloneRDBMSInfo(srcInfo irs.RDBMSInfo) irs.RDBMSInfo {
+	// clone RDBMSInfo
+	clonedInfo := srcInfo
+	clonedInfo.SubnetIIDs = append([]irs.IID{}, srcInfo.SubnetIIDs...)
+	clonedInfo.SecurityGroupIIDs = append([]irs.IID{}, srcInfo.SecurityGroupIIDs...)
+	clonedInfo.TagList = append([]irs.KeyValue{}, srcInfo.TagList...)
+	clonedInfo.KeyValueList = srcInfo.KeyValueList // now, do not need cloning
+
+	return clonedInfo
+}
+
+func (rdbmsHandler *MockRDBMSHandler) ListRDBMS() ([]*irs.RDBMSInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListRDBMS()!")
+
+	rdbmsMapLock.RLock()
+	defer rdbmsMapLock.RUnlock()
+
+	// cloning list of RDBMS
+	return CloneRDBMSInfoList(rdbmsInfoMap[rdbmsHandler.MockName]), nil
+}
+
+func (rdbmsHandler *MockRDBMSHandler) GetRDBMS(iid irs.IID) (irs.RDBMSInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetRDBMS()!")
+
+	rdbmsMapLock.RLock()
+	defer rdbmsMapLock.RUnlock()
+
+	info, ok := getMockRDBMS(rdbmsHandler.MockName, iid)
+	if !ok {
+		return irs.RDBMSInfo{}, fmt.Errorf("%s RDBMS does not exist!!", iid.NameId)
+	}
+	return CloneRDBMSInfo(*info), nil
+}
+
+func (rdbmsHandler *MockRDBMSHandler) DeleteRDBMS(iid irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DeleteRDBMS()!")
+
+	mockName := rdbmsHandler.MockName
+
+	rdbmsMapLock.Lock()
+	defer rdbmsMapLock.Unlock()
+
+	infoList := rdbmsInfoMap[mockName]
+	for idx, info := range infoList {
+		if info.IId.SystemId == iid.SystemId {
+			rdbmsInfoMap[mockName] = append(infoList[:idx], infoList[idx+1:]...)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("%s RDBMS does not exist!!", iid.NameId)
+}
+
+func (rdbmsHandler *MockRDBMSHandler) ListIID() ([]*irs.IID, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListIID()!")
+
+	rdbmsMapLock.RLock()
+	defer rdbmsMapLock.RUnlock()
+
+	iidList := []*irs.IID{}
+	for _, info := range rdbmsInfoMap[rdbmsHandler.MockName] {
+		iidList = append(iidList, &irs.IID{info.IId.NameId, info.IId.SystemId})
+	}
+	return iidList, nil
+}
+
+func (rdbmsHandler *MockRDBMSHandler) ChangeSpec(iid irs.IID, newSpec string) (irs.RDBMSInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ChangeSpec()!")
+
+	return updateMockRDBMS(rdbmsHandler.MockName, iid, func(info *irs.RDBMSInfo) error {
+		info.DBInstanceSpec = newSpec
+		return nil
+	})
+}
+
+func (rdbmsHandler *MockRDBMSHandler) ChangeStorageSize(iid irs.IID, newSize string) (irs.RDBMSInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ChangeStorageSize()!")
+
+	return updateMockRDBMS(rdbmsHandler.MockName, iid, func(info *irs.RDBMSInfo) error {
+		newSizeGB, err := strconv.Atoi(newSize)
+		if err != nil {
+			return fmt.Errorf("invalid StorageSize '%s': %v", newSize, err)
+		}
+		curSizeGB, _ := strconv.Atoi(info.StorageSize)
+		if newSizeGB <= curSizeGB {
+			return fmt.Errorf("StorageSize %s must be larger than the current size %s", newSize, info.StorageSize)
+		}
+		info.StorageSize = newSize
+		return nil
+	})
+}
+
+func (rdbmsHandler *MockRDBMSHandler) StartRDBMS(iid irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called StartRDBMS()!")
+
+	_, err := updateMockRDBMS(rdbmsHandler.MockName, iid, func(info *irs.RDBMSInfo) error {
+		if info.Status != irs.RDBMSStopped {
+			return fmt.Errorf("%s RDBMS is not Stopped!! status: %s", iid.NameId, info.Status)
+		}
+		info.Status = irs.RDBMSAvailable
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (rdbmsHandler *MockRDBMSHandler) StopRDBMS(iid irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called StopRDBMS()!")
+
+	_, err := updateMockRDBMS(rdbmsHandler.MockName, iid, func(info *irs.RDBMSInfo) error {
+		if info.Status != irs.RDBMSAvailable {
+			return fmt.Errorf("%s RDBMS is not Available!! status: %s", iid.NameId, info.Status)
+		}
+		info.Status = irs.RDBMSStopped
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// caller must not hold rdbmsMapLock
+func updateMockRDBMS(mockName string, iid irs.IID, updateFunc func(info *irs.RDBMSInfo) error) (irs.RDBMSInfo, error) {
+	rdbmsMapLock.Lock()
+	defer rdbmsMapLock.Unlock()
+
+	info, ok := getMockRDBMS(mockName, iid)
+	if !ok {
+		return irs.RDBMSInfo{}, fmt.Errorf("%s RDBMS does not exist!!", iid.NameId)
+	}
+	if err := updateFunc(info); err != nil {
+		return irs.RDBMSInfo{}, err
+	}
+	return CloneRDBMSInfo(*info), nil
+}
+
+func (rdbmsHandler *MockRDBMSHandler) CreateSnapshot(rdbmsIID irs.IID, snapshotName string) (irs.RDBMSSnapshotInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called CreateSnapshot()!")
+
+	mockName := rdbmsHandler.MockName
+
+	rdbmsMapLock.Lock()
+	defer rdbmsMapLock.Unlock()
+
+	rdbmsInfo, ok := getMockRDBMS(mockName, rdbmsIID)
+	if !ok {
+		return irs.RDBMSSnapshotInfo{}, fmt.Errorf("%s RDBMS does not exist!!", rdbmsIID.NameId)
+	}
+
+	infoList := rdbmsSnapshotInfoMap[mockName]
+	for _, info := range infoList {
+		if info.IId.NameId == snapshotName {
+			return irs.RDBMSSnapshotInfo{}, fmt.Errorf("%s RDBMS Snapshot already exists!!", snapshotName)
+		}
+	}
+
+	snapshotInfo := irs.RDBMSSnapshotInfo{
+		IId:             irs.IID{NameId: snapshotName, SystemId: snapshotName},
+		SourceRDBMS:     rdbmsInfo.IId,
+		DBEngine:        rdbmsInfo.DBEngine,
+		DBEngineVersion: rdbmsInfo.DBEngineVersion,
+		StorageSize:     rdbmsInfo.StorageSize,
+		Status:          irs.RDBMSSnapshotAvailable,
+		CreatedTime:     time.Now(),
+	}
+	rdbmsSnapshotInfoMap[mockName] = append(infoList, &snapshotInfo)
+
+	return snapshotInfo, nil
+}
+
+func (rdbmsHandler *MockRDBMSHandler) ListSnapshot(rdbmsIID irs.IID) ([]*irs.RDBMSSnapshotInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListSnapshot()!")
+
+	rdbmsMapLock.RLock()
+	defer rdbmsMapLock.RUnlock()
+
+	infoList := []*irs.RDBMSSnapshotInfo{}
+	for _, info := range rdbmsSnapshotInfoMap[rdbmsHandler.MockName] {
+		if info.SourceRDBMS.SystemId == rdbmsIID.SystemId {
+			clonedInfo := *info
+			infoList = append(infoList, &clonedInfo)
+		}
+	}
+	return infoList, nil
+}
+
+func (rdbmsHandler *MockRDBMSHandler) DeleteSnapshot(snapshotIID irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DeleteSnapshot()!")
+
+	mockName := rdbmsHandler.MockName
+
+	rdbmsMapLock.Lock()
+	defer rdbmsMapLock.Unlock()
+
+	infoList := rdbmsSnapshotInfoMap[mockName]
+	for idx, info := range infoList {
+		if info.IId.SystemId == snapshotIID.SystemId {
+			rdbmsSnapshotInfoMap[mockName] = append(infoList[:idx], infoList[idx+1:]...)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("%s RDBMS Snapshot does not exist!!", snapshotIID.NameId)
+}
+
+func (rdbmsHandler *MockRDBMSHandler) RestoreSnapshot(snapshotIID irs.IID, rdbmsReqInfo irs.RDBMSInfo) (irs.RDBMSInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called RestoreSnapshot()!")
+
+	mockName := rdbmsHandler.MockName
+
+	rdbmsMapLock.RLock()
+	var snapshotInfo *irs.RDBMSSnapshotInfo
+	for _, info := range rdbmsSnapshotInfoMap[mockName] {
+		if info.IId.SystemId == snapshotIID.SystemId {
+			snapshotInfo = info
+			break
+		}
+	}
+	var srcInfo *irs.RDBMSInfo
+	if snapshotInfo != nil {
+		srcInfo, _ = getMockRDBMS(mockName, snapshotInfo.SourceRDBMS)
+	}
+	rdbmsMapLock.RUnlock()
+
+	if snapshotInfo == nil {
+		return irs.RDBMSInfo{}, fmt.Errorf("%s RDBMS Snapshot does not exist!!", snapshotIID.NameId)
+	}
+
+	rdbmsInfo := rdbmsReqInfo
+	rdbmsInfo.IId.SystemId = rdbmsInfo.IId.NameId
+	rdbmsInfo.DBEngine = snapshotInfo.DBEngine
+	rdbmsInfo.DBEngineVersion = snapshotInfo.DBEngineVersion
+	rdbmsInfo.StorageSize = snapshotInfo.StorageSize
+	if srcInfo != nil {
+		if rdbmsInfo.DBInstanceSpec == "" {
+			rdbmsInfo.DBInstanceSpec = srcInfo.DBInstanceSpec
+		}
+		rdbmsInfo.MasterUserName = srcInfo.MasterUserName
+	}
+	rdbmsInfo.Endpoint = rdbmsInfo.IId.NameId + ".mock.local"
+	rdbmsInfo.Status = irs.RDBMSAvailable
+	rdbmsInfo.CreatedTime = time.Now()
+
+	return insertMockRDBMS(mockName, rdbmsInfo)
+}
+
+// caller must hold rdbmsMapLock
+func getMockRDBMS(mockName string, iid irs.IID) (*irs.RDBMSInfo, bool) {
+	for _, info := range rdbmsInfoMap[mockName] {
+		if info.IId.NameId == iid.NameId || (iid.SystemId != "" && info.IId.SystemId == iid.SystemId) {
+			return info, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,327 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Mock Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+import (
+	"fmt"
+	"net"
+	"sync"
+
+	cblog "github.com/cloud-barista/cb-log"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+var routeTableInfoMap map[string][]*irs.RouteTableInfo
+
+type MockRouteTableHandler struct {
+	MockName string
+}
+
+func init() {
+	routeTableInfoMap = make(map[string][]*irs.RouteTableInfo)
+}
+
+var routeTableMapLock = new(sync.RWMutex)
+
+// (1) check the VPC and the routes
+// (2) create RouteTableInfo object with the local route of the VPC
+// (3) insert RouteTableInfo into global Map
+func (rtHandler *MockRouteTableHandler) CreateRouteTable(routeTableReqInfo irs.RouteTableReqInfo) (irs.RouteTableInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called CreateRouteTable()!")
+
+	mockName := rtHandler.MockName
+
+	// (1) check the VPC and the routes
+	vpcInfo, err := getMockVPC(mockName, routeTableReqInfo.VpcIID)
+	if err != nil {
+		return irs.RouteTableInfo{}, err
+	}
+
+	// (2) create RouteTableInfo object with the local route of the VPC
+	rtInfo := irs.RouteTableInfo{
+		IId:       irs.IID{NameId: routeTableReqInfo.IId.NameId, SystemId: routeTableReqInfo.IId.NameId},
+		VpcIID:    vpcInfo.IId,
+		Status:    irs.RouteTableAvailable,
+		RouteList: []irs.RouteInfo{{DestinationCIDR: vpcInfo.IPv4_CIDR, TargetType: irs.RouteTargetLocal}},
+		TagList:   routeTableReqInfo.TagList,
+	}
+	routeList, err := rtHandler.checkRoutes(rtInfo, routeTableReqInfo.RouteList)
+	if err != nil {
+		return irs.RouteTableInfo{}, err
+	}
+	rtInfo.RouteList = append(rtInfo.RouteList, routeList...)
+
+	// (3) insert RouteTableInfo into global Map
+	routeTableMapLock.Lock()
+	defer routeTableMapLock.Unlock()
+	for _, info := range routeTableInfoMap[mockName] {
+		if info.IId.NameId == rtInfo.IId.NameId {
+			return irs.RouteTableInfo{}, fmt.Errorf("%s RouteTable already exists!!", rtInfo.IId.NameId)
+		}
+	}
+	routeTableInfoMap[mockName] = append(routeTableInfoMap[mockName], &rtInfo)
+
+	return CloneRouteTableInfo(rtInfo), nil
+}
+
+// checkRoutes checks the destination and the target of the routes to add.
+func (rtHandler *MockRouteTableHandler) checkRoutes(rtInfo irs.RouteTableInfo, routeList []irs.RouteInfo) ([]irs.RouteInfo, error) {
+	checkedList := []irs.RouteInfo{}
+	for _, route := range routeList {
+		_, ipNet, err := net.ParseCIDR(route.DestinationCIDR)
+		if err != nil {
+			return nil, err
+		}
+		route.DestinationCIDR = ipNet.String()
+		for _, one := range append(rtInfo.RouteList, checkedList...) {
+			if one.DestinationCIDR == route.DestinationCIDR {
+				return nil, fmt.Errorf("the route to %s already exists!!", route.DestinationCIDR)
+			}
+		}
+
+		switch route.TargetType {
+		case irs.RouteTargetInternetGateway:
+			route.TargetIID = irs.IID{}
+		case irs.RouteTargetNATGateway:
+			natGatewayMapLock.RLock()
+			natInfo, err := getMockNATGateway(rtHandler.MockName, route.TargetIID)
+			natGatewayMapLock.RUnlock()
+			if err != nil {
+				return nil, err
+			}
+			route.TargetIID = natInfo.IId
+		case irs.RouteTargetVPCPeering:
+			vpcPeeringMapLock.RLock()
+			peeringInfo, err := (&MockVPCPeeringHandler{MockName: rtHandler.MockName}).getPeering(route.TargetIID)
+			vpcPeeringMapLock.RUnlock()
+			if err != nil {
+				return nil, err
+			}
+			route.TargetIID = peeringInfo.IId
+		case irs.RouteTargetNIC:
+			if route.TargetIID.SystemId == "" {
+				return nil, fmt.Errorf("the NIC of the route to %s is empty!!", route.DestinationCIDR)
+			}
+		default:
+			return nil, fmt.Errorf("%s is not a supported route target type!!", route.TargetType)
+		}
+		checkedList = append(checkedList, route)
+	}
+	return checkedList, nil
+}
+
+func CloneRouteTableInfoList(srcInfoList []*irs.RouteTableInfo) []*irs.RouteTableInfo {
+	clonedInfoList := []*irs.RouteTableInfo{}
+	for _, srcInfo := range srcInfoList {
+		clonedInfo := CloneRouteTableInfo(*srcInfo)
+		clonedInfoList = append(clonedInfoList, &clonedInfo)
+	}
+	return clonedInfoList
+}
+
+func CloneRouteTableInfo(srcInfo irs.RouteTableInfo) irs.RouteTableInfo {
+	clonedInfo := srcInfo
+	clonedInfo.RouteList = append([]irs.RouteInfo{}, srcInfo.RouteList...)
+	if srcInfo.SubnetIIDs != nil {
+		clonedInfo.SubnetIIDs = append([]irs.IID{}, srcInfo.SubnetIIDs...)
+	}
+	return clonedInfo
+}
+
+func (rtHandler *MockRouteTableHandler) getRouteTable(routeTableIID irs.IID) (*irs.RouteTableInfo, error) {
+	for _, info := range routeTableInfoMap[rtHandler.MockName] {
+		if info.IId.SystemId == routeTableIID.SystemId || (routeTableIID.SystemId == "" && info.IId.NameId == routeTableIID.NameId) {
+			return info, nil
+		}
+	}
+	return nil, fmt.Errorf("%s RouteTable does not exist!!", routeTableIID.NameId)
+}
+
+func (rtHandler *MockRouteTableHandler) ListRouteTable() ([]*irs.RouteTableInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListRouteTable()!")
+
+	routeTableMapLock.RLock()
+	defer routeTableMapLock.RUnlock()
+	infoList, ok := routeTableInfoMap[rtHandler.MockName]
+	if !ok {
+		return []*irs.RouteTableInfo{}, nil
+	}
+
+	return CloneRouteTableInfoList(infoList), nil
+}
+
+func (rtHandler *MockRouteTableHandler) GetRouteTable(routeTableIID irs.IID) (irs.RouteTableInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetRouteTable()!")
+
+	routeTableMapLock.RLock()
+	defer routeTableMapLock.RUnlock()
+
+	info, err := rtHandler.getRouteTable(routeTableIID)
+	if err != nil {
+		return irs.RouteTableInfo{}, err
+	}
+	return CloneRouteTableInfo(*info), nil
+}
+
+func (rtHandler *MockRouteTableHandler) DeleteRouteTable(routeTableIID irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DeleteRouteTable()!")
+
+	routeTableMapLock.Lock()
+	defer routeTableMapLock.Unlock()
+
+	mockName := rtHandler.MockName
+	infoList := routeTableInfoMap[mockName]
+	for idx, info := range infoList {
+		if info.IId.SystemId == routeTableIID.SystemId {
+			if len(info.SubnetIIDs) > 0 {
+				return false, fmt.Errorf("%s RouteTable is associated with %d Subnet(s)!!", routeTableIID.NameId, len(info.SubnetIIDs))
+			}
+			routeTableInfoMap[mockName] = append(infoList[:idx], infoList[idx+1:]...)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("%s RouteTable does not exist!!", routeTableIID.NameId)
+}
+
+// ------ Route Management
+func (rtHandler *MockRouteTableHandler) AddRoutes(routeTableIID irs.IID, routeList []irs.RouteInfo) (irs.RouteTableInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called AddRoutes()!")
+
+	routeTableMapLock.Lock()
+	defer routeTableMapLock.Unlock()
+
+	info, err := rtHandler.getRouteTable(routeTableIID)
+	if err != nil {
+		return irs.RouteTableInfo{}, err
+	}
+	checkedList, err := rtHandler.checkRoutes(*info, routeList)
+	if err != nil {
+		return irs.RouteTableInfo{}, err
+	}
+	info.RouteList = append(info.RouteList, checkedList...)
+
+	return CloneRouteTableInfo(*info), nil
+}
+
+func (rtHandler *MockRouteTableHandler) RemoveRoutes(routeTableIID irs.IID, routeList []irs.RouteInfo) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called RemoveRoutes()!")
+
+	routeTableMapLock.Lock()
+	defer routeTableMapLock.Unlock()
+
+	info, err := rtHandler.getRouteTable(routeTableIID)
+	if err != nil {
+		return false, err
+	}
+
+	removeMap := map[string]bool{}
+	for _, route := range routeList {
+		_, ipNet, err := net.ParseCIDR(route.DestinationCIDR)
+		if err != nil {
+			return false, err
+		}
+		removeMap[ipNet.String()] = true
+	}
+
+	remainList := []irs.RouteInfo{}
+	for _, route := range info.RouteList {
+		if removeMap[route.DestinationCIDR] {
+			if route.TargetType == irs.RouteTargetLocal {
+				return false, fmt.Errorf("the local route to %s can not be removed!!", route.DestinationCIDR)
+			}
+			continue
+		}
+		remainList = append(remainList, route)
+	}
+	info.RouteList = remainList
+
+	return true, nil
+}
+
+// ------ Subnet Association
+func (rtHandler *MockRouteTableHandler) AssociateSubnet(routeTableIID irs.IID, subnetIID irs.IID) (irs.RouteTableInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called AssociateSubnet()!")
+
+	routeTableMapLock.Lock()
+	defer routeTableMapLock.Unlock()
+
+	info, err := rtHandler.getRouteTable(routeTableIID)
+	if err != nil {
+		return irs.RouteTableInfo{}, err
+	}
+	vpcInfo, err := getMockVPC(rtHandler.MockName, info.VpcIID)
+	if err != nil {
+		return irs.RouteTableInfo{}, err
+	}
+	subnetIID, err = getMockSubnetIID(vpcInfo, subnetIID)
+	if err != nil {
+		return irs.RouteTableInfo{}, err
+	}
+
+	// replace the previous association
+	for _, one := range routeTableInfoMap[rtHandler.MockName] {
+		one.SubnetIIDs = removeSubnetIID(one.SubnetIIDs, subnetIID)
+	}
+	info.SubnetIIDs = append(info.SubnetIIDs, subnetIID)
+
+	return CloneRouteTableInfo(*info), nil
+}
+
+func (rtHandler *MockRouteTableHandler) DisassociateSubnet(routeTableIID irs.IID, subnetIID irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DisassociateSubnet()!")
+
+	routeTableMapLock.Lock()
+	defer routeTableMapLock.Unlock()
+
+	info, err := rtHandler.getRouteTable(routeTableIID)
+	if err != nil {
+		return false, err
+	}
+	if !containsSubnetIID(info.SubnetIIDs, subnetIID) {
+		return false, fmt.Errorf("%s Subnet is not associated with %s RouteTable!!", subnetIID.NameId, routeTableIID.NameId)
+	}
+	info.SubnetIIDs = removeSubnetIID(info.SubnetIIDs, subnetIID)
+
+	return true, nil
+}
+
+func removeSubnetIID(iidList []irs.IID, iid irs.IID) []irs.IID {
+	remainList := []irs.IID{}
+	for _, one := range iidList {
+		if one.SystemId != iid.SystemId {
+			remainList = append(remainList, one)
+		}
+	}
+	return remainList
+}
+
+func (rtHandler *MockRouteTableHandler) ListIID() ([]*irs.IID, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListIID()!")
+
+	routeTableMapLock.RLock()
+	defer routeTableMapLock.RUnlock()
+
+	iidList := []*irs.IID{}
+	for _, info := range routeTableInfoMap[rtHandler.MockName] {
+		iidList = append(iidList, &irs.IID{NameId: info.IId.NameId, SystemId: info.IId.SystemId})
+	}
+
+	return iidList, nil
+}
@@ -247,6 +247,10 @@ func isEqualRule(a *irs.SecurityRuleInfo, b *irs.SecurityRuleInfo) bool {
 		FromPort   string
 		ToPort     string
 		CIDR       string
+
+		CIDRv6                 string
+		SourceSecurityGroupIID *IID
+		Description            string // not compared
 	}
 	-------------------------------*/
 
@@ -265,6 +269,15 @@ func isEqualRule(a *irs.SecurityRuleInfo, b *irs.SecurityRuleInfo) bool {
 	if a.CIDR != b.CIDR {
 		return false
 	}
+	if a.CIDRv6 != b.CIDRv6 {
+		return false
+	}
+	if (a.SourceSecurityGroupIID == nil) != (b.SourceSecurityGroupIID == nil) {
+		return false
+	}
+	if a.SourceSecurityGroupIID != nil && a.SourceSecurityGroupIID.SystemId != b.SourceSecurityGroupIID.SystemId {
+		return false
+	}
 
 	return true
 }
@@ -11,6 +11,7 @@
 package resources
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -150,6 +151,17 @@ func (vmHandler *MockVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, er
 		return irs.VMInfo{}, err
 	}
 
+	// record user's UserData to check it in tests
+	var keyValueList []irs.KeyValue
+	if vmReqInfo.UserData != "" {
+		keyValueList = append(keyValueList, irs.KeyValue{Key: "UserData", Value: vmReqInfo.UserData})
+	}
+
+	purchaseOption := vmReqInfo.PurchaseOption
+	if purchaseOption == "" {
+		purchaseOption = irs.OnDemandVM
+	}
+
 	// vm creation
 	vmInfo := irs.VMInfo{
 		IId:       vmReqInfo.IId,
@@ -182,8 +194,10 @@ func (vmHandler *MockVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, er
 
 		DataDiskIIDs: validatedDiskIIDs,
 
+		PurchaseOption: purchaseOption,
+
 		TagList:      vmReqInfo.TagList,
-		KeyValueList: nil,
+		KeyValueList: keyValueList,
 	}
 
 	// attach disks
@@ -356,6 +370,52 @@ func (vmHandler *MockVMHandler) TerminateVM(iid irs.IID) (irs.VMStatus, error) {
 	return irs.Terminating, nil
 }
 
+// ChangeVMSpec changes the VMSpec of the suspended VM, like CSPs which require stopping VM.
+func (vmHandler *MockVMHandler) ChangeVMSpec(iid irs.IID, vmSpecName string) (irs.VMInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ChangeVMSpec()!")
+
+	mockName := vmHandler.MockName
+
+	// spec validation
+	vmSpecHandler := MockVMSpecHandler{mockName}
+	validatedSpecInfo, err := vmSpecHandler.GetVMSpec(vmSpecName)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VMInfo{}, err
+	}
+
+	vmMapLock.Lock()
+	defer vmMapLock.Unlock()
+
+	// status validation
+	for _, info := range vmStatusInfoMap[mockName] {
+		if (*info).IId.NameId == iid.NameId && (*info).VmStatus != irs.Suspended {
+			errMSG := iid.NameId + " vm is not suspended!! current status: " + string((*info).VmStatus)
+			cblogger.Error(errMSG)
+			return irs.VMInfo{}, errors.New(errMSG)
+		}
+	}
+
+	infoList, ok := vmInfoMap[mockName]
+	if !ok {
+		errMSG := iid.NameId + " vm iid does not exist!!"
+		cblogger.Error(errMSG)
+		return irs.VMInfo{}, errors.New(errMSG)
+	}
+
+	for _, info := range infoList {
+		if (*info).IId.NameId == iid.NameId {
+			info.VMSpecName = validatedSpecInfo.Name
+			return CloneVMInfo(*info), nil
+		}
+	}
+
+	errMSG := iid.NameId + " vm iid does not exist!!"
+	cblogger.Error(errMSG)
+	return irs.VMInfo{}, errors.New(errMSG)
+}
+
 func (vmHandler *MockVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
 	cblogger := cblog.GetLogger("CB-SPIDER")
 	cblogger.Info("Mock Driver: called ListVMStatus()!")
@@ -527,6 +587,8 @@ func CloneVMInfo(srcInfo irs.VMInfo) irs.VMInfo {
 
 		SSHAccessPoint: srcInfo.SSHAccessPoint,
 
+		PurchaseOption: srcInfo.PurchaseOption,
+
 		TagList:      srcInfo.TagList,      // clone TagList
 		KeyValueList: srcInfo.KeyValueList, // now, do not need cloning
 	}
@@ -0,0 +1,265 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Mock Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	cblog "github.com/cloud-barista/cb-log"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+var vpcPeeringInfoMap map[string][]*irs.VPCPeeringInfo
+
+type MockVPCPeeringHandler struct {
+	MockName string
+}
+
+func init() {
+	vpcPeeringInfoMap = make(map[string][]*irs.VPCPeeringInfo)
+}
+
+var vpcPeeringMapLock = new(sync.RWMutex)
+
+// (1) get the VPCs of both sides
+// (2) create VPCPeeringInfo object with PendingAcceptance
+// (3) insert VPCPeeringInfo into global Map
+func (peeringHandler *MockVPCPeeringHandler) CreateVPCPeering(peeringReqInfo irs.VPCPeeringReqInfo) (irs.VPCPeeringInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called CreateVPCPeering()!")
+
+	mockName := peeringHandler.MockName
+
+	// (1) get the VPCs of both sides
+	requesterVPC, err := getMockVPC(mockName, peeringReqInfo.RequesterVpcIID)
+	if err != nil {
+		return irs.VPCPeeringInfo{}, err
+	}
+	accepterVPC, err := getMockVPC(mockName, peeringReqInfo.AccepterVpcIID)
+	if err != nil {
+		return irs.VPCPeeringInfo{}, err
+	}
+
+	// (2) create VPCPeeringInfo object with PendingAcceptance
+	peeringInfo := irs.VPCPeeringInfo{
+		IId:              irs.IID{NameId: peeringReqInfo.IId.NameId, SystemId: peeringReqInfo.IId.NameId},
+		RequesterVpcIID:  requesterVPC.IId,
+		RequesterVpcCIDR: requesterVPC.IPv4_CIDR,
+		AccepterVpcIID:   accepterVPC.IId,
+		AccepterVpcCIDR:  accepterVPC.IPv4_CIDR,
+		Status:           irs.VPCPeeringPendingAcceptance,
+		CreatedTime:      time.Now(),
+		TagList:          peeringReqInfo.TagList,
+	}
+
+	// (3) insert VPCPeeringInfo into global Map
+	vpcPeeringMapLock.Lock()
+	defer vpcPeeringMapLock.Unlock()
+	for _, info := range vpcPeeringInfoMap[mockName] {
+		if info.IId.NameId == peeringInfo.IId.NameId {
+			return irs.VPCPeeringInfo{}, fmt.Errorf("%s VPCPeering already exists!!", peeringInfo.IId.NameId)
+		}
+	}
+	vpcPeeringInfoMap[mockName] = append(vpcPeeringInfoMap[mockName], &peeringInfo)
+
+	return CloneVPCPeeringInfo(peeringInfo), nil
+}
+
+func getMockVPC(mockName string, vpcIID irs.IID) (irs.VPCInfo, error) {
+	vpcMapLock.RLock()
+	defer vpcMapLock.RUnlock()
+
+	for _, info := range vpcInfoMap[mockName] {
+		if info.IId.SystemId == vpcIID.SystemId || (vpcIID.SystemId == "" && info.IId.NameId == vpcIID.NameId) {
+			return CloneVPCInfo(*info), nil
+		}
+	}
+	return irs.VPCInfo{}, fmt.Errorf("%s VPC does not exist!!", vpcIID.NameId)
+}
+
+func CloneVPCPeeringInfoList(srcInfoList []*irs.VPCPeeringInfo) []*irs.VPCPeeringInfo {
+	clonedInfoList := []*irs.VPCPeeringInfo{}
+	for _, srcInfo := range srcInfoList {
+		clonedInfo := CloneVPCPeeringInfo(*srcInfo)
+		clonedInfoList = append(clonedInfoList, &clonedInfo)
+	}
+	return clonedInfoList
+}
+
+func CloneVPCPeeringInfo(srcInfo irs.VPCPeeringInfo) irs.VPCPeeringInfo {
+	clonedInfo := srcInfo
+	if srcInfo.RoutedSubnetIIDs != nil {
+		clonedInfo.RoutedSubnetIIDs = append([]irs.IID{}, srcInfo.RoutedSubnetIIDs...)
+	}
+	return clonedInfo
+}
+
+func (peeringHandler *MockVPCPeeringHandler) getPeering(peeringIID irs.IID) (*irs.VPCPeeringInfo, error) {
+	for _, info := range vpcPeeringInfoMap[peeringHandler.MockName] {
+		if info.IId.SystemId == peeringIID.SystemId || (peeringIID.SystemId == "" && info.IId.NameId == peeringIID.NameId) {
+			return info, nil
+		}
+	}
+	return nil, fmt.Errorf("%s VPCPeering does not exist!!", peeringIID.NameId)
+}
+
+func (peeringHandler *MockVPCPeeringHandler) AcceptVPCPeering(peeringIID irs.IID) (irs.VPCPeeringInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called AcceptVPCPeering()!")
+
+	vpcPeeringMapLock.Lock()
+	defer vpcPeeringMapLock.Unlock()
+
+	info, err := peeringHandler.getPeering(peeringIID)
+	if err != nil {
+		return irs.VPCPeeringInfo{}, err
+	}
+	info.Status = irs.VPCPeeringActive
+
+	return CloneVPCPeeringInfo(*info), nil
+}
+
+func (peeringHandler *MockVPCPeeringHandler) ListVPCPeering() ([]*irs.VPCPeeringInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListVPCPeering()!")
+
+	vpcPeeringMapLock.RLock()
+	defer vpcPeeringMapLock.RUnlock()
+	infoList, ok := vpcPeeringInfoMap[peeringHandler.MockName]
+	if !ok {
+		return []*irs.VPCPeeringInfo{}, nil
+	}
+
+	return CloneVPCPeeringInfoList(infoList), nil
+}
+
+func (peeringHandler *MockVPCPeeringHandler) GetVPCPeering(peeringIID irs.IID) (irs.VPCPeeringInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetVPCPeering()!")
+
+	vpcPeeringMapLock.RLock()
+	defer vpcPeeringMapLock.RUnlock()
+
+	info, err := peeringHandler.getPeering(peeringIID)
+	if err != nil {
+		return irs.VPCPeeringInfo{}, err
+	}
+	return CloneVPCPeeringInfo(*info), nil
+}
+
+func (peeringHandler *MockVPCPeeringHandler) DeleteVPCPeering(peeringIID irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DeleteVPCPeering()!")
+
+	vpcPeeringMapLock.Lock()
+	defer vpcPeeringMapLock.Unlock()
+
+	mockName := peeringHandler.MockName
+	infoList := vpcPeeringInfoMap[mockName]
+	for idx, info := range infoList {
+		if info.IId.SystemId == peeringIID.SystemId {
+			vpcPeeringInfoMap[mockName] = append(infoList[:idx], infoList[idx+1:]...)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("%s VPCPeering does not exist!!", peeringIID.NameId)
+}
+
+// ------ Route Propagation
+func (peeringHandler *MockVPCPeeringHandler) AddPeeringRoutes(peeringIID irs.IID, subnetIIDs []irs.IID) (irs.VPCPeeringInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called AddPeeringRoutes()!")
+
+	vpcPeeringMapLock.Lock()
+	defer vpcPeeringMapLock.Unlock()
+
+	info, err := peeringHandler.getPeering(peeringIID)
+	if err != nil {
+		return irs.VPCPeeringInfo{}, err
+	}
+	if info.Status != irs.VPCPeeringActive {
+		return irs.VPCPeeringInfo{}, fmt.Errorf("%s VPCPeering is not %s: %s", peeringIID.NameId, irs.VPCPeeringActive, info.Status)
+	}
+
+	// the subnets must belong to one of the peered VPCs
+	subnetMap := map[string]bool{}
+	for _, vpcIID := range []irs.IID{info.RequesterVpcIID, info.AccepterVpcIID} {
+		vpcInfo, err := getMockVPC(peeringHandler.MockName, vpcIID)
+		if err != nil {
+			return irs.VPCPeeringInfo{}, err
+		}
+		for _, subnetInfo := range vpcInfo.SubnetInfoList {
+			subnetMap[subnetInfo.IId.SystemId] = true
+		}
+	}
+	for _, subnetIID := range subnetIIDs {
+		if !subnetMap[subnetIID.SystemId] {
+			return irs.VPCPeeringInfo{}, fmt.Errorf("%s Subnet does not belong to the peered VPCs!!", subnetIID.NameId)
+		}
+	}
+
+	for _, subnetIID := range subnetIIDs {
+		if !containsSubnetIID(info.RoutedSubnetIIDs, subnetIID) {
+			info.RoutedSubnetIIDs = append(info.RoutedSubnetIIDs, irs.IID{NameId: subnetIID.NameId, SystemId: subnetIID.SystemId})
+		}
+	}
+
+	return CloneVPCPeeringInfo(*info), nil
+}
+
+func (peeringHandler *MockVPCPeeringHandler) RemovePeeringRoutes(peeringIID irs.IID, subnetIIDs []irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called RemovePeeringRoutes()!")
+
+	vpcPeeringMapLock.Lock()
+	defer vpcPeeringMapLock.Unlock()
+
+	info, err := peeringHandler.getPeering(peeringIID)
+	if err != nil {
+		return false, err
+	}
+
+	routedList := []irs.IID{}
+	for _, routed := range info.RoutedSubnetIIDs {
+		if !containsSubnetIID(subnetIIDs, routed) {
+			routedList = append(routedList, routed)
+		}
+	}
+	info.RoutedSubnetIIDs = routedList
+
+	return true, nil
+}
+
+func containsSubnetIID(iidList []irs.IID, iid irs.IID) bool {
+	for _, one := range iidList {
+		if one.SystemId == iid.SystemId {
+			return true
+		}
+	}
+	return false
+}
+
+func (peeringHandler *MockVPCPeeringHandler) ListIID() ([]*irs.IID, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListIID()!")
+
+	vpcPeeringMapLock.RLock()
+	defer vpcPeeringMapLock.RUnlock()
+
+	iidList := []*irs.IID{}
+	for _, info := range vpcPeeringInfoMap[peeringHandler.MockName] {
+		iidList = append(iidList, &irs.IID{NameId: info.IId.NameId, SystemId: info.IId.SystemId})
+	}
+
+	return iidList, nil
+}
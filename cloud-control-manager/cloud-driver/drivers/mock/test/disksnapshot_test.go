@@ -0,0 +1,161 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"testing"
+
+	cblog "github.com/cloud-barista/cb-log"
+)
+
+var diskSnapshotHandler irs.DiskSnapshotHandler
+var snapshotDiskHandler irs.DiskHandler
+
+func init() {
+	// make the log level lower to print clearly
+	cblog.SetLevel("error")
+
+	cred := idrv.CredentialInfo{
+		MockName: "MockDriver-DiskSnapshot", // to avoid the conflict with the data of other tests
+	}
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: cred,
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	diskSnapshotHandler, _ = cloudConn.CreateDiskSnapshotHandler()
+	snapshotDiskHandler, _ = cloudConn.CreateDiskHandler()
+}
+
+type DiskSnapshotTestInfo struct {
+	SnapshotId string
+	DiskId     string
+}
+
+var diskSnapshotTestInfoList = []DiskSnapshotTestInfo{
+	{"mock-snapshot-01", "mock-snapshot-disk-01"},
+	{"mock-snapshot-02", "mock-snapshot-disk-01"},
+	{"mock-snapshot-03", "mock-snapshot-disk-02"},
+}
+
+func TestDiskSnapshotCreateList(t *testing.T) {
+	// source disks
+	for _, diskId := range []string{"mock-snapshot-disk-01", "mock-snapshot-disk-02"} {
+		_, err := snapshotDiskHandler.CreateDisk(irs.DiskInfo{IId: irs.IID{NameId: diskId}, DiskSize: "100"})
+		if err != nil {
+			t.Error(err.Error())
+		}
+	}
+
+	// create
+	for _, info := range diskSnapshotTestInfoList {
+		reqInfo := irs.DiskSnapshotInfo{
+			IId:        irs.IID{NameId: info.SnapshotId},
+			SourceDisk: irs.IID{NameId: info.DiskId, SystemId: info.DiskId},
+		}
+		snapshotInfo, err := diskSnapshotHandler.CreateSnapshot(reqInfo)
+		if err != nil {
+			t.Error(err.Error())
+		}
+		if snapshotInfo.SnapshotSize != "100" {
+			t.Errorf("SnapshotSize %s is not same %s", snapshotInfo.SnapshotSize, "100")
+		}
+	}
+
+	// check the list size and values
+	infoList, err := diskSnapshotHandler.ListSnapshot()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if len(infoList) != len(diskSnapshotTestInfoList) {
+		t.Errorf("The number of Infos is not %d. It is %d.", len(diskSnapshotTestInfoList), len(infoList))
+	}
+	for i, info := range infoList {
+		if info.IId.SystemId != diskSnapshotTestInfoList[i].SnapshotId {
+			t.Errorf("System ID %s is not same %s", info.IId.SystemId, diskSnapshotTestInfoList[i].SnapshotId)
+		}
+		if info.SourceDisk.SystemId != diskSnapshotTestInfoList[i].DiskId {
+			t.Errorf("Source Disk %s is not same %s", info.SourceDisk.SystemId, diskSnapshotTestInfoList[i].DiskId)
+		}
+	}
+
+	// a snapshot of a disk that does not exist
+	_, err = diskSnapshotHandler.CreateSnapshot(irs.DiskSnapshotInfo{
+		IId:        irs.IID{NameId: "mock-snapshot-99"},
+		SourceDisk: irs.IID{NameId: "mock-snapshot-disk-99"},
+	})
+	if err == nil {
+		t.Error("The snapshot of a disk that does not exist is created!!")
+	}
+}
+
+func TestDiskCreateFromSnapshot(t *testing.T) {
+	reqInfo := irs.DiskInfo{
+		IId:               irs.IID{NameId: "mock-restored-disk-01"},
+		SourceSnapshotIID: irs.IID{NameId: diskSnapshotTestInfoList[0].SnapshotId, SystemId: diskSnapshotTestInfoList[0].SnapshotId},
+	}
+	diskInfo, err := snapshotDiskHandler.CreateDisk(reqInfo)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if diskInfo.DiskSize != "100" {
+		t.Errorf("DiskSize %s is not same %s", diskInfo.DiskSize, "100")
+	}
+	if diskInfo.SourceSnapshotIID.SystemId != diskSnapshotTestInfoList[0].SnapshotId {
+		t.Errorf("Source Snapshot %s is not same %s", diskInfo.SourceSnapshotIID.SystemId, diskSnapshotTestInfoList[0].SnapshotId)
+	}
+
+	// a snapshot that does not exist
+	reqInfo = irs.DiskInfo{
+		IId:               irs.IID{NameId: "mock-restored-disk-02"},
+		SourceSnapshotIID: irs.IID{NameId: "mock-snapshot-99"},
+	}
+	_, err = snapshotDiskHandler.CreateDisk(reqInfo)
+	if err == nil {
+		t.Error("The disk is created from a snapshot that does not exist!!")
+	}
+}
+
+func TestDiskSnapshotDeleteGet(t *testing.T) {
+	// Get & check the Value
+	info, err := diskSnapshotHandler.GetSnapshot(irs.IID{NameId: diskSnapshotTestInfoList[0].SnapshotId})
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if info.IId.SystemId != diskSnapshotTestInfoList[0].SnapshotId {
+		t.Errorf("System ID %s is not same %s", info.IId.SystemId, diskSnapshotTestInfoList[0].SnapshotId)
+	}
+
+	// delete all
+	infoList, err := diskSnapshotHandler.ListSnapshot()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	for _, info := range infoList {
+		ret, err := diskSnapshotHandler.DeleteSnapshot(info.IId)
+		if err != nil {
+			t.Error(err.Error())
+		}
+		if !ret {
+			t.Errorf("Return is not True!! %s", info.IId.NameId)
+		}
+	}
+	// check the result of Delete Op
+	iidList, err := diskSnapshotHandler.ListIID()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if len(iidList) > 0 {
+		t.Errorf("The number of Infos is not %d. It is %d.", 0, len(iidList))
+	}
+}
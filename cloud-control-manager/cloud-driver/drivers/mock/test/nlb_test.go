@@ -0,0 +1,105 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"testing"
+
+	cblog "github.com/cloud-barista/cb-log"
+)
+
+var nlbHandler irs.NLBHandler
+
+func init() {
+	// make the log level lower to print clearly
+	cblog.SetLevel("error")
+
+	cred := idrv.CredentialInfo{
+		MockName: "MockDriver-01",
+	}
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: cred,
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	nlbHandler, _ = cloudConn.CreateNLBHandler()
+}
+
+func TestNLBListenerRules(t *testing.T) {
+	reqInfo := irs.NLBInfo{
+		IId:           irs.IID{NameId: "mock-nlb-name01"},
+		VpcIID:        irs.IID{NameId: "mock-vpc-name01"},
+		Type:          "PUBLIC",
+		Scope:         "REGION",
+		Listener:      irs.ListenerInfo{Protocol: "HTTPS", Port: "443", CertificateID: "mock-cert-01"},
+		VMGroup:       irs.VMGroupInfo{Protocol: "HTTP", Port: "8080", VMs: &[]irs.IID{{NameId: "mock-vm-name01"}}},
+		HealthChecker: irs.HealthCheckerInfo{Protocol: "HTTP", Port: "8080", Interval: 10, Timeout: 6, Threshold: 3, Path: "/healthz"},
+		ListenerRules: []irs.ListenerRuleInfo{
+			{
+				Listener:      irs.ListenerInfo{Protocol: "TCP", Port: "22"},
+				TargetGroup:   irs.TargetGroupInfo{Protocol: "TCP", Port: "22"},
+				HealthChecker: irs.HealthCheckerInfo{Protocol: "TCP", Port: "22", Interval: 10, Timeout: 10, Threshold: 3},
+			},
+		},
+	}
+	_, err := nlbHandler.CreateNLB(reqInfo)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	info, err := nlbHandler.GetNLB(irs.IID{NameId: "mock-nlb-name01"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if info.Listener.CertificateID != "mock-cert-01" {
+		t.Errorf("CertificateID is not mock-cert-01. It is %s.", info.Listener.CertificateID)
+	}
+	if info.HealthChecker.Path != "/healthz" {
+		t.Errorf("health check Path is not /healthz. It is %s.", info.HealthChecker.Path)
+	}
+	if len(info.ListenerRules) != 1 {
+		t.Fatalf("The number of ListenerRules is not 1. It is %d.", len(info.ListenerRules))
+	}
+	if info.ListenerRules[0].Listener.Port != "22" || info.ListenerRules[0].TargetGroup.CspID == "" {
+		t.Errorf("ListenerRule is not created: %#v", info.ListenerRules[0])
+	}
+
+	// the returned info must not share the ListenerRules with the stored info
+	info.ListenerRules[0].Listener.Port = "2222"
+	info, _ = nlbHandler.GetNLB(irs.IID{NameId: "mock-nlb-name01"})
+	if info.ListenerRules[0].Listener.Port != "22" {
+		t.Errorf("stored ListenerRule is changed: %#v", info.ListenerRules[0])
+	}
+
+	// change
+	listener, err := nlbHandler.ChangeListener(irs.IID{NameId: "mock-nlb-name01"}, irs.ListenerInfo{Protocol: "HTTPS", Port: "443", CertificateID: "mock-cert-02"})
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if listener.CertificateID != "mock-cert-02" {
+		t.Errorf("CertificateID is not mock-cert-02. It is %s.", listener.CertificateID)
+	}
+	healthChecker, err := nlbHandler.ChangeHealthCheckerInfo(irs.IID{NameId: "mock-nlb-name01"},
+		irs.HealthCheckerInfo{Protocol: "HTTP", Port: "8080", Interval: 10, Timeout: 6, Threshold: 3, Path: "/ready"})
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if healthChecker.Path != "/ready" {
+		t.Errorf("health check Path is not /ready. It is %s.", healthChecker.Path)
+	}
+
+	_, err = nlbHandler.DeleteNLB(irs.IID{NameId: "mock-nlb-name01"})
+	if err != nil {
+		t.Error(err.Error())
+	}
+}
@@ -0,0 +1,180 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"testing"
+
+	cblog "github.com/cloud-barista/cb-log"
+)
+
+var rdbmsHandler irs.RDBMSHandler
+
+func init() {
+	// make the log level lower to print clearly
+	cblog.SetLevel("error")
+
+	cred := idrv.CredentialInfo{
+		MockName: "MockDriver-RDBMS", // to avoid the conflict with the data of other tests
+	}
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: cred,
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	rdbmsHandler, _ = cloudConn.CreateRDBMSHandler()
+}
+
+var rdbmsIID = irs.IID{NameId: "mock-rdbms-01", SystemId: "mock-rdbms-01"}
+
+func TestRDBMSMetaInfo(t *testing.T) {
+	metaInfo, err := rdbmsHandler.GetMetaInfo("MySQL")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if metaInfo.DBEngine != "mysql" {
+		t.Errorf("DBEngine %s is not same %s", metaInfo.DBEngine, "mysql")
+	}
+	if !metaInfo.SupportsChangeSpec || !metaInfo.SupportsChangeStorageSize || !metaInfo.SupportsStartStop || !metaInfo.SupportsSnapshot {
+		t.Errorf("The lifecycle operations are not supported: %+v", metaInfo)
+	}
+
+	_, err = rdbmsHandler.GetMetaInfo("oracle")
+	if err == nil {
+		t.Error("The meta info of an unsupported DBEngine is returned!!")
+	}
+}
+
+func TestRDBMSCreateChange(t *testing.T) {
+	reqInfo := irs.RDBMSInfo{
+		IId:            irs.IID{NameId: rdbmsIID.NameId},
+		DBEngine:       "mysql",
+		DBInstanceSpec: "mock.small",
+		StorageSize:    "100",
+		MasterUserName: "admin",
+	}
+	info, err := rdbmsHandler.CreateRDBMS(reqInfo)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if info.IId.SystemId != rdbmsIID.SystemId {
+		t.Errorf("System ID %s is not same %s", info.IId.SystemId, rdbmsIID.SystemId)
+	}
+
+	// change spec
+	info, err = rdbmsHandler.ChangeSpec(rdbmsIID, "mock.large")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if info.DBInstanceSpec != "mock.large" {
+		t.Errorf("DBInstanceSpec %s is not same %s", info.DBInstanceSpec, "mock.large")
+	}
+
+	// expand storage, shrinking is not allowed
+	info, err = rdbmsHandler.ChangeStorageSize(rdbmsIID, "200")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if info.StorageSize != "200" {
+		t.Errorf("StorageSize %s is not same %s", info.StorageSize, "200")
+	}
+	_, err = rdbmsHandler.ChangeStorageSize(rdbmsIID, "100")
+	if err == nil {
+		t.Error("The storage is shrunk!!")
+	}
+}
+
+func TestRDBMSStopStart(t *testing.T) {
+	testList := []struct {
+		control func(irs.IID) (bool, error)
+		status  irs.RDBMSStatus
+		isError bool
+	}{
+		{rdbmsHandler.StartRDBMS, irs.RDBMSAvailable, true}, // already started
+		{rdbmsHandler.StopRDBMS, irs.RDBMSStopped, false},
+		{rdbmsHandler.StopRDBMS, irs.RDBMSStopped, true}, // already stopped
+		{rdbmsHandler.StartRDBMS, irs.RDBMSAvailable, false},
+	}
+
+	for i, test := range testList {
+		_, err := test.control(rdbmsIID)
+		if (err != nil) != test.isError {
+			t.Errorf("#%d: error is %v, expected error: %v", i, err, test.isError)
+		}
+		info, err := rdbmsHandler.GetRDBMS(rdbmsIID)
+		if err != nil {
+			t.Error(err.Error())
+		}
+		if info.Status != test.status {
+			t.Errorf("#%d: Status %s is not same %s", i, info.Status, test.status)
+		}
+	}
+}
+
+func TestRDBMSSnapshotRestore(t *testing.T) {
+	snapshotInfo, err := rdbmsHandler.CreateSnapshot(rdbmsIID, "mock-rdbms-snapshot-01")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if snapshotInfo.SourceRDBMS.SystemId != rdbmsIID.SystemId {
+		t.Errorf("Source RDBMS %s is not same %s", snapshotInfo.SourceRDBMS.SystemId, rdbmsIID.SystemId)
+	}
+	_, err = rdbmsHandler.CreateSnapshot(rdbmsIID, "mock-rdbms-snapshot-01")
+	if err == nil {
+		t.Error("The snapshot with a duplicated name is created!!")
+	}
+
+	infoList, err := rdbmsHandler.ListSnapshot(rdbmsIID)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if len(infoList) != 1 {
+		t.Errorf("The number of Infos is not %d. It is %d.", 1, len(infoList))
+	}
+
+	// restore
+	reqInfo := irs.RDBMSInfo{IId: irs.IID{NameId: "mock-rdbms-02"}}
+	info, err := rdbmsHandler.RestoreSnapshot(snapshotInfo.IId, reqInfo)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if info.StorageSize != "200" || info.DBInstanceSpec != "mock.large" {
+		t.Errorf("The restored RDBMS does not inherit the source: %s, %s", info.StorageSize, info.DBInstanceSpec)
+	}
+
+	// delete all
+	ret, err := rdbmsHandler.DeleteSnapshot(snapshotInfo.IId)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if !ret {
+		t.Errorf("Return is not True!! %s", snapshotInfo.IId.NameId)
+	}
+	_, err = rdbmsHandler.RestoreSnapshot(snapshotInfo.IId, irs.RDBMSInfo{IId: irs.IID{NameId: "mock-rdbms-03"}})
+	if err == nil {
+		t.Error("The RDBMS is restored from a snapshot that does not exist!!")
+	}
+
+	for _, iid := range []irs.IID{rdbmsIID, info.IId} {
+		if _, err := rdbmsHandler.DeleteRDBMS(iid); err != nil {
+			t.Error(err.Error())
+		}
+	}
+	iidList, err := rdbmsHandler.ListIID()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if len(iidList) > 0 {
+		t.Errorf("The number of Infos is not %d. It is %d.", 0, len(iidList))
+	}
+}
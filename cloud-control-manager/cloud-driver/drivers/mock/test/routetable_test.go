@@ -0,0 +1,144 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"testing"
+
+	cblog "github.com/cloud-barista/cb-log"
+)
+
+var routeVPCHandler irs.VPCHandler
+var natGatewayHandler irs.NATGatewayHandler
+var routeTableHandler irs.RouteTableHandler
+
+func init() {
+	// make the log level lower to print clearly
+	cblog.SetLevel("error")
+
+	cred := idrv.CredentialInfo{
+		MockName: "MockDriver-Route",
+	}
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: cred,
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	routeVPCHandler, _ = cloudConn.CreateVPCHandler()
+	natGatewayHandler, _ = cloudConn.CreateNATGatewayHandler()
+	routeTableHandler, _ = cloudConn.CreateRouteTableHandler()
+}
+
+func TestRouteTable(t *testing.T) {
+	vpcIID := irs.IID{NameId: "mock-vpc-route01", SystemId: "mock-vpc-route01"}
+	publicSubnetIID := irs.IID{NameId: "mock-subnet-public01", SystemId: "mock-subnet-public01"}
+	privateSubnetIID := irs.IID{NameId: "mock-subnet-private01", SystemId: "mock-subnet-private01"}
+	_, err := routeVPCHandler.CreateVPC(irs.VPCReqInfo{
+		IId:       irs.IID{NameId: vpcIID.NameId},
+		IPv4_CIDR: "10.30.0.0/16",
+		SubnetInfoList: []irs.SubnetInfo{
+			{IId: irs.IID{NameId: publicSubnetIID.NameId}, IPv4_CIDR: "10.30.1.0/24"},
+			{IId: irs.IID{NameId: privateSubnetIID.NameId}, IPv4_CIDR: "10.30.2.0/24"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	natInfo, err := natGatewayHandler.CreateNATGateway(irs.NATGatewayReqInfo{
+		IId:       irs.IID{NameId: "mock-nat01"},
+		VpcIID:    vpcIID,
+		SubnetIID: publicSubnetIID,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if natInfo.Status != irs.NATGatewayAvailable {
+		t.Errorf("Status is not %s. It is %s.", irs.NATGatewayAvailable, natInfo.Status)
+	}
+	if natInfo.PublicIP == "" {
+		t.Errorf("PublicIP of the NATGateway is empty")
+	}
+
+	rtIID := irs.IID{NameId: "mock-rt01", SystemId: "mock-rt01"}
+	rtInfo, err := routeTableHandler.CreateRouteTable(irs.RouteTableReqInfo{
+		IId:    irs.IID{NameId: rtIID.NameId},
+		VpcIID: vpcIID,
+		RouteList: []irs.RouteInfo{
+			{DestinationCIDR: "0.0.0.0/0", TargetType: irs.RouteTargetNATGateway, TargetIID: natInfo.IId},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	// the local route of the VPC CIDR and the NAT route
+	if len(rtInfo.RouteList) != 2 {
+		t.Errorf("The number of routes is not 2. It is %d.", len(rtInfo.RouteList))
+	}
+
+	if _, err = routeTableHandler.AddRoutes(rtIID, []irs.RouteInfo{
+		{DestinationCIDR: "0.0.0.0/0", TargetType: irs.RouteTargetInternetGateway},
+	}); err == nil {
+		t.Errorf("AddRoutes with a duplicated destination must fail")
+	}
+	if _, err = routeTableHandler.AddRoutes(rtIID, []irs.RouteInfo{
+		{DestinationCIDR: "192.168.0.0/16", TargetType: irs.RouteTargetNATGateway, TargetIID: irs.IID{NameId: "no-nat", SystemId: "no-nat"}},
+	}); err == nil {
+		t.Errorf("AddRoutes to a NATGateway that does not exist must fail")
+	}
+	rtInfo, err = routeTableHandler.AddRoutes(rtIID, []irs.RouteInfo{
+		{DestinationCIDR: "172.16.0.0/12", TargetType: irs.RouteTargetInternetGateway},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rtInfo.RouteList) != 3 {
+		t.Errorf("The number of routes is not 3. It is %d.", len(rtInfo.RouteList))
+	}
+
+	if _, err = routeTableHandler.RemoveRoutes(rtIID, []irs.RouteInfo{{DestinationCIDR: "10.30.0.0/16"}}); err == nil {
+		t.Errorf("RemoveRoutes of the local route must fail")
+	}
+	if _, err = routeTableHandler.RemoveRoutes(rtIID, []irs.RouteInfo{{DestinationCIDR: "172.16.0.0/12"}}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	rtInfo, err = routeTableHandler.AssociateSubnet(rtIID, privateSubnetIID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rtInfo.SubnetIIDs) != 1 {
+		t.Errorf("The number of associated Subnets is not 1. It is %d.", len(rtInfo.SubnetIIDs))
+	}
+
+	// a RouteTable with associated Subnets can not be deleted
+	if _, err = routeTableHandler.DeleteRouteTable(rtIID); err == nil {
+		t.Errorf("DeleteRouteTable with an associated Subnet must fail")
+	}
+	if _, err = routeTableHandler.DisassociateSubnet(rtIID, privateSubnetIID); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	result, err := routeTableHandler.DeleteRouteTable(rtIID)
+	if err != nil || !result {
+		t.Fatalf("failed to delete the route table: %v", err)
+	}
+	result, err = natGatewayHandler.DeleteNATGateway(natInfo.IId)
+	if err != nil || !result {
+		t.Fatalf("failed to delete the NAT gateway: %v", err)
+	}
+	natList, _ := natGatewayHandler.ListNATGateway()
+	if len(natList) != 0 {
+		t.Errorf("The number of NATGateways is not 0. It is %d.", len(natList))
+	}
+}
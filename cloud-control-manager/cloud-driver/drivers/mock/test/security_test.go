@@ -198,3 +198,69 @@ func TestSecurityAddRules(t *testing.T) {
 	// pritn 0 Rule
 	// fmt.Printf("\n\t%#v\n", *info4.SecurityRules)
 }
+
+func TestSecurityRuleOptions(t *testing.T) {
+	infoList, err := securityHandler.ListSecurity()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(infoList) < 2 {
+		t.Fatalf("The number of Infos is less than 2. It is %d.", len(infoList))
+	}
+	sgIID := infoList[0].IId
+	sourceSGIID := infoList[1].IId
+	rulesBefore := len(*infoList[0].SecurityRules)
+
+	//---- Add 3 Rules with the same port: IPv4, IPv6 and source SG
+	SecurityRules := &[]irs.SecurityRuleInfo{
+		{Direction: "inbound", IPProtocol: "tcp", FromPort: "443", ToPort: "443", CIDR: "10.0.0.0/16", Description: "https from vpc"},
+		{Direction: "inbound", IPProtocol: "tcp", FromPort: "443", ToPort: "443", CIDRv6: "2001:db8::/32", Description: "https from ipv6"},
+		{Direction: "inbound", IPProtocol: "tcp", FromPort: "443", ToPort: "443", SourceSecurityGroupIID: &sourceSGIID, Description: "https from sg"},
+	}
+	info, err := securityHandler.AddRules(sgIID, SecurityRules)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(*info.SecurityRules) != rulesBefore+3 {
+		t.Errorf("The number of Rules is not %d. It is %d.", rulesBefore+3, len(*info.SecurityRules))
+	}
+	for _, rule := range (*info.SecurityRules)[rulesBefore:] {
+		if rule.Description == "" {
+			t.Errorf("The Description of the rule %v is dropped.", rule)
+		}
+	}
+
+	//---- Remove the source SG Rule only
+	SecurityRules2 := &[]irs.SecurityRuleInfo{
+		{Direction: "inbound", IPProtocol: "tcp", FromPort: "443", ToPort: "443", SourceSecurityGroupIID: &irs.IID{SystemId: sourceSGIID.SystemId}},
+	}
+	result, err := securityHandler.RemoveRules(sgIID, SecurityRules2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if result != true {
+		t.Fatal("expected the source SG rule to be removed")
+	}
+	info2, err := securityHandler.GetSecurity(sgIID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(*info2.SecurityRules) != rulesBefore+2 {
+		t.Errorf("The number of Rules is not %d. It is %d.", rulesBefore+2, len(*info2.SecurityRules))
+	}
+	for _, rule := range *info2.SecurityRules {
+		if rule.SourceSecurityGroupIID != nil {
+			t.Errorf("The source SG rule %v is not removed.", rule)
+		}
+	}
+
+	//---- a driver without the options rejects them
+	err = irs.CheckSecurityRuleOptions(SecurityRules, true, false, true)
+	if err == nil {
+		t.Error("CheckSecurityRuleOptions() does not reject CIDRv6.")
+	}
+	err = irs.CheckSecurityRuleOptions(SecurityRules, true, true, true)
+	if err != nil {
+		t.Error(err.Error())
+	}
+}
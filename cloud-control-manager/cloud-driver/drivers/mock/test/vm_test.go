@@ -354,3 +354,98 @@ func TestVMTerminateGet(t *testing.T) {
 	}
 
 }
+
+func TestStartSpotVM(t *testing.T) {
+
+	info := vmTestInfoList[0]
+
+	// spot vm creation
+	vmReqInfo := irs.VMReqInfo{
+		IId: irs.IID{"mock-spot-vm-01", ""},
+
+		ImageIID:          irs.IID{info.ImageIID, ""},
+		VpcIID:            irs.IID{info.VpcIID, ""},
+		SubnetIID:         irs.IID{info.SubnetIID, ""},
+		SecurityGroupIIDs: []irs.IID{{info.SecurityGroupIIDs[0], ""}},
+
+		VMSpecName: info.VMSpecName,
+		KeyPairIID: irs.IID{info.KeyPairIID, ""},
+
+		UserData: "#!/bin/bash\necho hello",
+
+		PurchaseOption: irs.SpotVM,
+		SpotMaxPrice:   "0.05",
+	}
+	_, err := vmHandler.StartVM(vmReqInfo)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	// Get & check the Value
+	vmInfo, err := vmHandler.GetVM(vmReqInfo.IId)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if vmInfo.PurchaseOption != irs.SpotVM {
+		t.Errorf("PurchaseOption %s is not same %s", vmInfo.PurchaseOption, irs.SpotVM)
+	}
+	if len(vmInfo.KeyValueList) != 1 || vmInfo.KeyValueList[0].Value != vmReqInfo.UserData {
+		t.Errorf("UserData is not recorded!! %v", vmInfo.KeyValueList)
+	}
+
+	_, err = vmHandler.TerminateVM(vmReqInfo.IId)
+	if err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestChangeVMSpec(t *testing.T) {
+
+	info := vmTestInfoList[0]
+
+	vmReqInfo := irs.VMReqInfo{
+		IId: irs.IID{"mock-spec-vm-01", ""},
+
+		ImageIID:          irs.IID{info.ImageIID, ""},
+		VpcIID:            irs.IID{info.VpcIID, ""},
+		SubnetIID:         irs.IID{info.SubnetIID, ""},
+		SecurityGroupIIDs: []irs.IID{{info.SecurityGroupIIDs[0], ""}},
+
+		VMSpecName: info.VMSpecName,
+		KeyPairIID: irs.IID{info.KeyPairIID, ""},
+	}
+	_, err := vmHandler.StartVM(vmReqInfo)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	// running vm can not be changed
+	_, err = vmHandler.ChangeVMSpec(vmReqInfo.IId, "mock-vmspec-02")
+	if err == nil {
+		t.Errorf("ChangeVMSpec of Running VM should be failed!!")
+	}
+
+	_, err = vmHandler.SuspendVM(vmReqInfo.IId)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	// not existed spec
+	_, err = vmHandler.ChangeVMSpec(vmReqInfo.IId, "mock-vmspec-not-exist")
+	if err == nil {
+		t.Errorf("ChangeVMSpec with not existed VMSpec should be failed!!")
+	}
+
+	vmInfo, err := vmHandler.ChangeVMSpec(vmReqInfo.IId, "mock-vmspec-02")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if vmInfo.VMSpecName != "mock-vmspec-02" {
+		t.Errorf("VMSpecName %s is not same %s", vmInfo.VMSpecName, "mock-vmspec-02")
+	}
+
+	_, err = vmHandler.TerminateVM(vmReqInfo.IId)
+	if err != nil {
+		t.Error(err.Error())
+	}
+}
@@ -0,0 +1,112 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2026.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"testing"
+
+	cblog "github.com/cloud-barista/cb-log"
+)
+
+var peeringVPCHandler irs.VPCHandler
+var vpcPeeringHandler irs.VPCPeeringHandler
+
+func init() {
+	// make the log level lower to print clearly
+	cblog.SetLevel("error")
+
+	cred := idrv.CredentialInfo{
+		MockName: "MockDriver-Peering",
+	}
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: cred,
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	peeringVPCHandler, _ = cloudConn.CreateVPCHandler()
+	vpcPeeringHandler, _ = cloudConn.CreateVPCPeeringHandler()
+}
+
+func TestVPCPeering(t *testing.T) {
+	for _, vpc := range []struct{ name, vpcCIDR, subnetName, subnetCIDR string }{
+		{"mock-vpc-peer01", "10.10.0.0/16", "mock-subnet-peer01", "10.10.1.0/24"},
+		{"mock-vpc-peer02", "10.20.0.0/16", "mock-subnet-peer02", "10.20.1.0/24"},
+	} {
+		_, err := peeringVPCHandler.CreateVPC(irs.VPCReqInfo{
+			IId:            irs.IID{NameId: vpc.name},
+			IPv4_CIDR:      vpc.vpcCIDR,
+			SubnetInfoList: []irs.SubnetInfo{{IId: irs.IID{NameId: vpc.subnetName}, IPv4_CIDR: vpc.subnetCIDR}},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+
+	peeringIID := irs.IID{NameId: "mock-peering01", SystemId: "mock-peering01"}
+	info, err := vpcPeeringHandler.CreateVPCPeering(irs.VPCPeeringReqInfo{
+		IId:             irs.IID{NameId: "mock-peering01"},
+		RequesterVpcIID: irs.IID{NameId: "mock-vpc-peer01", SystemId: "mock-vpc-peer01"},
+		AccepterVpcIID:  irs.IID{NameId: "mock-vpc-peer02", SystemId: "mock-vpc-peer02"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if info.Status != irs.VPCPeeringPendingAcceptance {
+		t.Errorf("Status is not %s. It is %s.", irs.VPCPeeringPendingAcceptance, info.Status)
+	}
+	if info.AccepterVpcCIDR != "10.20.0.0/16" {
+		t.Errorf("AccepterVpcCIDR is not 10.20.0.0/16. It is %s.", info.AccepterVpcCIDR)
+	}
+
+	// routes can be added to an Active peering only
+	subnetIIDs := []irs.IID{{NameId: "mock-subnet-peer01", SystemId: "mock-subnet-peer01"}}
+	if _, err = vpcPeeringHandler.AddPeeringRoutes(peeringIID, subnetIIDs); err == nil {
+		t.Errorf("AddPeeringRoutes of a PendingAcceptance peering must fail")
+	}
+
+	info, err = vpcPeeringHandler.AcceptVPCPeering(peeringIID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if info.Status != irs.VPCPeeringActive {
+		t.Errorf("Status is not %s. It is %s.", irs.VPCPeeringActive, info.Status)
+	}
+
+	info, err = vpcPeeringHandler.AddPeeringRoutes(peeringIID, subnetIIDs)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(info.RoutedSubnetIIDs) != 1 {
+		t.Errorf("The number of RoutedSubnetIIDs is not 1. It is %d.", len(info.RoutedSubnetIIDs))
+	}
+	if _, err = vpcPeeringHandler.AddPeeringRoutes(peeringIID, []irs.IID{{NameId: "no-subnet", SystemId: "no-subnet"}}); err == nil {
+		t.Errorf("AddPeeringRoutes with a Subnet out of the peered VPCs must fail")
+	}
+
+	if _, err = vpcPeeringHandler.RemovePeeringRoutes(peeringIID, subnetIIDs); err != nil {
+		t.Fatal(err.Error())
+	}
+	info, _ = vpcPeeringHandler.GetVPCPeering(peeringIID)
+	if len(info.RoutedSubnetIIDs) != 0 {
+		t.Errorf("The number of RoutedSubnetIIDs is not 0. It is %d.", len(info.RoutedSubnetIIDs))
+	}
+
+	result, err := vpcPeeringHandler.DeleteVPCPeering(peeringIID)
+	if err != nil || !result {
+		t.Fatalf("failed to delete the peering: %v", err)
+	}
+	infoList, _ := vpcPeeringHandler.ListVPCPeering()
+	if len(infoList) != 0 {
+		t.Errorf("The number of VPCPeerings is not 0. It is %d.", len(infoList))
+	}
+}
@@ -227,6 +227,22 @@ func (cloudConn *NcpVpcCloudConnection) CreateNICHandler() (irs.NICHandler, erro
 	return &handler, nil
 }
 
+func (cloudConn *NcpVpcCloudConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, fmt.Errorf("NCP VPC Cloud Driver: VPCPeeringHandler not supported")
+}
+
+func (cloudConn *NcpVpcCloudConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, fmt.Errorf("NCP VPC Cloud Driver: RouteTableHandler not supported")
+}
+
+func (cloudConn *NcpVpcCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, fmt.Errorf("NCP VPC Cloud Driver: NATGatewayHandler not supported")
+}
+
+func (cloudConn *NcpVpcCloudConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, fmt.Errorf("NCP VPC Cloud Driver: DiskSnapshotHandler not supported")
+}
+
 func (cloudConn *NcpVpcCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
 	cblogger.Info("NCP VPC Cloud Driver: called CreatePublicIPHandler()!")
 	handler := ncprs.NcpVpcPublicIPHandler{
@@ -661,6 +661,38 @@ func (handler *NcpVpcRDBMSHandler) DeleteRDBMS(rdbmsIID irs.IID) (bool, error) {
 	return true, nil
 }
 
+func (handler *NcpVpcRDBMSHandler) ChangeSpec(rdbmsIID irs.IID, newSpec string) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("NCP Driver Does not support ChangeSpec() yet!!")
+}
+
+func (handler *NcpVpcRDBMSHandler) ChangeStorageSize(rdbmsIID irs.IID, newSize string) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("NCP Driver Does not support ChangeStorageSize() yet!!")
+}
+
+func (handler *NcpVpcRDBMSHandler) StartRDBMS(rdbmsIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("NCP Driver Does not support StartRDBMS() yet!!")
+}
+
+func (handler *NcpVpcRDBMSHandler) StopRDBMS(rdbmsIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("NCP Driver Does not support StopRDBMS() yet!!")
+}
+
+func (handler *NcpVpcRDBMSHandler) CreateSnapshot(rdbmsIID irs.IID, snapshotName string) (irs.RDBMSSnapshotInfo, error) {
+	return irs.RDBMSSnapshotInfo{}, fmt.Errorf("NCP Driver Does not support CreateSnapshot() yet!!")
+}
+
+func (handler *NcpVpcRDBMSHandler) ListSnapshot(rdbmsIID irs.IID) ([]*irs.RDBMSSnapshotInfo, error) {
+	return nil, fmt.Errorf("NCP Driver Does not support ListSnapshot() yet!!")
+}
+
+func (handler *NcpVpcRDBMSHandler) DeleteSnapshot(snapshotIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("NCP Driver Does not support DeleteSnapshot() yet!!")
+}
+
+func (handler *NcpVpcRDBMSHandler) RestoreSnapshot(snapshotIID irs.IID, rdbmsReqInfo irs.RDBMSInfo) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("NCP Driver Does not support RestoreSnapshot() yet!!")
+}
+
 // ---- Helper functions ----
 
 // extractStorageSizeFromProductCode parses storage size (GB) from NCP product code.
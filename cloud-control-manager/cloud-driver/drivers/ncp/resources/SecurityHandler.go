@@ -42,6 +42,11 @@ func init() {
 }
 
 func (securityHandler *NcpVpcSecurityHandler) CreateSecurity(securityReqInfo irs.SecurityReqInfo) (irs.SecurityInfo, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityReqInfo.SecurityRules, false, false, false); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	cblogger.Info("NCP VPC cloud driver: called CreateSecurity()!")
 
 	InitLog() // Caution!!
@@ -491,6 +496,11 @@ func (securityHandler *NcpVpcSecurityHandler) DeleteSecurity(securityIID irs.IID
 }
 
 func (securityHandler *NcpVpcSecurityHandler) AddRules(sgIID irs.IID, securityRules *[]irs.SecurityRuleInfo) (irs.SecurityInfo, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityRules, false, false, false); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	cblogger.Info("NCP VPC cloud driver: called AddRules()!")
 
 	InitLog() // Caution!!
@@ -769,6 +779,11 @@ func (securityHandler *NcpVpcSecurityHandler) AddRules(sgIID irs.IID, securityRu
 }
 
 func (securityHandler *NcpVpcSecurityHandler) RemoveRules(sgIID irs.IID, securityRules *[]irs.SecurityRuleInfo) (bool, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityRules, false, false, false); err != nil {
+		return false, err
+	}
+
 	cblogger.Info("NCP VPC cloud driver: called RemoveRules()!")
 
 	InitLog() // Caution!!
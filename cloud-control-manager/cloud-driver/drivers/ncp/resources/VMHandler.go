@@ -142,6 +142,12 @@ func (vmHandler *NcpVpcVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 			return irs.VMInfo{}, newErr
 		}
 		if isPublicWindowsImage {
+			if vmReqInfo.UserData != "" {
+				newErr := fmt.Errorf("UserData is not supported for Windows VM")
+				cblogger.Error(newErr.Error())
+				LoggingError(callLogInfo, newErr)
+				return irs.VMInfo{}, newErr
+			}
 			var createErr error
 			initScriptNo, createErr = vmHandler.createWinInitScript(vmReqInfo.VMUserPasswd)
 			if createErr != nil {
@@ -152,7 +158,7 @@ func (vmHandler *NcpVpcVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 			}
 		} else {
 			var createErr error
-			initScriptNo, createErr = vmHandler.createLinuxInitScript(vmReqInfo.ImageIID, keyPairId)
+			initScriptNo, createErr = vmHandler.createLinuxInitScript(vmReqInfo.ImageIID, keyPairId, vmReqInfo.UserData)
 			if createErr != nil {
 				newErr := fmt.Errorf("Failed to Create Cloud-Init Script with the KeyPairId : [%v]", createErr)
 				cblogger.Error(newErr.Error())
@@ -250,6 +256,12 @@ func (vmHandler *NcpVpcVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 			return irs.VMInfo{}, newErr
 		}
 		if isMyWindowsImage {
+			if vmReqInfo.UserData != "" {
+				newErr := fmt.Errorf("UserData is not supported for Windows VM")
+				cblogger.Error(newErr.Error())
+				LoggingError(callLogInfo, newErr)
+				return irs.VMInfo{}, newErr
+			}
 			var createErr error
 			initScriptNo, createErr = vmHandler.createWinInitScript(vmReqInfo.VMUserPasswd)
 			if createErr != nil {
@@ -260,7 +272,7 @@ func (vmHandler *NcpVpcVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 			}
 		} else {
 			var createErr error
-			initScriptNo, createErr = vmHandler.createLinuxInitScript(vmReqInfo.ImageIID, keyPairId)
+			initScriptNo, createErr = vmHandler.createLinuxInitScript(vmReqInfo.ImageIID, keyPairId, vmReqInfo.UserData)
 			if createErr != nil {
 				newErr := fmt.Errorf("Failed to Create Cloud-Init Script with the KeyPairId : [%v]", createErr)
 				cblogger.Error(newErr.Error())
@@ -811,6 +823,10 @@ func (vmHandler *NcpVpcVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, erro
 	}
 }
 
+func (vmHandler *NcpVpcVMHandler) ChangeVMSpec(vmIID irs.IID, vmSpecName string) (irs.VMInfo, error) {
+	return irs.VMInfo{}, fmt.Errorf("NCP Driver Does not support ChangeVMSpec() yet!!")
+}
+
 /*
 # NCP serverInstanceStatusName
 init
@@ -1244,7 +1260,7 @@ func (vmHandler *NcpVpcVMHandler) probePublicImageTypeQuiet(imageNo string) (boo
 	return strings.EqualFold(ncloud.StringValue(image.ServerImageType.Code), "NCP"), true, nil
 }
 
-func (vmHandler *NcpVpcVMHandler) createLinuxInitScript(imageIID irs.IID, keyPairId string) (*string, error) {
+func (vmHandler *NcpVpcVMHandler) createLinuxInitScript(imageIID irs.IID, keyPairId string, userData string) (*string, error) {
 	cblogger.Info("NCP VPC Cloud driver: called createLinuxInitScript()!!")
 
 	var originImagePlatform string
@@ -1349,6 +1365,14 @@ func (vmHandler *NcpVpcVMHandler) createLinuxInitScript(imageIID irs.IID, keyPai
 	cmdString = strings.ReplaceAll(cmdString, "{{public_key}}", keyValue.Value)
 	// cblogger.Info("cmdString : ", cmdString)
 
+	// Append user's UserData script
+	cmdString, err = keycommon.AppendUserDataScript(cmdString, userData)
+	if err != nil {
+		newErr := fmt.Errorf("Failed to Append the UserData to the Cloud-Init Script : [%v]", err)
+		cblogger.Error(newErr.Error())
+		return nil, newErr
+	}
+
 	// Create Cloud-Init Script
 	// LnxTypeOs string = "LNX" // LNX (LINUX)
 	// WinTypeOS string = "WND" // WND (WINDOWS)
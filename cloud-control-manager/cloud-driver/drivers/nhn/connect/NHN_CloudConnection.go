@@ -193,6 +193,22 @@ func (cloudConn *NhnCloudConnection) CreateNICHandler() (irs.NICHandler, error)
 	return &handler, nil
 }
 
+func (cloudConn *NhnCloudConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, errors.New("NHN Cloud Driver: VPCPeeringHandler not supported")
+}
+
+func (cloudConn *NhnCloudConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, errors.New("NHN Cloud Driver: RouteTableHandler not supported")
+}
+
+func (cloudConn *NhnCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, errors.New("NHN Cloud Driver: NATGatewayHandler not supported")
+}
+
+func (cloudConn *NhnCloudConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, errors.New("NHN Cloud Driver: DiskSnapshotHandler not supported")
+}
+
 func (cloudConn *NhnCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
 	cblogger.Info("NHN Cloud Driver: called CreatePublicIPHandler()!")
 	handler := nhnrs.NhnCloudPublicIPHandler{
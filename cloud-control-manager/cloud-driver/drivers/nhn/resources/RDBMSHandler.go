@@ -870,6 +870,38 @@ func (handler *NhnCloudRDBMSHandler) DeleteRDBMS(rdbmsIID irs.IID) (bool, error)
 	return true, nil
 }
 
+func (handler *NhnCloudRDBMSHandler) ChangeSpec(rdbmsIID irs.IID, newSpec string) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("NHN Cloud Driver Does not support ChangeSpec() yet!!")
+}
+
+func (handler *NhnCloudRDBMSHandler) ChangeStorageSize(rdbmsIID irs.IID, newSize string) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("NHN Cloud Driver Does not support ChangeStorageSize() yet!!")
+}
+
+func (handler *NhnCloudRDBMSHandler) StartRDBMS(rdbmsIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("NHN Cloud Driver Does not support StartRDBMS() yet!!")
+}
+
+func (handler *NhnCloudRDBMSHandler) StopRDBMS(rdbmsIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("NHN Cloud Driver Does not support StopRDBMS() yet!!")
+}
+
+func (handler *NhnCloudRDBMSHandler) CreateSnapshot(rdbmsIID irs.IID, snapshotName string) (irs.RDBMSSnapshotInfo, error) {
+	return irs.RDBMSSnapshotInfo{}, fmt.Errorf("NHN Cloud Driver Does not support CreateSnapshot() yet!!")
+}
+
+func (handler *NhnCloudRDBMSHandler) ListSnapshot(rdbmsIID irs.IID) ([]*irs.RDBMSSnapshotInfo, error) {
+	return nil, fmt.Errorf("NHN Cloud Driver Does not support ListSnapshot() yet!!")
+}
+
+func (handler *NhnCloudRDBMSHandler) DeleteSnapshot(snapshotIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("NHN Cloud Driver Does not support DeleteSnapshot() yet!!")
+}
+
+func (handler *NhnCloudRDBMSHandler) RestoreSnapshot(snapshotIID irs.IID, rdbmsReqInfo irs.RDBMSInfo) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("NHN Cloud Driver Does not support RestoreSnapshot() yet!!")
+}
+
 // ---- NHN native RDS API helper methods ────────────────────────────────────
 
 // postRDS sends a POST request to the NHN RDS for MySQL API.
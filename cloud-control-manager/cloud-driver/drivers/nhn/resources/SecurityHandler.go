@@ -36,6 +36,11 @@ type NhnCloudSecurityHandler struct {
 }
 
 func (securityHandler *NhnCloudSecurityHandler) CreateSecurity(securityReqInfo irs.SecurityReqInfo) (irs.SecurityInfo, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityReqInfo.SecurityRules, false, false, false); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	cblogger.Info("NHN Cloud Driver: called CreateSecurity()!")
 	callLogInfo := getCallLogScheme(securityHandler.RegionInfo.Region, call.SECURITYGROUP, securityReqInfo.IId.NameId, "CreateSecurity()")
 
@@ -215,6 +220,11 @@ func (securityHandler *NhnCloudSecurityHandler) DeleteSecurity(securityIID irs.I
 }
 
 func (securityHandler *NhnCloudSecurityHandler) AddRules(sgIID irs.IID, securityRules *[]irs.SecurityRuleInfo) (irs.SecurityInfo, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityRules, false, false, false); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	cblogger.Info("NHN Cloud Driver: called AddRules()!")
 	callLogInfo := getCallLogScheme(securityHandler.RegionInfo.Region, call.SECURITYGROUP, sgIID.SystemId, "AddRules()")
 
@@ -374,6 +384,11 @@ func (securityHandler *NhnCloudSecurityHandler) AddRules(sgIID irs.IID, security
 }
 
 func (securityHandler *NhnCloudSecurityHandler) RemoveRules(sgIID irs.IID, securityRules *[]irs.SecurityRuleInfo) (bool, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityRules, false, false, false); err != nil {
+		return false, err
+	}
+
 	cblogger.Info("NHN Cloud Driver: called RemoveRules()!")
 	callLogInfo := getCallLogScheme(securityHandler.RegionInfo.Region, call.SECURITYGROUP, sgIID.SystemId, "RemoveRules()")
 
@@ -44,6 +44,7 @@ import (
 	//	images "github.com/cloud-barista/nhncloud-sdk-go/openstack/imageservice/v2/images" // imageservice/v2/images : For Visibility parameter
 
 	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
+	cdcom "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/common"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 )
@@ -288,6 +289,16 @@ func (vmHandler *NhnCloudVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo
 	}
 	// cblogger.Infof("init UserData : [%s]", *initUserData)
 
+	// Merge user's UserData with the cloud-init script
+	mergedUserData, mergeErr := cdcom.MergeUserData(*initUserData, vmReqInfo.UserData)
+	if mergeErr != nil {
+		newErr := fmt.Errorf("Failed to Merge the UserData with the Cloud-Init Script : [%v]", mergeErr)
+		cblogger.Error(newErr.Error())
+		LoggingError(callLogInfo, newErr)
+		return irs.VMInfo{}, newErr
+	}
+	initUserData = &mergedUserData
+
 	// Preparing VM Creation Options
 	serverCreateOpts := servers.CreateOpts{
 		Name:           vmReqInfo.IId.NameId,
@@ -752,6 +763,10 @@ func (vmHandler *NhnCloudVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, er
 	return irs.Terminating, nil
 }
 
+func (vmHandler *NhnCloudVMHandler) ChangeVMSpec(vmIID irs.IID, vmSpecName string) (irs.VMInfo, error) {
+	return irs.VMInfo{}, fmt.Errorf("NHN Cloud Driver Does not support ChangeVMSpec() yet!!")
+}
+
 func (vmHandler *NhnCloudVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
 	cblogger.Info("NHN Cloud Driver: called ListVMStatus()")
 	callLogInfo := getCallLogScheme(vmHandler.RegionInfo.Region, call.VM, "ListVMStatus()", "ListVMStatus()")
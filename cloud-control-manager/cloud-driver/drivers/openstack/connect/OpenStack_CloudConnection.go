@@ -241,6 +241,22 @@ func (cloudConn *OpenStackCloudConnection) CreateNICHandler() (irs.NICHandler, e
 	return &handler, nil
 }
 
+func (cloudConn *OpenStackCloudConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, errors.New("OpenStack Driver: VPCPeeringHandler not supported")
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, errors.New("OpenStack Driver: RouteTableHandler not supported")
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, errors.New("OpenStack Driver: NATGatewayHandler not supported")
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, errors.New("OpenStack Driver: DiskSnapshotHandler not supported")
+}
+
 func (cloudConn *OpenStackCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
 	cblogger.Info("OpenStack Cloud Driver: called CreatePublicIPHandler()!")
 	handler := osrs.OpenStackPublicIPHandler{
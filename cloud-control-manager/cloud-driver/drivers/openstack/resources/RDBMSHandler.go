@@ -556,6 +556,38 @@ func (handler *OpenStackRDBMSHandler) DeleteRDBMS(rdbmsIID irs.IID) (bool, error
 	return true, nil
 }
 
+func (handler *OpenStackRDBMSHandler) ChangeSpec(rdbmsIID irs.IID, newSpec string) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("OpenStack Driver Does not support ChangeSpec() yet!!")
+}
+
+func (handler *OpenStackRDBMSHandler) ChangeStorageSize(rdbmsIID irs.IID, newSize string) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("OpenStack Driver Does not support ChangeStorageSize() yet!!")
+}
+
+func (handler *OpenStackRDBMSHandler) StartRDBMS(rdbmsIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("OpenStack Driver Does not support StartRDBMS() yet!!")
+}
+
+func (handler *OpenStackRDBMSHandler) StopRDBMS(rdbmsIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("OpenStack Driver Does not support StopRDBMS() yet!!")
+}
+
+func (handler *OpenStackRDBMSHandler) CreateSnapshot(rdbmsIID irs.IID, snapshotName string) (irs.RDBMSSnapshotInfo, error) {
+	return irs.RDBMSSnapshotInfo{}, fmt.Errorf("OpenStack Driver Does not support CreateSnapshot() yet!!")
+}
+
+func (handler *OpenStackRDBMSHandler) ListSnapshot(rdbmsIID irs.IID) ([]*irs.RDBMSSnapshotInfo, error) {
+	return nil, fmt.Errorf("OpenStack Driver Does not support ListSnapshot() yet!!")
+}
+
+func (handler *OpenStackRDBMSHandler) DeleteSnapshot(snapshotIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("OpenStack Driver Does not support DeleteSnapshot() yet!!")
+}
+
+func (handler *OpenStackRDBMSHandler) RestoreSnapshot(snapshotIID irs.IID, rdbmsReqInfo irs.RDBMSInfo) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("OpenStack Driver Does not support RestoreSnapshot() yet!!")
+}
+
 // resolveFlavorRef resolves a VM spec name (e.g. "m1.small") or UUID to the
 // flavor UUID accepted by Trove's FlavorRef. In DevStack, Trove shares the
 // same Nova flavor catalog, so the IDs are identical to VM spec IDs.
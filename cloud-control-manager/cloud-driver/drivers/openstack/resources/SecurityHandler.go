@@ -79,6 +79,11 @@ func (securityHandler *OpenStackSecurityHandler) setterSeg(secGroup secgroups.Se
 }
 
 func (securityHandler *OpenStackSecurityHandler) CreateSecurity(securityReqInfo irs.SecurityReqInfo) (createdSG irs.SecurityInfo, creteErr error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityReqInfo.SecurityRules, false, true, false); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	// log HisCall
 	hiscallInfo := GetCallLogScheme(securityHandler.ComputeClient.IdentityEndpoint, call.SECURITYGROUP, securityReqInfo.IId.NameId, "CreateSecurity()")
 
@@ -266,6 +271,11 @@ func (securityHandler *OpenStackSecurityHandler) DeleteSecurity(securityIID irs.
 }
 
 func (securityHandler *OpenStackSecurityHandler) AddRules(sgIID irs.IID, securityRules *[]irs.SecurityRuleInfo) (irs.SecurityInfo, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityRules, false, true, false); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	hiscallInfo := GetCallLogScheme(securityHandler.ComputeClient.IdentityEndpoint, call.SECURITYGROUP, sgIID.NameId, "AddRules()")
 
 	start := call.Start()
@@ -331,6 +341,11 @@ func (securityHandler *OpenStackSecurityHandler) AddRules(sgIID irs.IID, securit
 }
 
 func (securityHandler *OpenStackSecurityHandler) RemoveRules(sgIID irs.IID, securityRules *[]irs.SecurityRuleInfo) (bool, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityRules, false, true, false); err != nil {
+		return false, err
+	}
+
 	hiscallInfo := GetCallLogScheme(securityHandler.ComputeClient.IdentityEndpoint, call.SECURITYGROUP, sgIID.NameId, "AddRules()")
 
 	start := call.Start()
@@ -484,7 +499,11 @@ func convertCBRuleInfosToOpenStackRules(sgId string, sgRules *[]irs.SecurityRule
 		if err != nil {
 			return nil, err
 		}
-		etherType, err := checkIPAddressType(rule.CIDR)
+		remoteIPPrefix := rule.CIDR
+		if rule.CIDRv6 != "" {
+			remoteIPPrefix = rule.CIDRv6
+		}
+		etherType, err := checkIPAddressType(remoteIPPrefix)
 		if err != nil {
 			return nil, err
 		}
@@ -494,7 +513,7 @@ func convertCBRuleInfosToOpenStackRules(sgId string, sgRules *[]irs.SecurityRule
 				EtherType:      etherType,
 				SecGroupID:     sgId,
 				Protocol:       protocol,
-				RemoteIPPrefix: rule.CIDR,
+				RemoteIPPrefix: remoteIPPrefix,
 			}
 		} else {
 			min, max, err := convertRulePortRangeCBToOP(rule.FromPort, rule.ToPort)
@@ -508,7 +527,7 @@ func convertCBRuleInfosToOpenStackRules(sgId string, sgRules *[]irs.SecurityRule
 				PortRangeMin:   min,
 				PortRangeMax:   max,
 				Protocol:       protocol,
-				RemoteIPPrefix: rule.CIDR,
+				RemoteIPPrefix: remoteIPPrefix,
 			}
 		}
 		openStackRuleCreateOpts[i] = createRuleOpts
@@ -539,7 +558,12 @@ func convertOpenStackRuleToCBRuleInfo(rawRules *rules.SecGroupRule) irs.Security
 	ruleInfo := irs.SecurityRuleInfo{
 		Direction:  direction,
 		IPProtocol: convertRuleProtocolOPToCB(rawRules.Protocol),
-		CIDR:       cidr,
+	}
+	// IPv6 CIDR => CIDRv6
+	if rules.RuleEtherType(rawRules.EtherType) == rules.EtherType6 {
+		ruleInfo.CIDRv6 = cidr
+	} else {
+		ruleInfo.CIDR = cidr
 	}
 
 	if strings.ToLower(rawRules.Protocol) == ICMP {
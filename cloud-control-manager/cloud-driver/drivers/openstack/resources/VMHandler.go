@@ -482,6 +482,53 @@ func (vmHandler *OpenStackVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, e
 	return irs.Terminated, nil
 }
 
+// ChangeVMSpec resizes the server to the given flavor and confirms the resize.
+func (vmHandler *OpenStackVMHandler) ChangeVMSpec(vmIID irs.IID, vmSpecName string) (irs.VMInfo, error) {
+	// log HisCall
+	hiscallInfo := GetCallLogScheme(vmHandler.ComputeClient.IdentityEndpoint, call.VM, vmIID.NameId, "ChangeVMSpec()")
+
+	vmSpec, err := GetFlavorByName(vmHandler.ComputeClient, vmSpecName)
+	if err != nil {
+		changeErr := errors.New(fmt.Sprintf("Failed to Change VMSpec. err = %s", err))
+		cblogger.Error(changeErr.Error())
+		LoggingError(hiscallInfo, changeErr)
+		return irs.VMInfo{}, changeErr
+	}
+
+	start := call.Start()
+	resizeOpts := servers.ResizeOpts{
+		FlavorRef: vmSpec.ID,
+	}
+	err = servers.Resize(context.TODO(), vmHandler.ComputeClient, vmIID.SystemId, resizeOpts).ExtractErr()
+	if err != nil {
+		changeErr := errors.New(fmt.Sprintf("Failed to Change VMSpec. err = %s", err))
+		cblogger.Error(changeErr.Error())
+		LoggingError(hiscallInfo, changeErr)
+		return irs.VMInfo{}, changeErr
+	}
+
+	// 리사이즈 완료 후 VERIFY_RESIZE 상태에서 확정(Confirm) 처리
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Minute)
+	defer cancel()
+	err = servers.WaitForStatus(ctx, vmHandler.ComputeClient, vmIID.SystemId, "VERIFY_RESIZE")
+	if err != nil {
+		changeErr := errors.New(fmt.Sprintf("Failed to Change VMSpec. err = %s", err))
+		cblogger.Error(changeErr.Error())
+		LoggingError(hiscallInfo, changeErr)
+		return irs.VMInfo{}, changeErr
+	}
+	err = servers.ConfirmResize(context.TODO(), vmHandler.ComputeClient, vmIID.SystemId).ExtractErr()
+	if err != nil {
+		changeErr := errors.New(fmt.Sprintf("Failed to Change VMSpec. err = %s", err))
+		cblogger.Error(changeErr.Error())
+		LoggingError(hiscallInfo, changeErr)
+		return irs.VMInfo{}, changeErr
+	}
+	LoggingInfo(hiscallInfo, start)
+
+	return vmHandler.GetVM(vmIID)
+}
+
 func (vmHandler *OpenStackVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
 	// log HisCall
 	hiscallInfo := GetCallLogScheme(vmHandler.ComputeClient.IdentityEndpoint, call.VM, VM, "ListVMStatus()")
@@ -1233,6 +1280,9 @@ func checkWindowVMReqInfo(vmReqInfo irs.VMReqInfo) error {
 	if vmReqInfo.VMUserId != WindowBaseUser {
 		return errors.New("for Windows, the userId only provides Administrator")
 	}
+	if vmReqInfo.UserData != "" {
+		return errors.New("for Windows, UserData is not supported")
+	}
 	// password
 	err := cdcom.ValidateWindowsPassword(vmReqInfo.VMUserPasswd)
 	if err != nil {
@@ -1244,7 +1294,7 @@ func checkWindowVMReqInfo(vmReqInfo irs.VMReqInfo) error {
 	return nil
 }
 
-func linuxServerCreatOptConvertKeyPairWrapping(baseServerCreateOpt servers.CreateOpts, keyPairIID irs.IID, computeClient *gophercloud.ServiceClient) (keypairs.CreateOptsExt, error) {
+func linuxServerCreatOptConvertKeyPairWrapping(baseServerCreateOpt servers.CreateOpts, keyPairIID irs.IID, userData string, computeClient *gophercloud.ServiceClient) (keypairs.CreateOptsExt, error) {
 	keyPair, err := GetRawKey(computeClient, keyPairIID)
 	if err != nil {
 		return keypairs.CreateOptsExt{}, err
@@ -1258,6 +1308,12 @@ func linuxServerCreatOptConvertKeyPairWrapping(baseServerCreateOpt servers.Creat
 	fileStr = strings.ReplaceAll(fileStr, "{{username}}", SSHDefaultUser)
 	fileStr = strings.ReplaceAll(fileStr, "{{public_key}}", keyPair.PublicKey)
 
+	// merge user's UserData with Spider's cloud-init
+	fileStr, err = cdcom.MergeUserData(fileStr, userData)
+	if err != nil {
+		return keypairs.CreateOptsExt{}, err
+	}
+
 	baseServerCreateOpt.UserData = []byte(fileStr)
 	createOptsExt := keypairs.CreateOptsExt{
 		KeyName: keyPair.Name,
@@ -1305,7 +1361,7 @@ func severCreatePublicImageLinuxOS(baseServerCreateOpt servers.CreateOpts, vmReq
 		}
 		// Linux
 		baseServerCreateOpt.BlockDevice = blockDeviceSet
-		createOptsExt, err := linuxServerCreatOptConvertKeyPairWrapping(baseServerCreateOpt, vmReqInfo.KeyPairIID, computeClient)
+		createOptsExt, err := linuxServerCreatOptConvertKeyPairWrapping(baseServerCreateOpt, vmReqInfo.KeyPairIID, vmReqInfo.UserData, computeClient)
 		if err != nil {
 			return servers.Server{}, err
 		}
@@ -1317,7 +1373,7 @@ func severCreatePublicImageLinuxOS(baseServerCreateOpt servers.CreateOpts, vmReq
 	} else {
 		// Disk Size 변경 X
 		if VolumeClient == nil { // Disk Size 변경 X && VolumeClient == nil
-			createOptsExt, err := linuxServerCreatOptConvertKeyPairWrapping(baseServerCreateOpt, vmReqInfo.KeyPairIID, computeClient)
+			createOptsExt, err := linuxServerCreatOptConvertKeyPairWrapping(baseServerCreateOpt, vmReqInfo.KeyPairIID, vmReqInfo.UserData, computeClient)
 			if err != nil {
 				return servers.Server{}, err
 			}
@@ -1339,7 +1395,7 @@ func severCreatePublicImageLinuxOS(baseServerCreateOpt servers.CreateOpts, vmReq
 				rootBlockDeviceSet,
 			}
 			baseServerCreateOpt.BlockDevice = blockDeviceSet
-			createOptsExt, err := linuxServerCreatOptConvertKeyPairWrapping(baseServerCreateOpt, vmReqInfo.KeyPairIID, computeClient)
+			createOptsExt, err := linuxServerCreatOptConvertKeyPairWrapping(baseServerCreateOpt, vmReqInfo.KeyPairIID, vmReqInfo.UserData, computeClient)
 			if err != nil {
 				return servers.Server{}, err
 			}
@@ -1462,7 +1518,7 @@ func severCreateMyImageLinuxOS(baseServerCreateOpt servers.CreateOpts, vmReqInfo
 		return servers.Server{}, errors.New(fmt.Sprintf("Failed to startVM err = this Openstack cannot provide VolumeClient. BlockDevice information is located within the snapshot."))
 	}
 
-	createOptsExt, err := linuxServerCreatOptConvertKeyPairWrapping(baseServerCreateOpt, vmReqInfo.KeyPairIID, computeClient)
+	createOptsExt, err := linuxServerCreatOptConvertKeyPairWrapping(baseServerCreateOpt, vmReqInfo.KeyPairIID, vmReqInfo.UserData, computeClient)
 	server, err := servers.Create(context.TODO(), computeClient, createOptsExt, nil).Extract()
 	if err != nil {
 		return servers.Server{}, err
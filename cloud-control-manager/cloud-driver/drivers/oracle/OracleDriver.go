@@ -40,6 +40,7 @@ func (OracleDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 		QuotaInfoHandler:  true,
 		DiskHandler:       true,
 		MyImageHandler:    true,
+		VM_USER_DATA:      true,
 		TagSupportResourceType: []irs.RSType{
 			irs.VPC, irs.SUBNET, irs.SG, irs.VM,
 		},
@@ -116,6 +116,22 @@ func (cloudConn *OracleConnection) CreateNICHandler() (irs.NICHandler, error) {
 	return nil, errors.New("Oracle Driver: NICHandler not implemented")
 }
 
+func (cloudConn *OracleConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, errors.New("Oracle Driver: VPCPeeringHandler not supported")
+}
+
+func (cloudConn *OracleConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, errors.New("Oracle Driver: RouteTableHandler not supported")
+}
+
+func (cloudConn *OracleConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, errors.New("Oracle Driver: NATGatewayHandler not supported")
+}
+
+func (cloudConn *OracleConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, errors.New("Oracle Driver: DiskSnapshotHandler not supported")
+}
+
 func (cloudConn *OracleConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
 	return nil, errors.New("Oracle Driver: PublicIPHandler not implemented")
 }
@@ -21,6 +21,11 @@ type OracleSecurityHandler struct {
 }
 
 func (handler *OracleSecurityHandler) CreateSecurity(req irs.SecurityReqInfo) (irs.SecurityInfo, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(req.SecurityRules, false, false, false); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	if req.IId.NameId == "" || req.VpcIID.SystemId == "" {
 		return irs.SecurityInfo{}, errors.New("invalid security group request")
 	}
@@ -74,6 +79,11 @@ func (handler *OracleSecurityHandler) DeleteSecurity(iid irs.IID) (bool, error)
 }
 
 func (handler *OracleSecurityHandler) AddRules(sgIID irs.IID, rules *[]irs.SecurityRuleInfo) (irs.SecurityInfo, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(rules, false, false, false); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	nsg, err := handler.getNsg(sgIID, "")
 	if err != nil {
 		return irs.SecurityInfo{}, err
@@ -94,6 +104,11 @@ func (handler *OracleSecurityHandler) AddRules(sgIID irs.IID, rules *[]irs.Secur
 }
 
 func (handler *OracleSecurityHandler) RemoveRules(sgIID irs.IID, rules *[]irs.SecurityRuleInfo) (bool, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(rules, false, false, false); err != nil {
+		return false, err
+	}
+
 	nsg, err := handler.getNsg(sgIID, "")
 	if err != nil {
 		return false, err
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	cdcom "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/common"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	"github.com/oracle/oci-go-sdk/v65/common"
@@ -51,7 +52,7 @@ func (handler *OracleVMHandler) StartVM(req irs.VMReqInfo) (irs.VMInfo, error) {
 			nsgIDs = append(nsgIDs, sgIID.SystemId)
 		}
 	}
-	userData, err := cloudInitUserData(keyInfo.PublicKey)
+	userData, err := cloudInitUserData(keyInfo.PublicKey, req.UserData)
 	if err != nil {
 		return irs.VMInfo{}, err
 	}
@@ -167,6 +168,10 @@ func (handler *OracleVMHandler) TerminateVM(iid irs.IID) (irs.VMStatus, error) {
 	return irs.Terminating, nil
 }
 
+func (handler *OracleVMHandler) ChangeVMSpec(vmIID irs.IID, vmSpecName string) (irs.VMInfo, error) {
+	return irs.VMInfo{}, fmt.Errorf("Oracle Cloud Driver Does not support ChangeVMSpec() yet!!")
+}
+
 func (handler *OracleVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
 	instances, err := handler.listInstances()
 	if err != nil {
@@ -323,13 +328,17 @@ func (handler *OracleVMHandler) vmInfo(instance core.Instance) (irs.VMInfo, erro
 	return irs.VMInfo{IId: irs.IID{NameId: stringValue(instance.DisplayName), SystemId: stringValue(instance.Id)}, StartTime: timeValue(instance.TimeCreated), Region: irs.RegionInfo{Region: handler.Region.Region, Zone: stringValue(instance.AvailabilityDomain)}, ImageType: imageType, ImageIId: irs.IID{SystemId: imageID, NameId: imageID}, VMSpecName: stringValue(instance.Shape), VpcIID: irs.IID{SystemId: vcnID}, SubnetIID: irs.IID{SystemId: subnetID}, SecurityGroupIIds: nsgIIDs(vnic.NsgIds), KeyPairIId: irs.IID{NameId: keyPairName, SystemId: keyPairName}, RootDiskType: "default", RootDiskSize: "default", RootDeviceName: "boot", DataDiskIIDs: dataDisks, VMUserId: vmUserID, NICs: []irs.VMNICInfo{{IId: irs.IID{NameId: stringValue(vnic.Id), SystemId: stringValue(vnic.Id)}}}, PublicIP: stringValue(vnic.PublicIp), PrivateIP: stringValue(vnic.PrivateIp), Platform: platform, AccessPoint: accessPoint(stringValue(vnic.PublicIp)), TagList: tagList(instance.FreeformTags)}, nil
 }
 
-func cloudInitUserData(publicKey string) (string, error) {
+func cloudInitUserData(publicKey string, reqUserData string) (string, error) {
 	rootPath := os.Getenv("CBSPIDER_ROOT")
 	fileData, err := os.ReadFile(rootPath + oracleCloudInitPath)
 	if err != nil {
 		return "", fmt.Errorf("failed to read Oracle cloud-init template: %w", err)
 	}
 	userData := strings.ReplaceAll(string(fileData), cloudInitPublicKeyVar, strings.TrimSpace(publicKey))
+	userData, err = cdcom.MergeUserData(userData, reqUserData)
+	if err != nil {
+		return "", fmt.Errorf("failed to merge UserData with Oracle cloud-init template: %w", err)
+	}
 	return base64.StdEncoding.EncodeToString([]byte(userData)), nil
 }
 
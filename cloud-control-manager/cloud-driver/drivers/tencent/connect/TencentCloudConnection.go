@@ -193,6 +193,22 @@ func (cloudConn *TencentCloudConnection) CreateNICHandler() (irs.NICHandler, err
 	return &handler, nil
 }
 
+func (cloudConn *TencentCloudConnection) CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error) {
+	return nil, errors.New("Tencent Cloud Driver: VPCPeeringHandler not supported")
+}
+
+func (cloudConn *TencentCloudConnection) CreateRouteTableHandler() (irs.RouteTableHandler, error) {
+	return nil, errors.New("Tencent Cloud Driver: RouteTableHandler not supported")
+}
+
+func (cloudConn *TencentCloudConnection) CreateNATGatewayHandler() (irs.NATGatewayHandler, error) {
+	return nil, errors.New("Tencent Cloud Driver: NATGatewayHandler not supported")
+}
+
+func (cloudConn *TencentCloudConnection) CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error) {
+	return nil, errors.New("Tencent Cloud Driver: DiskSnapshotHandler not supported")
+}
+
 func (cloudConn *TencentCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
 	cblogger.Info("Tencent Cloud Driver: called CreatePublicIPHandler()!")
 	handler := trs.TencentPublicIPHandler{Region: cloudConn.Region, VPCClient: cloudConn.VNetworkClient}
@@ -679,6 +679,38 @@ func (handler *TencentRDBMSHandler) DeleteRDBMS(rdbmsIID irs.IID) (bool, error)
 	return true, nil
 }
 
+func (handler *TencentRDBMSHandler) ChangeSpec(rdbmsIID irs.IID, newSpec string) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("Tencent Cloud Driver Does not support ChangeSpec() yet!!")
+}
+
+func (handler *TencentRDBMSHandler) ChangeStorageSize(rdbmsIID irs.IID, newSize string) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("Tencent Cloud Driver Does not support ChangeStorageSize() yet!!")
+}
+
+func (handler *TencentRDBMSHandler) StartRDBMS(rdbmsIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("Tencent Cloud Driver Does not support StartRDBMS() yet!!")
+}
+
+func (handler *TencentRDBMSHandler) StopRDBMS(rdbmsIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("Tencent Cloud Driver Does not support StopRDBMS() yet!!")
+}
+
+func (handler *TencentRDBMSHandler) CreateSnapshot(rdbmsIID irs.IID, snapshotName string) (irs.RDBMSSnapshotInfo, error) {
+	return irs.RDBMSSnapshotInfo{}, fmt.Errorf("Tencent Cloud Driver Does not support CreateSnapshot() yet!!")
+}
+
+func (handler *TencentRDBMSHandler) ListSnapshot(rdbmsIID irs.IID) ([]*irs.RDBMSSnapshotInfo, error) {
+	return nil, fmt.Errorf("Tencent Cloud Driver Does not support ListSnapshot() yet!!")
+}
+
+func (handler *TencentRDBMSHandler) DeleteSnapshot(snapshotIID irs.IID) (bool, error) {
+	return false, fmt.Errorf("Tencent Cloud Driver Does not support DeleteSnapshot() yet!!")
+}
+
+func (handler *TencentRDBMSHandler) RestoreSnapshot(snapshotIID irs.IID, rdbmsReqInfo irs.RDBMSInfo) (irs.RDBMSInfo, error) {
+	return irs.RDBMSInfo{}, fmt.Errorf("Tencent Cloud Driver Does not support RestoreSnapshot() yet!!")
+}
+
 // ===== Helper Functions =====
 
 func (handler *TencentRDBMSHandler) convertToRDBMSInfo(inst *cdb.InstanceInfo) irs.RDBMSInfo {
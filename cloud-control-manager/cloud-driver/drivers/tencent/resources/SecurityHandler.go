@@ -53,6 +53,11 @@ Action : ACCEPT or DROP
 // 사용자의 policy를 추가로 적용 : CreateSecurityGroupPolicies
 // 1번의 request는 한반향만 가능(두가지 동시에 불가)
 func (securityHandler *TencentSecurityHandler) CreateSecurity(securityReqInfo irs.SecurityReqInfo) (irs.SecurityInfo, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(securityReqInfo.SecurityRules, false, false, false); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	cblogger.Infof("securityReqInfo : ", securityReqInfo)
 	//=================================================
 	// 동일 이름 생성 방지 추가(cb-spider 요청 필수 기능)
@@ -477,6 +482,11 @@ func (securityHandler *TencentSecurityHandler) DeleteSecurity(securityIID irs.II
 // CreateSecurityGroupPolicies inbound, outbound 동시 호출 불가 > 각각 호출
 // ModifySecurityGroupPolicies Version을 0으로 set하면 초기화(모든 룰 사라짐), 설정하지 않으면 모두 삭제 후 insert(기존 값 사라짐, 넘어온 값만 사용)
 func (securityHandler *TencentSecurityHandler) AddRules(securityIID irs.IID, reqSecurityRules *[]irs.SecurityRuleInfo) (irs.SecurityInfo, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(reqSecurityRules, false, false, false); err != nil {
+		return irs.SecurityInfo{}, err
+	}
+
 	////////
 	// logger for HisCall
 	callogger := call.GetLogger("HISCALL")
@@ -599,6 +609,11 @@ func (securityHandler *TencentSecurityHandler) AddRules(securityIID irs.IID, req
 
 // DeleteSecurityGroupPolicies inbound, outbound 동시 호출 불가 > 각각 호출
 func (securityHandler *TencentSecurityHandler) RemoveRules(securityIID irs.IID, reqSecurityRules *[]irs.SecurityRuleInfo) (bool, error) {
+	// reject the rule options not supported by this driver
+	if err := irs.CheckSecurityRuleOptions(reqSecurityRules, false, false, false); err != nil {
+		return false, err
+	}
+
 	////////
 	// logger for HisCall
 	callogger := call.GetLogger("HISCALL")
@@ -171,6 +171,21 @@ func (vmHandler *TencentVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 
 	request.InstanceChargeType = common.StringPtr("POSTPAID_BY_HOUR")
 
+	// Spot Instance - 입찰 가격(MaxPrice)이 필수
+	if vmReqInfo.PurchaseOption == irs.SpotVM {
+		if vmReqInfo.SpotMaxPrice == "" {
+			return irs.VMInfo{}, errors.New("SpotMaxPrice is required for Tencent Spot Instance")
+		}
+		request.InstanceChargeType = common.StringPtr("SPOTPAID")
+		request.InstanceMarketOptions = &cvm.InstanceMarketOptionsRequest{
+			MarketType: common.StringPtr("spot"),
+			SpotOptions: &cvm.SpotMarketOptions{
+				MaxPrice:         common.StringPtr(vmReqInfo.SpotMaxPrice),
+				SpotInstanceType: common.StringPtr("one-time"),
+			},
+		}
+	}
+
 	request.InternetAccessible = &cvm.InternetAccessible{
 		// 	InternetChargeType: common.StringPtr("TRAFFIC_POSTPAID_BY_HOUR"),
 		PublicIpAssigned:        common.BoolPtr(true),
@@ -370,7 +385,15 @@ func (vmHandler *TencentVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 		cblogger.Error(err)
 		return irs.VMInfo{}, err
 	}
-	userData := string(fileDataCloudInit)
+	if isWindow && vmReqInfo.UserData != "" {
+		return irs.VMInfo{}, errors.New("UserData is not supported for Windows VM")
+	}
+	// merge user's UserData with Spider's cloud-init
+	userData, err := cdcom.MergeUserData(string(fileDataCloudInit), vmReqInfo.UserData)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VMInfo{}, err
+	}
 	//userData = strings.ReplaceAll(userData, "{{username}}", CBDefaultVmUserName)
 	//userData = strings.ReplaceAll(userData, "{{public_key}}", keyPairInfo.PublicKey)
 	userDataBase64 := base64.StdEncoding.EncodeToString([]byte(userData))
@@ -624,6 +647,43 @@ func (vmHandler *TencentVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, err
 	return irs.VMStatus("Terminating"), nil
 }
 
+// ChangeVMSpec changes the instance type of the stopped instance.
+// https://intl.cloud.tencent.com/document/product/213/15744
+func (vmHandler *TencentVMHandler) ChangeVMSpec(vmIID irs.IID, vmSpecName string) (irs.VMInfo, error) {
+	cblogger.Infof("vmNameId : [%s] / vmSpecName : [%s]", vmIID.SystemId, vmSpecName)
+
+	callogger := call.GetLogger("HISCALL")
+	callLogInfo := call.CLOUDLOGSCHEMA{
+		CloudOS:      call.TENCENT,
+		RegionZone:   vmHandler.Region.Zone,
+		ResourceType: call.VM,
+		ResourceName: vmIID.SystemId,
+		CloudOSAPI:   "ResetInstancesType()",
+		ElapsedTime:  "",
+		ErrorMSG:     "",
+	}
+
+	request := cvm.NewResetInstancesTypeRequest()
+	request.InstanceIds = common.StringPtrs([]string{vmIID.SystemId})
+	request.InstanceType = common.StringPtr(vmSpecName)
+
+	callLogStart := call.Start()
+	response, err := vmHandler.Client.ResetInstancesType(request)
+	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+
+	if err != nil {
+		callLogInfo.ErrorMSG = err.Error()
+		callogger.Error(call.String(callLogInfo))
+
+		cblogger.Error(err)
+		return irs.VMInfo{}, err
+	}
+	callogger.Info(call.String(callLogInfo))
+	cblogger.Debug(response.ToJsonString())
+
+	return vmHandler.GetVM(vmIID)
+}
+
 func (vmHandler *TencentVMHandler) GetVM(vmIID irs.IID) (irs.VMInfo, error) {
 	cblogger.Infof("vmNameId : [%s]", vmIID.SystemId)
 
@@ -676,6 +736,11 @@ func (vmHandler *TencentVMHandler) ExtractDescribeInstances(curVm *cvm.Instance)
 		//KeyPairIId: irs.IID{SystemId: *curVm.},
 	}
 
+	vmInfo.PurchaseOption = irs.OnDemandVM
+	if curVm.InstanceChargeType != nil && *curVm.InstanceChargeType == "SPOTPAID" {
+		vmInfo.PurchaseOption = irs.SpotVM
+	}
+
 	if !reflect.ValueOf(curVm.ImageId).IsNil() {
 		imageIID := irs.IID{SystemId: *curVm.ImageId}
 		vmInfo.ImageIId = imageIID
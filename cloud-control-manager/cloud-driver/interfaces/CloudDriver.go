@@ -26,19 +26,23 @@ type DriverCapabilityInfo struct {
 	VMSpecHandler     bool // support: true, do not support: false
 
 	// Resource Handler
-	VPCHandler        bool // support: true, do not support: false
-	SecurityHandler   bool // support: true, do not support: false
-	KeyPairHandler    bool // support: true, do not support: false
-	VMHandler         bool // support: true, do not support: false
-	DiskHandler       bool // support: true, do not support: false
-	MyImageHandler    bool // support: true, do not support: false
-	NLBHandler        bool // support: true, do not support: false
-	ClusterHandler    bool // support: true, do not support: false
-	FileSystemHandler bool // support: true, do not support: false
-	QuotaInfoHandler  bool // support: true, do not support: false
-	RDBMSHandler      bool // support: true, do not support: false
-	PublicIPHandler   bool // support: true, do not support: false
-	NICHandler        bool // support: true, do not support: false
+	VPCHandler          bool // support: true, do not support: false
+	SecurityHandler     bool // support: true, do not support: false
+	KeyPairHandler      bool // support: true, do not support: false
+	VMHandler           bool // support: true, do not support: false
+	DiskHandler         bool // support: true, do not support: false
+	DiskSnapshotHandler bool // support: true, do not support: false
+	MyImageHandler      bool // support: true, do not support: false
+	NLBHandler          bool // support: true, do not support: false
+	ClusterHandler      bool // support: true, do not support: false
+	FileSystemHandler   bool // support: true, do not support: false
+	QuotaInfoHandler    bool // support: true, do not support: false
+	RDBMSHandler        bool // support: true, do not support: false
+	PublicIPHandler     bool // support: true, do not support: false
+	NICHandler          bool // support: true, do not support: false
+	VPCPeeringHandler   bool // support: true, do not support: false
+	RouteTableHandler   bool // support: true, do not support: false
+	NATGatewayHandler   bool // support: true, do not support: false
 
 	TagHandler bool // support: true, do not support: false
 	// ex) {ires.VPC, ires.SUBNET, ires.SG, ires.KEY, ires.VM, ires.NLB, ires.DISK, ires.MYIMAGE, ires.CLUSTER}
@@ -48,6 +52,20 @@ type DriverCapabilityInfo struct {
 	VPC_CIDR     bool // support: true, do not support: false
 	EMULATED_VPC bool // support: true, do not support: false
 	SINGLE_VPC   bool // support: true, do not support: false
+	VM_USER_DATA bool // support: true, do not support: false
+	SPOT_VM      bool // support: true, do not support: false
+
+	VM_SPEC_CHANGE            bool // support: true, do not support: false
+	VM_SPEC_CHANGE_NEEDS_STOP bool // true: VM must be suspended to change VMSpec, false: VMSpec can be changed on running VM
+
+	SG_RULE_DESCRIPTION bool // support: true, do not support: false
+	SG_RULE_IPV6_CIDR   bool // support: true, do not support: false
+	SG_RULE_SOURCE_SG   bool // support: true, do not support: false
+
+	NLB_MULTI_LISTENER    bool // support: true, do not support: false
+	NLB_HTTPS_CERTIFICATE bool // support: true, do not support: false
+	NLB_HEALTH_CHECK_PATH bool // support: true, do not support: false
+	NLB_HTTP_PROTOCOL     bool // HTTP, HTTPS listener and backend(L7), support: true, do not support: false(L4 only)
 
 	// reserved for future use
 	// VNicHandler     bool // support: true, do not support: false
@@ -27,6 +27,7 @@ type CloudConnection interface {
 
 	CreateNLBHandler() (irs.NLBHandler, error)
 	CreateDiskHandler() (irs.DiskHandler, error)
+	CreateDiskSnapshotHandler() (irs.DiskSnapshotHandler, error)
 	CreateMyImageHandler() (irs.MyImageHandler, error)
 
 	CreateClusterHandler() (irs.ClusterHandler, error)
@@ -48,6 +49,10 @@ type CloudConnection interface {
 
 	CreateNICHandler() (irs.NICHandler, error)
 
+	CreateVPCPeeringHandler() (irs.VPCPeeringHandler, error)
+	CreateRouteTableHandler() (irs.RouteTableHandler, error)
+	CreateNATGatewayHandler() (irs.NATGatewayHandler, error)
+
 	IsConnected() (bool, error)
 	Close() error
 }
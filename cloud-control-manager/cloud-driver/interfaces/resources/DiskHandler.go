@@ -35,6 +35,8 @@ type DiskInfo struct {
 	Status  DiskStatus `json:"Status" validate:"required" example:"Available"`
 	OwnerVM IID        `json:"OwnerVM" validate:"omitempty"` // When the Status is DiskAttached
 
+	SourceSnapshotIID IID `json:"SourceSnapshotIID,omitempty" validate:"omitempty"` // Set to create the disk from a DiskSnapshot
+
 	CreatedTime  time.Time  `json:"CreatedTime" validate:"required"`             // The time when the disk was created
 	TagList      []KeyValue `json:"TagList,omitempty" validate:"omitempty"`      // A list of tags associated with this disk
 	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"` // Additional key-value pairs associated with this disk
@@ -0,0 +1,51 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+import "time"
+
+// -------- Const
+type DiskSnapshotStatus string
+
+const (
+	DiskSnapshotCreating  DiskSnapshotStatus = "Creating"
+	DiskSnapshotAvailable DiskSnapshotStatus = "Available"
+	DiskSnapshotDeleting  DiskSnapshotStatus = "Deleting"
+	DiskSnapshotError     DiskSnapshotStatus = "Error"
+)
+
+// -------- Info Structure
+// DiskSnapshotInfo represents the information of a Disk Snapshot resource.
+type DiskSnapshotInfo struct {
+	IId        IID `json:"IId" validate:"required"`        // {NameId, SystemId}
+	SourceDisk IID `json:"SourceDisk" validate:"required"` // The disk from which the snapshot was taken
+
+	Zone         string `json:"Zone,omitempty" validate:"omitempty" example:"us-east-1a"`  // Zone of the source disk
+	SnapshotSize string `json:"SnapshotSize,omitempty" validate:"omitempty" example:"100"` // Size of the source disk (unit is GB)
+
+	Status DiskSnapshotStatus `json:"Status" validate:"required" example:"Available"` // Creating | Available | Deleting | Error
+
+	CreatedTime  time.Time  `json:"CreatedTime" validate:"required"`             // The time when the snapshot was created
+	TagList      []KeyValue `json:"TagList,omitempty" validate:"omitempty"`      // A list of tags associated with this snapshot
+	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"` // Additional key-value pairs associated with this snapshot
+}
+
+// -------- DiskSnapshot API
+// A disk is created from a snapshot with DiskHandler.CreateDisk() and DiskInfo.SourceSnapshotIID.
+type DiskSnapshotHandler interface {
+
+	//------ Snapshot Management
+	ListIID() ([]*IID, error)
+	CreateSnapshot(snapshotReqInfo DiskSnapshotInfo) (DiskSnapshotInfo, error)
+	ListSnapshot() ([]*DiskSnapshotInfo, error)
+	GetSnapshot(snapshotIID IID) (DiskSnapshotInfo, error)
+	DeleteSnapshot(snapshotIID IID) (bool, error)
+}
@@ -0,0 +1,66 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resources interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+import "time"
+
+// -------- Const
+type NATGatewayStatus string
+
+const (
+	NATGatewayPending   NATGatewayStatus = "Pending"
+	NATGatewayAvailable NATGatewayStatus = "Available"
+	NATGatewayDeleting  NATGatewayStatus = "Deleting"
+	NATGatewayError     NATGatewayStatus = "Error"
+	NATGatewayNotFound  NATGatewayStatus = "NotFound" // Registered in Spider but not found in CSP
+)
+
+// -------- Info Structure
+// NATGatewayInfo represents the information of a NAT Gateway bound to a subnet.
+type NATGatewayInfo struct {
+	IId       IID `json:"IId" validate:"required"` // {NameId, SystemId}
+	VpcIID    IID `json:"VpcIID" validate:"required"`
+	SubnetIID IID `json:"SubnetIID" validate:"required"` // Subnet where the NAT Gateway is placed
+
+	PublicIPIID IID    `json:"PublicIPIID,omitempty" validate:"omitempty"` // PublicIP resource used by the NAT Gateway, if given at the creation
+	PublicIP    string `json:"PublicIP,omitempty" validate:"omitempty" example:"52.10.20.30"`
+	PrivateIP   string `json:"PrivateIP,omitempty" validate:"omitempty" example:"10.0.1.10"`
+
+	Status      NATGatewayStatus `json:"Status" validate:"required" example:"Available"`
+	CreatedTime time.Time        `json:"CreatedTime" validate:"required"`
+
+	TagList      []KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"`
+}
+
+// NATGatewayReqInfo represents the request information for creating a NAT Gateway.
+type NATGatewayReqInfo struct {
+	IId       IID `json:"IId" validate:"required"`
+	VpcIID    IID `json:"VpcIID" validate:"required"`
+	SubnetIID IID `json:"SubnetIID" validate:"required"`
+
+	// optional, a PublicIP allocated by the PublicIPHandler.
+	// If it is empty, the CSP allocates a new address for the NAT Gateway.
+	PublicIPIID IID `json:"PublicIPIID,omitempty" validate:"omitempty"`
+
+	TagList []KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+}
+
+// -------- NAT Gateway API
+type NATGatewayHandler interface {
+
+	//------ NATGateway Management
+	ListIID() ([]*IID, error)
+	CreateNATGateway(natGatewayReqInfo NATGatewayReqInfo) (NATGatewayInfo, error)
+	ListNATGateway() ([]*NATGatewayInfo, error)
+	GetNATGateway(natGatewayIID IID) (NATGatewayInfo, error)
+	DeleteNATGateway(natGatewayIID IID) (bool, error)
+}
@@ -29,6 +29,9 @@ type NLBInfo struct {
 	VMGroup       VMGroupInfo       `json:"VMGroup" validate:"required"`
 	HealthChecker HealthCheckerInfo `json:"HealthChecker" validate:"required"`
 
+	//------ Additional Listeners, optional
+	ListenerRules []ListenerRuleInfo `json:"ListenerRules,omitempty" validate:"omitempty"`
+
 	CreatedTime  time.Time  `json:"CreatedTime" validate:"required" example:"2024-08-27T10:00:00Z"`
 	TagList      []KeyValue `json:"TagList,omitempty" validate:"omitempty"`
 	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"`
@@ -37,11 +40,13 @@ type NLBInfo struct {
 // ListenerInfo represents the frontend listener configuration for an NLB.
 // @description Listener Information for a Network Load Balancer (NLB)
 type ListenerInfo struct {
-	Protocol string `json:"Protocol" validate:"required" example:"TCP"` // TCP|UDP
+	Protocol string `json:"Protocol" validate:"required" example:"TCP"` // TCP|UDP|HTTP|HTTPS
 	IP       string `json:"IP" validate:"omitempty" example:"192.168.0.1"`
 	Port     string `json:"Port" validate:"required" example:"80"` // 1-65535
 	DNSName  string `json:"DNSName" validate:"omitempty" example:"nlb.example.com"`
 
+	CertificateID string `json:"CertificateID,omitempty" validate:"omitempty" example:"arn:aws:acm:ap-northeast-2:123456789012:certificate/abcd"` // HTTPS only, CSP ID of the certificate for TLS termination
+
 	CspID        string     `json:"CspID,omitempty" validate:"omitempty"`
 	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"`
 }
@@ -49,7 +54,7 @@ type ListenerInfo struct {
 // VMGroupInfo represents the backend VM group configuration for an NLB.
 // @description VM Group Information for a Network Load Balancer (NLB)
 type VMGroupInfo struct {
-	Protocol string `json:"Protocol" validate:"required" example:"TCP"` // TCP|UDP
+	Protocol string `json:"Protocol" validate:"required" example:"TCP"` // TCP|UDP|HTTP|HTTPS
 	Port     string `json:"Port" validate:"required" example:"8080"`    // 1-65535
 	VMs      *[]IID `json:"VMs" validate:"required"`
 
@@ -60,11 +65,31 @@ type VMGroupInfo struct {
 // HealthCheckerInfo represents the health check configuration for an NLB.
 // @description Health Checker Information for a Network Load Balancer (NLB)
 type HealthCheckerInfo struct {
-	Protocol  string `json:"Protocol" validate:"required" example:"TCP"` // TCP|HTTP
-	Port      string `json:"Port" validate:"required" example:"80"`      // Listener Port or 1-65535
-	Interval  int    `json:"Interval" validate:"required" example:"30"`  // secs, Interval time between health checks.
-	Timeout   int    `json:"Timeout" validate:"required" example:"5"`    // secs, Waiting time to decide an unhealthy VM when no response.
-	Threshold int    `json:"Threshold" validate:"required" example:"3"`  // num, The number of continuous health checks to change the VM status.
+	Protocol  string `json:"Protocol" validate:"required" example:"TCP"`             // TCP|HTTP|HTTPS
+	Port      string `json:"Port" validate:"required" example:"80"`                  // Listener Port or 1-65535
+	Interval  int    `json:"Interval" validate:"required" example:"30"`              // secs, Interval time between health checks.
+	Timeout   int    `json:"Timeout" validate:"required" example:"5"`                // secs, Waiting time to decide an unhealthy VM when no response.
+	Threshold int    `json:"Threshold" validate:"required" example:"3"`              // num, The number of continuous health checks to change the VM status.
+	Path      string `json:"Path,omitempty" validate:"omitempty" example:"/healthz"` // HTTP|HTTPS only, request path of the health checks
+
+	CspID        string     `json:"CspID,omitempty" validate:"omitempty"`
+	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"`
+}
+
+// ListenerRuleInfo represents an additional listener of an NLB and its target group.
+// The target group forwards the traffic of the listener to the VMs of NLBInfo.VMGroup.
+// @description Listener Rule Information for a Network Load Balancer (NLB)
+type ListenerRuleInfo struct {
+	Listener      ListenerInfo      `json:"Listener" validate:"required"`
+	TargetGroup   TargetGroupInfo   `json:"TargetGroup" validate:"required"`
+	HealthChecker HealthCheckerInfo `json:"HealthChecker" validate:"required"`
+}
+
+// TargetGroupInfo represents the backend protocol and port of a listener rule.
+// @description Target Group Information for a Network Load Balancer (NLB)
+type TargetGroupInfo struct {
+	Protocol string `json:"Protocol" validate:"required" example:"HTTP"` // TCP|UDP|HTTP|HTTPS
+	Port     string `json:"Port" validate:"required" example:"8080"`     // 1-65535
 
 	CspID        string     `json:"CspID,omitempty" validate:"omitempty"`
 	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"`
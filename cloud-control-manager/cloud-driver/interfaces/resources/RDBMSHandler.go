@@ -51,6 +51,12 @@ type RDBMSMetaInfo struct {
 
 	RequiresSubnet        bool `json:"RequiresSubnet"`        // true if SubnetNames is required at creation
 	RequiresSecurityGroup bool `json:"RequiresSecurityGroup"` // true if SecurityGroupNames is required at creation
+
+	// Lifecycle operations after creation. Drivers set these on the result of BuildRDBMSMetaInfo().
+	SupportsChangeSpec        bool `json:"SupportsChangeSpec"`        // true if ChangeSpec() can change DBInstanceSpec
+	SupportsChangeStorageSize bool `json:"SupportsChangeStorageSize"` // true if ChangeStorageSize() can expand StorageSize
+	SupportsStartStop         bool `json:"SupportsStartStop"`         // true if StartRDBMS()/StopRDBMS() are supported
+	SupportsSnapshot          bool `json:"SupportsSnapshot"`          // true if manual snapshots and restore from a snapshot are supported
 }
 
 func NormalizeRDBMSEngine(dbEngine string) (string, error) {
@@ -169,6 +175,32 @@ type RDBMSInfo struct {
 	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"`
 }
 
+// -------- Snapshot Info Structure
+type RDBMSSnapshotStatus string
+
+const (
+	RDBMSSnapshotCreating  RDBMSSnapshotStatus = "Creating"
+	RDBMSSnapshotAvailable RDBMSSnapshotStatus = "Available"
+	RDBMSSnapshotDeleting  RDBMSSnapshotStatus = "Deleting"
+	RDBMSSnapshotError     RDBMSSnapshotStatus = "Error"
+)
+
+// RDBMSSnapshotInfo represents a manual snapshot of an RDBMS instance.
+// @description RDBMS Snapshot Information
+type RDBMSSnapshotInfo struct {
+	IId         IID `json:"IId" validate:"required"`         // {NameId, SystemId}
+	SourceRDBMS IID `json:"SourceRDBMS" validate:"required"` // The RDBMS from which the snapshot was taken
+
+	DBEngine        string `json:"DBEngine,omitempty" example:"mysql"`
+	DBEngineVersion string `json:"DBEngineVersion,omitempty" example:"8.0.35"`
+	StorageSize     string `json:"StorageSize,omitempty" example:"100"` // Storage size of the source RDBMS in GB
+
+	Status RDBMSSnapshotStatus `json:"Status" validate:"required" example:"Available"` // Creating | Available | Deleting | Error
+
+	CreatedTime  time.Time  `json:"CreatedTime,omitempty"`
+	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"`
+}
+
 // -------- RDBMS Handler API
 type RDBMSHandler interface {
 
@@ -182,7 +214,17 @@ type RDBMSHandler interface {
 	GetRDBMS(rdbmsIID IID) (RDBMSInfo, error)
 	DeleteRDBMS(rdbmsIID IID) (bool, error)
 
-	//------ Instance Control (TBD: support will be decided later)
-	// ChangeSpec(rdbmsIID IID, newSpec string) (RDBMSInfo, error)        // Change instance class/spec
-	// ChangeStorageSize(rdbmsIID IID, newSize string) (bool, error)      // Expand storage
+	//------ Instance Control (check the Supports* fields of GetMetaInfo())
+	ChangeSpec(rdbmsIID IID, newSpec string) (RDBMSInfo, error)        // Change instance class/spec
+	ChangeStorageSize(rdbmsIID IID, newSize string) (RDBMSInfo, error) // Expand storage in GB, shrinking is not allowed
+	StartRDBMS(rdbmsIID IID) (bool, error)
+	StopRDBMS(rdbmsIID IID) (bool, error)
+
+	//------ Snapshot Management
+	CreateSnapshot(rdbmsIID IID, snapshotName string) (RDBMSSnapshotInfo, error)
+	ListSnapshot(rdbmsIID IID) ([]*RDBMSSnapshotInfo, error)
+	DeleteSnapshot(snapshotIID IID) (bool, error)
+	// RestoreSnapshot creates a new RDBMS from a snapshot.
+	// rdbmsReqInfo has IId, VpcIID, SubnetIIDs and SecurityGroupIIDs of the new RDBMS; DBInstanceSpec is optional.
+	RestoreSnapshot(snapshotIID IID, rdbmsReqInfo RDBMSInfo) (RDBMSInfo, error)
 }
@@ -37,6 +37,13 @@ const (
 	RDBMS    RSType = "rdbms"
 	PUBLICIP RSType = "publicip"
 	NIC      RSType = "nic"
+
+	VPCPEERING RSType = "vpcpeering"
+	ROUTETABLE RSType = "routetable"
+	NATGATEWAY RSType = "natgateway"
+
+	DISKSNAPSHOT  RSType = "disksnapshot"
+	RDBMSSNAPSHOT RSType = "rdbmssnapshot"
 )
 
 func RSTypeString(rsType RSType) string {
@@ -73,6 +80,16 @@ func RSTypeString(rsType RSType) string {
 		return "Public IP"
 	case NIC:
 		return "Network Interface Card"
+	case VPCPEERING:
+		return "VPC Peering"
+	case ROUTETABLE:
+		return "Route Table"
+	case NATGATEWAY:
+		return "NAT Gateway"
+	case DISKSNAPSHOT:
+		return "Disk Snapshot"
+	case RDBMSSNAPSHOT:
+		return "RDBMS Snapshot"
 	default:
 		return string(rsType) + " is not supported Resource!!"
 
@@ -116,6 +133,16 @@ func StringToRSType(str string) (RSType, error) {
 		return PUBLICIP, nil
 	case "nic":
 		return NIC, nil
+	case "vpcpeering":
+		return VPCPEERING, nil
+	case "routetable":
+		return ROUTETABLE, nil
+	case "natgateway":
+		return NATGATEWAY, nil
+	case "disksnapshot":
+		return DISKSNAPSHOT, nil
+	case "rdbmssnapshot":
+		return RDBMSSNAPSHOT, nil
 	default:
 		return "", fmt.Errorf("%s is not a valid resource type", str)
 	}
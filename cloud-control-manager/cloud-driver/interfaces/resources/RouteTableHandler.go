@@ -0,0 +1,83 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resources interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+// -------- Const
+type RouteTargetType string
+
+const (
+	RouteTargetInternetGateway RouteTargetType = "InternetGateway" // the Internet Gateway of the VPC, TargetIID is not used
+	RouteTargetNATGateway      RouteTargetType = "NATGateway"
+	RouteTargetNIC             RouteTargetType = "NIC"
+	RouteTargetVPCPeering      RouteTargetType = "VPCPeering"
+	RouteTargetLocal           RouteTargetType = "Local" // the route in the VPC made by CSP, read only
+)
+
+type RouteTableStatus string
+
+const (
+	RouteTableAvailable RouteTableStatus = "Available"
+	RouteTableNotFound  RouteTableStatus = "NotFound" // Registered in Spider but not found in CSP
+)
+
+// -------- Info Structure
+// RouteInfo represents a route of a route table.
+type RouteInfo struct {
+	DestinationCIDR string          `json:"DestinationCIDR" validate:"required" example:"0.0.0.0/0"`
+	TargetType      RouteTargetType `json:"TargetType" validate:"required" example:"NATGateway"`
+	TargetIID       IID             `json:"TargetIID,omitempty" validate:"omitempty"` // NATGateway, NIC or VPCPeering
+}
+
+// RouteTableInfo represents the information of a custom route table of a VPC.
+type RouteTableInfo struct {
+	IId    IID `json:"IId" validate:"required"` // {NameId, SystemId}
+	VpcIID IID `json:"VpcIID" validate:"required"`
+
+	Status RouteTableStatus `json:"Status" validate:"required" example:"Available"`
+
+	RouteList  []RouteInfo `json:"RouteList" validate:"required"`
+	SubnetIIDs []IID       `json:"SubnetIIDs,omitempty" validate:"omitempty"` // Subnets associated with the route table
+
+	TagList      []KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"`
+}
+
+// RouteTableReqInfo represents the request information for creating a route table.
+type RouteTableReqInfo struct {
+	IId    IID `json:"IId" validate:"required"`
+	VpcIID IID `json:"VpcIID" validate:"required"`
+
+	RouteList []RouteInfo `json:"RouteList,omitempty" validate:"omitempty"`
+
+	TagList []KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+}
+
+// -------- Route Table API
+type RouteTableHandler interface {
+
+	//------ RouteTable Management
+	ListIID() ([]*IID, error)
+	CreateRouteTable(routeTableReqInfo RouteTableReqInfo) (RouteTableInfo, error)
+	ListRouteTable() ([]*RouteTableInfo, error)
+	GetRouteTable(routeTableIID IID) (RouteTableInfo, error)
+	DeleteRouteTable(routeTableIID IID) (bool, error)
+
+	//------ Route Management
+	AddRoutes(routeTableIID IID, routeList []RouteInfo) (RouteTableInfo, error)
+	// RemoveRoutes removes the routes matched with DestinationCIDR.
+	RemoveRoutes(routeTableIID IID, routeList []RouteInfo) (bool, error)
+
+	//------ Subnet Association
+	// A subnet is associated with one route table, the previous association is replaced.
+	AssociateSubnet(routeTableIID IID, subnetIID IID) (RouteTableInfo, error)
+	// DisassociateSubnet makes the subnet use the default route table of the VPC.
+	DisassociateSubnet(routeTableIID IID, subnetIID IID) (bool, error)
+}
@@ -11,6 +11,10 @@
 
 package resources
 
+import (
+	"fmt"
+)
+
 type SecurityReqInfo struct {
 	IId IID // {NameId, SystemId}
 
@@ -27,6 +31,11 @@ type SecurityRuleInfo struct {
 	FromPort   string `json:"FromPort" validate:"required" example:"22"`               // TCP, UDP: 1~65535, ICMP, ALL: -1
 	ToPort     string `json:"ToPort" validate:"required" example:"22"`                 // TCP, UDP: 1~65535, ICMP, ALL: -1
 	CIDR       string `json:"CIDR,omitempty" validate:"omitempty" example:"0.0.0.0/0"` // if not specified, defaults to 0.0.0.0/0
+
+	// optional, only one of CIDR, CIDRv6 and SourceSecurityGroupIID can be set
+	CIDRv6                 string `json:"CIDRv6,omitempty" validate:"omitempty" example:"::/0"`                 // IPv6 CIDR
+	SourceSecurityGroupIID *IID   `json:"SourceSecurityGroupIID,omitempty" validate:"omitempty"`                // allow from(inbound) or to(outbound) the SecurityGroup
+	Description            string `json:"Description,omitempty" validate:"omitempty" example:"ssh from office"` // max 255 characters
 }
 
 type SecurityInfo struct {
@@ -51,3 +60,23 @@ type SecurityHandler interface {
 	AddRules(sgIID IID, securityRules *[]SecurityRuleInfo) (SecurityInfo, error)
 	RemoveRules(sgIID IID, securityRules *[]SecurityRuleInfo) (bool, error)
 }
+
+// CheckSecurityRuleOptions returns an error if a rule uses an optional field that the driver does not support,
+// so that the field is rejected instead of being dropped silently.
+func CheckSecurityRuleOptions(securityRules *[]SecurityRuleInfo, descriptionSupported, cidrV6Supported, sourceSGSupported bool) error {
+	if securityRules == nil {
+		return nil
+	}
+	for _, rule := range *securityRules {
+		if rule.Description != "" && !descriptionSupported {
+			return fmt.Errorf("this driver does not support the Description of a security rule: %s", rule.Description)
+		}
+		if rule.CIDRv6 != "" && !cidrV6Supported {
+			return fmt.Errorf("this driver does not support the IPv6 CIDR(CIDRv6) of a security rule: %s", rule.CIDRv6)
+		}
+		if rule.SourceSecurityGroupIID != nil && !sourceSGSupported {
+			return fmt.Errorf("this driver does not support the SourceSecurityGroupIID of a security rule: %v", *rule.SourceSecurityGroupIID)
+		}
+	}
+	return nil
+}
@@ -41,6 +41,14 @@ const (
 	WINDOWS    Platform = "WINDOWS"
 )
 
+// VMPurchaseOption represents the purchase model of a VM.
+type VMPurchaseOption string
+
+const (
+	OnDemandVM VMPurchaseOption = "OnDemand"
+	SpotVM     VMPurchaseOption = "Spot" // AWS Spot, GCP Spot VM, Azure Spot, Alibaba preemptible, ...
+)
+
 type VMReqInfo struct {
 	IId IID // {NameId, SystemId}
 
@@ -62,6 +70,11 @@ type VMReqInfo struct {
 	VMUserPasswd string
 	WindowsType  bool
 
+	UserData string // cloud-init script or cloud-config, plain text or base64 encoded (max 16KB)
+
+	PurchaseOption VMPurchaseOption // OnDemand | Spot, default: OnDemand
+	SpotMaxPrice   string           // "": up to the on-demand price, "0.05": max price per hour (USD or CSP currency), Spot only
+
 	TagList []KeyValue
 }
 
@@ -73,7 +86,7 @@ type VMStatusInfo struct {
 // VMStatus represents the possible statuses of a VM.
 // @description The status of a Virtual Machine (VM).
 // @enum string
-// @enum values [Creating, Running, Suspending, Suspended, Resuming, Rebooting, Terminating, Terminated, NotExist, Failed]
+// @enum values [Creating, Running, Suspending, Suspended, Resuming, Rebooting, Terminating, Terminated, NotExist, Interrupted, Failed]
 type VMStatus string
 
 const (
@@ -90,6 +103,8 @@ const (
 	Terminated  VMStatus = "Terminated"
 	NotExist    VMStatus = "NotExist" // VM does not exist
 
+	Interrupted VMStatus = "Interrupted" // Spot VM reclaimed(stopped or terminated) by CSP
+
 	Failed VMStatus = "Failed"
 )
 
@@ -138,6 +153,8 @@ type VMInfo struct {
 
 	Platform Platform `json:"Platform" validate:"required" example:"LINUX"` // LINUX | WINDOWS
 
+	PurchaseOption VMPurchaseOption `json:"PurchaseOption,omitempty" validate:"omitempty" example:"OnDemand"` // OnDemand | Spot
+
 	SSHAccessPoint string `json:"SSHAccessPoint,omitempty" validate:"omitempty" example:"10.2.3.2:22"` // Deprecated
 	AccessPoint    string `json:"AccessPoint" validate:"required" example:"1.2.3.4:22"`                // 10.2.3.2:22, 123.456.789.123:432
 
@@ -155,6 +172,8 @@ type VMHandler interface {
 	RebootVM(vmIID IID) (VMStatus, error)
 	TerminateVM(vmIID IID) (VMStatus, error)
 
+	ChangeVMSpec(vmIID IID, vmSpecName string) (VMInfo, error) // VM should be suspended before if VM_SPEC_CHANGE_NEEDS_STOP is true
+
 	ListVMStatus() ([]*VMStatusInfo, error)
 	GetVMStatus(vmIID IID) (VMStatus, error)
 
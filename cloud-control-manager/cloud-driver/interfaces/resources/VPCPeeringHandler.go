@@ -0,0 +1,74 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resources interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2026.10.
+
+package resources
+
+import "time"
+
+// -------- Const
+type VPCPeeringStatus string
+
+const (
+	VPCPeeringPendingAcceptance VPCPeeringStatus = "PendingAcceptance" // Requested, waiting for the acceptance
+	VPCPeeringActive            VPCPeeringStatus = "Active"            // Accepted, the traffic can be routed
+	VPCPeeringDeleting          VPCPeeringStatus = "Deleting"
+	VPCPeeringError             VPCPeeringStatus = "Error"    // Rejected, expired or failed
+	VPCPeeringNotFound          VPCPeeringStatus = "NotFound" // Registered in Spider but not found in CSP
+)
+
+// -------- Info Structure
+// VPCPeeringInfo represents the information of a peering between two VPCs in the same cloud.
+type VPCPeeringInfo struct {
+	IId IID `json:"IId" validate:"required"` // {NameId, SystemId}
+
+	RequesterVpcIID  IID    `json:"RequesterVpcIID" validate:"required"`
+	RequesterVpcCIDR string `json:"RequesterVpcCIDR,omitempty" validate:"omitempty" example:"10.0.0.0/16"`
+	AccepterVpcIID   IID    `json:"AccepterVpcIID" validate:"required"`
+	AccepterVpcCIDR  string `json:"AccepterVpcCIDR,omitempty" validate:"omitempty" example:"10.1.0.0/16"`
+
+	Status VPCPeeringStatus `json:"Status" validate:"required" example:"Active"`
+
+	RoutedSubnetIIDs []IID `json:"RoutedSubnetIIDs,omitempty" validate:"omitempty"` // Subnets having the route to the peer VPC
+
+	CreatedTime  time.Time  `json:"CreatedTime" validate:"required"`
+	TagList      []KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+	KeyValueList []KeyValue `json:"KeyValueList,omitempty" validate:"omitempty"`
+}
+
+// VPCPeeringReqInfo represents the request information for creating a VPC peering.
+type VPCPeeringReqInfo struct {
+	IId IID `json:"IId" validate:"required"`
+
+	RequesterVpcIID IID `json:"RequesterVpcIID" validate:"required"`
+	AccepterVpcIID  IID `json:"AccepterVpcIID" validate:"required"`
+
+	TagList []KeyValue `json:"TagList,omitempty" validate:"omitempty"`
+}
+
+// -------- VPC Peering API
+type VPCPeeringHandler interface {
+
+	//------ VPC Peering Management
+	ListIID() ([]*IID, error)
+	CreateVPCPeering(peeringReqInfo VPCPeeringReqInfo) (VPCPeeringInfo, error)
+	// AcceptVPCPeering accepts a PendingAcceptance peering.
+	// In the CSPs activating a peering without the acceptance, it returns the current info.
+	AcceptVPCPeering(peeringIID IID) (VPCPeeringInfo, error)
+	ListVPCPeering() ([]*VPCPeeringInfo, error)
+	GetVPCPeering(peeringIID IID) (VPCPeeringInfo, error)
+	DeleteVPCPeering(peeringIID IID) (bool, error)
+
+	//------ Route Propagation
+	// AddPeeringRoutes adds the route to the peer VPC's CIDR into the route table of each subnet.
+	// A subnet can belong to the requester VPC or the accepter VPC.
+	AddPeeringRoutes(peeringIID IID, subnetIIDs []IID) (VPCPeeringInfo, error)
+	// RemovePeeringRoutes removes the routes added by AddPeeringRoutes.
+	RemovePeeringRoutes(peeringIID IID, subnetIIDs []IID) (bool, error)
+}
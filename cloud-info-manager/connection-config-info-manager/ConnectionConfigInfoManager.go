@@ -14,12 +14,16 @@ import (
 
 	cblogger "github.com/cloud-barista/cb-log"
 	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
 
 	infostore "github.com/cloud-barista/cb-spider/info-store"
 )
 
 // ====================================================================
 const KEY_COLUMN_NAME = "config_name"
+const PROVIDER_NAME_COLUMN = "provider_name"
+const CREDENTIAL_NAME_COLUMN = "credential_name"
+const REGION_NAME_COLUMN = "region_name"
 
 // ConnectionConfigInfo represents the configuration information for cloud connection.
 // @Description Information about the connection configuration used to connect to a specific cloud provider.
@@ -115,6 +119,134 @@ func GetConnectionConfig(configName string) (*ConnectionConfigInfo, error) {
 	return &connectionConfigInfo, err
 }
 
+// 1. check params
+// 2. check the driver, credential and region of the same provider
+// 3. update ConnectionConfigInfo in info-store
+//
+// The ConfigName can not be changed, because the resources are managed with it.
+func UpdateConnectionConfigInfo(configName string, configInfo ConnectionConfigInfo) (*ConnectionConfigInfo, error) {
+	cblog.Info("call UpdateConnectionConfigInfo()")
+
+	configName = strings.TrimSpace(configName)
+	if configName == "" {
+		return nil, fmt.Errorf("ConfigName is empty!")
+	}
+
+	oldInfo, err := GetConnectionConfig(configName)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(configInfo.ConfigName) == "" {
+		configInfo.ConfigName = configName
+	}
+	if strings.TrimSpace(configInfo.ConfigName) != configName {
+		return nil, fmt.Errorf("ConfigName(%s) can not be changed to '%s'!", configName, configInfo.ConfigName)
+	}
+	if strings.TrimSpace(configInfo.ProviderName) == "" {
+		configInfo.ProviderName = oldInfo.ProviderName
+	}
+
+	cblog.Debug("check params")
+	err = checkParams(configInfo.ConfigName,
+		configInfo.ProviderName, configInfo.DriverName, configInfo.CredentialName, configInfo.RegionName)
+	if err != nil {
+		return nil, err
+	}
+
+	// trim user inputs
+	configInfo.ConfigName = strings.TrimSpace(configInfo.ConfigName)
+	configInfo.ProviderName = strings.ToUpper(strings.TrimSpace(configInfo.ProviderName))
+	configInfo.DriverName = strings.TrimSpace(configInfo.DriverName)
+	configInfo.CredentialName = strings.TrimSpace(configInfo.CredentialName)
+	configInfo.RegionName = strings.TrimSpace(configInfo.RegionName)
+
+	if configInfo.ProviderName != oldInfo.ProviderName {
+		return nil, fmt.Errorf("ProviderName(%s) of '%s' can not be changed to '%s'!",
+			oldInfo.ProviderName, configName, configInfo.ProviderName)
+	}
+
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	defer infostore.Close(db)
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := checkReference(tx, "cloud_driver_infos", "driver_name", configInfo.DriverName, configInfo.ProviderName); err != nil {
+			return err
+		}
+		if err := checkReference(tx, "credential_infos", CREDENTIAL_NAME_COLUMN, configInfo.CredentialName, configInfo.ProviderName); err != nil {
+			return err
+		}
+		if err := checkReference(tx, "region_infos", REGION_NAME_COLUMN, configInfo.RegionName, configInfo.ProviderName); err != nil {
+			return err
+		}
+		return tx.Save(&configInfo).Error
+	})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &configInfo, nil
+}
+
+// checkReference checks the driver, credential or region exists with the provider name.
+func checkReference(tx *gorm.DB, tableName string, columnName string, name string, providerName string) error {
+	var providerNameList []string
+	err := tx.Table(tableName).Where(columnName+" = ?", name).Pluck(PROVIDER_NAME_COLUMN, &providerNameList).Error
+	if err != nil {
+		return err
+	}
+	if len(providerNameList) == 0 {
+		return fmt.Errorf("%s: does not exist!", name)
+	}
+	if !strings.EqualFold(providerNameList[0], providerName) {
+		return fmt.Errorf("%s is for %s, not for %s!", name, providerNameList[0], providerName)
+	}
+	return nil
+}
+
+// UpdateReferences updates the connection configs referencing a credential or region in the transaction
+// of the credential or region update.
+//   - columnName: CREDENTIAL_NAME_COLUMN or REGION_NAME_COLUMN
+//   - the connection configs get the newName, if the name is changed.
+//   - the provider can not be changed while connection configs reference it.
+//
+// It returns the number of the referencing connection configs.
+func UpdateReferences(tx *gorm.DB, columnName string, oldName string, newName string, providerName string) (int64, error) {
+	var count int64
+	err := tx.Model(&ConnectionConfigInfo{}).Where(columnName+" = ?", oldName).Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	if count == 0 {
+		return 0, nil
+	}
+
+	var otherProviderCount int64
+	err = tx.Model(&ConnectionConfigInfo{}).Where(columnName+" = ? AND "+PROVIDER_NAME_COLUMN+" <> ?", oldName, providerName).
+		Count(&otherProviderCount).Error
+	if err != nil {
+		return 0, err
+	}
+	if otherProviderCount > 0 {
+		return 0, fmt.Errorf("%s is used by %d connection config(s) of another provider, ProviderName can not be changed to '%s'!",
+			oldName, otherProviderCount, providerName)
+	}
+
+	if oldName != newName {
+		err = tx.Model(&ConnectionConfigInfo{}).Where(columnName+" = ?", oldName).Update(columnName, newName).Error
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return count, nil
+}
+
 func DeleteConnectionConfig(configName string) (bool, error) {
 	cblog.Info("call DeleteConnectionConfig()")
 
@@ -16,21 +16,21 @@ import (
 	cblogger "github.com/cloud-barista/cb-log"
 	icdrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	cim "github.com/cloud-barista/cb-spider/cloud-info-manager"
+	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
 	infostore "github.com/cloud-barista/cb-spider/info-store"
 
 	"github.com/sirupsen/logrus"
-
-	"crypto/aes"
-	"crypto/cipher"
-	"crypto/rand"
-	"encoding/base64"
-	"io"
+	"gorm.io/gorm"
 )
 
 // ====================================================================
 const KEY_COLUMN_NAME = "credential_name"
 const PROVIDER_NAME_COLUMN = "provider_name"
 
+// the value shown instead of a credential value.
+// With UpdateCredentialInfo(), the key with this value keeps the current value.
+const HIDDEN_VALUE = "Hidden for security."
+
 // CredentialInfo represents the information of a cloud credential.
 // @Description Information about a specific cloud credential used for authentication.
 type CredentialInfo struct {
@@ -71,6 +71,12 @@ func RegisterCredentialInfo(crdInfo CredentialInfo) (*CredentialInfo, error) {
 		return nil, err
 	}
 
+	// check the secret references(ex: vault://path#key) in the values
+	err = checkSecretReferences(crdInfo.KeyValueInfoList)
+	if err != nil {
+		return nil, err
+	}
+
 	// trim user inputs
 	crdInfo.CredentialName = strings.TrimSpace(crdInfo.CredentialName)
 	crdInfo.ProviderName = strings.ToUpper(strings.TrimSpace(crdInfo.ProviderName))
@@ -90,7 +96,7 @@ func RegisterCredentialInfo(crdInfo CredentialInfo) (*CredentialInfo, error) {
 	// Hide credential data for security
 	kvList := []icdrs.KeyValue{}
 	for _, kv := range crdInfo.KeyValueInfoList {
-		kv.Value = "Hidden for security."
+		kv.Value = HIDDEN_VALUE
 		kvList = append(kvList, kv)
 	}
 
@@ -149,7 +155,7 @@ func ListCredential() ([]*CredentialInfo, error) {
 
 		kvList := []icdrs.KeyValue{}
 		for _, kv := range info.KeyValueInfoList {
-			kv.Value = "Hidden for security."
+			kv.Value = HIDDEN_VALUE
 			kvList = append(kvList, kv)
 		}
 		info.KeyValueInfoList = kvList
@@ -176,7 +182,7 @@ func ListCredentialByProvider(providerName string) ([]*CredentialInfo, error) {
 
 		kvList := []icdrs.KeyValue{}
 		for _, kv := range info.KeyValueInfoList {
-			kv.Value = "Hidden for security."
+			kv.Value = HIDDEN_VALUE
 			kvList = append(kvList, kv)
 		}
 		info.KeyValueInfoList = kvList
@@ -206,7 +212,7 @@ func GetCredential(credentialName string) (*CredentialInfo, error) {
 	// Hide credential data for security
 	kvList := []icdrs.KeyValue{}
 	for _, kv := range credentialInfo.KeyValueInfoList {
-		kv.Value = "Hidden for security."
+		kv.Value = HIDDEN_VALUE
 		kvList = append(kvList, kv)
 	}
 	credentialInfo.KeyValueInfoList = kvList
@@ -217,6 +223,7 @@ func GetCredential(credentialName string) (*CredentialInfo, error) {
 // 1. check params
 // 2. get CredentialInfo from info-store
 // 3. decrypt CrednetialInfo
+// 4. resolve secret references(ex: vault://path#key) in CredentialInfo
 func GetCredentialDecrypt(credentialName string) (*CredentialInfo, error) {
 	cblog.Info("call GetCredential()")
 
@@ -235,9 +242,137 @@ func GetCredentialDecrypt(credentialName string) (*CredentialInfo, error) {
 	if err != nil {
 		return &CredentialInfo{}, err
 	}
+
+	// get the values of secret references from the secret providers
+	err = resolveKeyValueList(credentialInfo.KeyValueInfoList)
+	if err != nil {
+		return &CredentialInfo{}, err
+	}
 	return &credentialInfo, nil
 }
 
+// 1. check params and validation of credential-key
+// 2. keep the current values of the keys with HIDDEN_VALUE
+// 3. update CredentialInfo and the referencing connection configs in a transaction
+//
+// If the CredentialName is changed, the referencing connection configs get the new name.
+func UpdateCredentialInfo(credentialName string, crdInfo CredentialInfo) (*CredentialInfo, error) {
+	cblog.Info("call UpdateCredentialInfo()")
+
+	credentialName = strings.TrimSpace(credentialName)
+	if credentialName == "" {
+		return nil, fmt.Errorf("CredentialName is empty!")
+	}
+
+	var oldInfo CredentialInfo
+	err := infostore.Get(&oldInfo, KEY_COLUMN_NAME, credentialName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if strings.TrimSpace(crdInfo.CredentialName) == "" {
+		crdInfo.CredentialName = credentialName
+	}
+	if strings.TrimSpace(crdInfo.ProviderName) == "" {
+		crdInfo.ProviderName = oldInfo.ProviderName
+	}
+
+	// If Input credential Key are csp format, we convert Key Names to spider Key Names
+	kvInfoList, err := mapCredentialsCSPKeyToSpiderKeys(crdInfo.ProviderName, crdInfo.KeyValueInfoList)
+	if err != nil {
+		return nil, err
+	}
+
+	// keep the current values, ex) the values of GetCredential() are sent back
+	err = decryptKeyValueList(oldInfo.KeyValueInfoList)
+	if err != nil {
+		return nil, err
+	}
+	for i, kv := range kvInfoList {
+		if kv.Value != HIDDEN_VALUE {
+			continue
+		}
+		found := false
+		for _, oldKV := range oldInfo.KeyValueInfoList {
+			if strings.EqualFold(oldKV.Key, kv.Key) {
+				kvInfoList[i].Value = oldKV.Value
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("the value of '%s' is hidden, but %s has no current value of '%s'!", kv.Key, credentialName, kv.Key)
+		}
+	}
+	crdInfo.KeyValueInfoList = kvInfoList
+
+	// check params and validation of credential-key
+	err = checkParams(crdInfo.CredentialName, crdInfo.ProviderName, crdInfo.KeyValueInfoList)
+	if err != nil {
+		return nil, err
+	}
+
+	// check the secret references(ex: vault://path#key) in the values
+	err = checkSecretReferences(crdInfo.KeyValueInfoList)
+	if err != nil {
+		return nil, err
+	}
+
+	// trim user inputs
+	crdInfo.CredentialName = strings.TrimSpace(crdInfo.CredentialName)
+	crdInfo.ProviderName = strings.ToUpper(strings.TrimSpace(crdInfo.ProviderName))
+
+	err = encryptKeyValueList(crdInfo.KeyValueInfoList)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	defer infostore.Close(db)
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if crdInfo.CredentialName != credentialName {
+			var count int64
+			if err := tx.Model(&CredentialInfo{}).Where(KEY_COLUMN_NAME+" = ?", crdInfo.CredentialName).Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return fmt.Errorf("%s: already exists!", crdInfo.CredentialName)
+			}
+			if err := tx.Delete(&CredentialInfo{}, KEY_COLUMN_NAME+" = ?", credentialName).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Save(&crdInfo).Error; err != nil {
+			return err
+		}
+		_, err := ccim.UpdateReferences(tx, ccim.CREDENTIAL_NAME_COLUMN, credentialName, crdInfo.CredentialName, crdInfo.ProviderName)
+		return err
+	})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// the secrets of the old references are not used anymore
+	ClearSecretCache()
+
+	// Hide credential data for security
+	kvList := []icdrs.KeyValue{}
+	for _, kv := range crdInfo.KeyValueInfoList {
+		kv.Value = HIDDEN_VALUE
+		kvList = append(kvList, kv)
+	}
+	crdInfo.KeyValueInfoList = kvList
+
+	return &crdInfo, nil
+}
+
 func UnRegisterCredential(credentialName string) (bool, error) {
 	cblog.Info("call UnRegisterCredential()")
 
@@ -284,15 +419,18 @@ func checkParams(credentialName string, providerName string, keyValueInfoList []
 	return nil
 }
 
-// #######################################################################
-// @todo get from Env file. by powerkim, 2020.06.01.
-var SPIDER_KEY = []byte("cloud-barista-cb-spider-cloud-ba") // 32 bytes
-//#######################################################################
-
 func encryptKeyValueList(keyValueInfoList []icdrs.KeyValue) error {
+	masterKey, err := MasterKey()
+	if err != nil {
+		return err
+	}
 
 	for i, kv := range keyValueInfoList {
-		encString, err := Encrypt(SPIDER_KEY, []byte(kv.Value))
+		// secret reference is not a secret, keep it as is
+		if IsSecretReference(kv.Value) {
+			continue
+		}
+		encString, err := Encrypt(masterKey, []byte(kv.Value))
 		if err != nil {
 			return err
 		}
@@ -303,9 +441,16 @@ func encryptKeyValueList(keyValueInfoList []icdrs.KeyValue) error {
 }
 
 func decryptKeyValueList(keyValueInfoList []icdrs.KeyValue) error {
+	masterKey, err := MasterKey()
+	if err != nil {
+		return err
+	}
 
 	for i, kv := range keyValueInfoList {
-		decString, err := Decrypt(SPIDER_KEY, []byte(kv.Value))
+		if IsSecretReference(kv.Value) {
+			continue
+		}
+		decString, err := Decrypt(masterKey, []byte(kv.Value))
 		if err != nil {
 			return err
 		}
@@ -314,46 +459,3 @@ func decryptKeyValueList(keyValueInfoList []icdrs.KeyValue) error {
 	}
 	return nil
 }
-
-// encription with spider key
-func Encrypt(spider_key, contents []byte) (string, error) {
-
-	block, err := aes.NewCipher(spider_key)
-	if err != nil {
-		return "", err
-	}
-
-	ciphertext := make([]byte, aes.BlockSize+len(contents))
-	iv := ciphertext[:aes.BlockSize]
-	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
-		return "", err
-	}
-
-	stream := cipher.NewCTR(block, iv)
-	stream.XORKeyStream(ciphertext[aes.BlockSize:], contents)
-
-	return base64.StdEncoding.EncodeToString(ciphertext), nil
-}
-
-// decryption with spider key
-func Decrypt(spider_key, contents []byte) (string, error) {
-
-	ciphertext, err := base64.StdEncoding.DecodeString(string(contents))
-	if err != nil {
-		return "", err
-	}
-
-	block, err := aes.NewCipher(spider_key)
-	if err != nil {
-		return "", err
-	}
-
-	iv := ciphertext[:aes.BlockSize]
-	ciphertext = ciphertext[aes.BlockSize:]
-
-	stream := cipher.NewCTR(block, iv)
-	plaintext := make([]byte, len(ciphertext))
-	stream.XORKeyStream(plaintext, ciphertext)
-
-	return string(plaintext), nil
-}
@@ -0,0 +1,501 @@
+// Cloud Credential Info. Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Master Key for the encryption of credentials and private keys in Spider MetaDB.
+//
+// by CB-Spider Team, 2026.10.
+
+package credentialinfomanager
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+	"gorm.io/gorm"
+)
+
+// ====================================================================
+// Master Key Configuration
+//
+// (1) SPIDER_MASTER_KEY="<key>": master key itself
+// (2) SPIDER_MASTER_KEY_FILE="<path>": file with the master key
+//     default: $CBSPIDER_ROOT/meta_db/.spider_master_key, created at the first start
+// (3) SPIDER_MASTER_KEY_PREVIOUS="<key>,<key>": old keys to read the records not rotated yet
+//
+// A key is 32 bytes in raw, hex(64 chars) or base64 format.
+// In the key file, the first line is the current key and the next lines are old keys.
+
+const MASTER_KEY_ENV = "SPIDER_MASTER_KEY"
+const MASTER_KEY_FILE_ENV = "SPIDER_MASTER_KEY_FILE"
+const PREVIOUS_MASTER_KEY_ENV = "SPIDER_MASTER_KEY_PREVIOUS"
+
+const MASTER_KEY_SIZE = 32 // AES-256
+
+// versioned ciphertext: "spv2:<KeyId>:<base64(nonce + AES-GCM sealed data)>"
+// no version prefix: legacy AES-CTR ciphertext with the legacy key
+const cipherV2Prefix = "spv2:"
+
+// legacy key compiled into old binaries, only used to read legacy records.
+var legacySpiderKey = []byte("cloud-barista-cb-spider-cloud-ba") // 32 bytes
+
+var (
+	masterKeyLock  sync.RWMutex
+	currentKey     []byte
+	previousKeys   = map[string][]byte{} // KeyId => key
+	masterKeyFile  string                // empty if the key is from SPIDER_MASTER_KEY
+	masterKeyError error                 // error while loading the master key
+)
+
+// MasterKeyInfo represents the status of the master key. It does not include any key.
+type MasterKeyInfo struct {
+	KeyId          string   `json:"KeyId" example:"3f2a9c1b"`                                            // ID of the current master key
+	KeySource      string   `json:"KeySource" example:"file:/root/cb-spider/meta_db/.spider_master_key"` // env or file:<path>
+	PreviousKeyIds []string `json:"PreviousKeyIds"`                                                      // IDs of the old keys still readable
+}
+
+// MasterKeyRotationInfo represents the result of the master key rotation.
+type MasterKeyRotationInfo struct {
+	MasterKeyInfo
+	RotatedCredentialCount int `json:"RotatedCredentialCount" example:"3"` // number of re-encrypted CredentialInfo
+	RotatedPrivateKeyCount int `json:"RotatedPrivateKeyCount" example:"5"` // number of re-encrypted VM KeyPair private keys
+}
+
+//====================================================================
+
+func init() {
+	masterKeyError = loadMasterKey()
+	if masterKeyError != nil {
+		// without master key, encryption and decryption return this error.
+		cblog.Errorf("failed to load the master key: %v", masterKeyError)
+	}
+}
+
+func loadMasterKey() error {
+	for _, keyString := range splitKeyList(os.Getenv(PREVIOUS_MASTER_KEY_ENV), ",") {
+		key, err := parseMasterKey(keyString)
+		if err != nil {
+			return fmt.Errorf("%s: %v", PREVIOUS_MASTER_KEY_ENV, err)
+		}
+		previousKeys[KeyId(key)] = key
+	}
+
+	// (1) from env
+	if keyString := strings.TrimSpace(os.Getenv(MASTER_KEY_ENV)); keyString != "" {
+		key, err := parseMasterKey(keyString)
+		if err != nil {
+			return fmt.Errorf("%s: %v", MASTER_KEY_ENV, err)
+		}
+		currentKey = key
+		delete(previousKeys, KeyId(key))
+		return nil
+	}
+
+	// (2) from key file
+	masterKeyFile = strings.TrimSpace(os.Getenv(MASTER_KEY_FILE_ENV))
+	if masterKeyFile == "" {
+		masterKeyFile = filepath.Join(os.Getenv("CBSPIDER_ROOT"), "meta_db", ".spider_master_key")
+	}
+
+	data, err := os.ReadFile(masterKeyFile)
+	if os.IsNotExist(err) && os.Getenv(MASTER_KEY_FILE_ENV) == "" {
+		// first start: create a new master key file
+		key := make([]byte, MASTER_KEY_SIZE)
+		if _, err := io.ReadFull(rand.Reader, key); err != nil {
+			return err
+		}
+		if err := writeMasterKeyFile(masterKeyFile, key, nil); err != nil {
+			return err
+		}
+		currentKey = key
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	keyList := splitKeyList(string(data), "\n")
+	if len(keyList) == 0 {
+		return fmt.Errorf("%s: no master key in the file", masterKeyFile)
+	}
+	for i, keyString := range keyList {
+		key, err := parseMasterKey(keyString)
+		if err != nil {
+			return fmt.Errorf("%s(line %d): %v", masterKeyFile, i+1, err)
+		}
+		if i == 0 {
+			currentKey = key
+		} else {
+			previousKeys[KeyId(key)] = key
+		}
+	}
+	delete(previousKeys, KeyId(currentKey))
+	return nil
+}
+
+// split key list and remove empty lines and comments
+func splitKeyList(keyList string, sep string) []string {
+	ret := []string{}
+	for _, keyString := range strings.Split(keyList, sep) {
+		keyString = strings.TrimSpace(keyString)
+		if keyString == "" || strings.HasPrefix(keyString, "#") {
+			continue
+		}
+		ret = append(ret, keyString)
+	}
+	return ret
+}
+
+// parseMasterKey accepts a 32 bytes key in hex, base64 or raw format.
+func parseMasterKey(keyString string) ([]byte, error) {
+	keyString = strings.TrimSpace(keyString)
+
+	if len(keyString) == hex.EncodedLen(MASTER_KEY_SIZE) {
+		if key, err := hex.DecodeString(keyString); err == nil {
+			return key, nil
+		}
+	}
+	if key, err := base64.StdEncoding.DecodeString(keyString); err == nil && len(key) == MASTER_KEY_SIZE {
+		return key, nil
+	}
+	if len(keyString) == MASTER_KEY_SIZE {
+		return []byte(keyString), nil
+	}
+
+	return nil, fmt.Errorf("master key must be %d bytes in raw, hex or base64 format", MASTER_KEY_SIZE)
+}
+
+func writeMasterKeyFile(path string, key []byte, oldKeys [][]byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+	sb.WriteString("# CB-Spider master key. The first key is the current key, the others are old keys.\n")
+	sb.WriteString("# Keep this file safe: credentials in Spider MetaDB can not be read without it.\n")
+	sb.WriteString(hex.EncodeToString(key) + "\n")
+	for _, oldKey := range oldKeys {
+		sb.WriteString(hex.EncodeToString(oldKey) + "\n")
+	}
+
+	// write to a temp file and rename it, not to lose the key file while writing
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, []byte(sb.String()), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// KeyId returns the short ID of the key, which is saved with the ciphertext.
+func KeyId(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:4])
+}
+
+// MasterKey returns the current master key.
+func MasterKey() ([]byte, error) {
+	masterKeyLock.RLock()
+	defer masterKeyLock.RUnlock()
+
+	if masterKeyError != nil {
+		return nil, masterKeyError
+	}
+	return currentKey, nil
+}
+
+// GetMasterKeyInfo returns the status of the master key without the key itself.
+func GetMasterKeyInfo() (*MasterKeyInfo, error) {
+	cblog.Info("call GetMasterKeyInfo()")
+
+	masterKeyLock.RLock()
+	defer masterKeyLock.RUnlock()
+
+	if masterKeyError != nil {
+		return nil, masterKeyError
+	}
+	return getMasterKeyInfo(), nil
+}
+
+func getMasterKeyInfo() *MasterKeyInfo {
+	info := &MasterKeyInfo{
+		KeyId:          KeyId(currentKey),
+		KeySource:      "env",
+		PreviousKeyIds: []string{},
+	}
+	if masterKeyFile != "" {
+		info.KeySource = "file:" + masterKeyFile
+	}
+	for keyId := range previousKeys {
+		info.PreviousKeyIds = append(info.PreviousKeyIds, keyId)
+	}
+	return info
+}
+
+// RotateMasterKey re-encrypts every CredentialInfo and VM KeyPair private key with the new master key.
+// If newKeyString is empty, a new random key is made. It is allowed only when the key is from the key file.
+// With the key file, the new key is saved as the current key and the old key is kept as an old key.
+// With SPIDER_MASTER_KEY, the user has to set the new key to SPIDER_MASTER_KEY before the next start.
+func RotateMasterKey(newKeyString string) (*MasterKeyRotationInfo, error) {
+	cblog.Info("call RotateMasterKey()")
+
+	masterKeyLock.Lock()
+	defer masterKeyLock.Unlock()
+
+	if masterKeyError != nil {
+		return nil, masterKeyError
+	}
+
+	var newKey []byte
+	var err error
+	if strings.TrimSpace(newKeyString) == "" {
+		if masterKeyFile == "" {
+			return nil, fmt.Errorf("new master key is required when the master key is set by %s", MASTER_KEY_ENV)
+		}
+		newKey = make([]byte, MASTER_KEY_SIZE)
+		if _, err := io.ReadFull(rand.Reader, newKey); err != nil {
+			return nil, err
+		}
+	} else {
+		newKey, err = parseMasterKey(newKeyString)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if KeyId(newKey) == KeyId(currentKey) {
+		return nil, fmt.Errorf("new master key is the same as the current master key")
+	}
+
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	defer infostore.Close(db)
+
+	result := MasterKeyRotationInfo{}
+	err = db.Transaction(func(tx *gorm.DB) error {
+		// (1) credentials
+		var credentialInfoList []*CredentialInfo
+		if err := tx.Find(&credentialInfoList).Error; err != nil {
+			return err
+		}
+		for _, credentialInfo := range credentialInfoList {
+			for i, kv := range credentialInfo.KeyValueInfoList {
+				value, err := reEncrypt(newKey, kv.Value)
+				if err != nil {
+					return fmt.Errorf("credential '%s': %v", credentialInfo.CredentialName, err)
+				}
+				credentialInfo.KeyValueInfoList[i].Value = value
+			}
+			if err := tx.Save(credentialInfo).Error; err != nil {
+				return err
+			}
+			result.RotatedCredentialCount++
+		}
+
+		// (2) VM KeyPair private keys managed by Spider
+		if !tx.Migrator().HasTable("local_key_infos") {
+			return nil
+		}
+		var localKeyList []map[string]interface{}
+		if err := tx.Table("local_key_infos").Find(&localKeyList).Error; err != nil {
+			return err
+		}
+		for _, localKey := range localKeyList {
+			privateKey, _ := localKey["private_key"].(string)
+			value, err := reEncrypt(newKey, privateKey)
+			if err != nil {
+				return fmt.Errorf("private key of '%v': %v", localKey["name_id"], err)
+			}
+			err = tx.Table("local_key_infos").
+				Where("provider_name = ? AND hash_string = ? AND name_id = ?",
+					localKey["provider_name"], localKey["hash_string"], localKey["name_id"]).
+				Update("private_key", value).Error
+			if err != nil {
+				return err
+			}
+			result.RotatedPrivateKeyCount++
+		}
+		return nil
+	})
+	if err != nil {
+		cblog.Error(err)
+		return nil, fmt.Errorf("failed to rotate the master key, nothing is changed: %v", err)
+	}
+
+	// keep the old key to read the backups of MetaDB
+	oldKey := currentKey
+	previousKeys[KeyId(oldKey)] = oldKey
+	currentKey = newKey
+	delete(previousKeys, KeyId(newKey))
+
+	if masterKeyFile != "" {
+		oldKeys := [][]byte{oldKey}
+		for keyId, key := range previousKeys {
+			if keyId != KeyId(oldKey) {
+				oldKeys = append(oldKeys, key)
+			}
+		}
+		if err := writeMasterKeyFile(masterKeyFile, newKey, oldKeys); err != nil {
+			cblog.Error(err)
+			return nil, fmt.Errorf("records are re-encrypted with the new key(%s), but failed to save the key file: %v",
+				KeyId(newKey), err)
+		}
+	} else {
+		cblog.Warnf("master key is rotated to '%s'. Set the new key to %s and the old key to %s before the next start.",
+			KeyId(newKey), MASTER_KEY_ENV, PREVIOUS_MASTER_KEY_ENV)
+	}
+
+	result.MasterKeyInfo = *getMasterKeyInfo()
+	return &result, nil
+}
+
+// decrypt with the current or old keys and encrypt with the new key
+func reEncrypt(newKey []byte, encValue string) (string, error) {
+	if IsSecretReference(encValue) {
+		return encValue, nil
+	}
+	plainText, err := decrypt(currentKey, []byte(encValue))
+	if err != nil {
+		return "", err
+	}
+	return Encrypt(newKey, []byte(plainText))
+}
+
+// EncryptWithMasterKey encrypts contents with the current master key.
+func EncryptWithMasterKey(contents []byte) (string, error) {
+	masterKey, err := MasterKey()
+	if err != nil {
+		return "", err
+	}
+	return Encrypt(masterKey, contents)
+}
+
+// DecryptWithMasterKey decrypts contents with the current or old master keys.
+func DecryptWithMasterKey(contents []byte) (string, error) {
+	masterKey, err := MasterKey()
+	if err != nil {
+		return "", err
+	}
+	return Decrypt(masterKey, contents)
+}
+
+// Encrypt encrypts contents with the key by AES-GCM and returns the versioned ciphertext.
+func Encrypt(key, contents []byte) (string, error) {
+	if key == nil {
+		return "", fmt.Errorf("master key is not loaded: %v", masterKeyError)
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	sealed := gcm.Seal(nonce, nonce, contents, nil)
+
+	return cipherV2Prefix + KeyId(key) + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts the versioned ciphertext with the key or an old key of the same KeyId.
+// The legacy ciphertext without version is decrypted with the legacy key.
+func Decrypt(key, contents []byte) (string, error) {
+	masterKeyLock.RLock()
+	defer masterKeyLock.RUnlock()
+
+	return decrypt(key, contents)
+}
+
+func decrypt(key, contents []byte) (string, error) {
+	encValue := string(contents)
+	if !strings.HasPrefix(encValue, cipherV2Prefix) {
+		return decryptLegacy(legacySpiderKey, contents)
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(encValue, cipherV2Prefix), ":", 2)
+	if len(parts) != 2 {
+		return "", fmt.Errorf("invalid encrypted data format")
+	}
+	keyId := parts[0]
+
+	switch {
+	case key != nil && KeyId(key) == keyId:
+	case currentKey != nil && KeyId(currentKey) == keyId:
+		key = currentKey
+	case previousKeys[keyId] != nil:
+		key = previousKeys[keyId]
+	default:
+		return "", fmt.Errorf("data was encrypted with an unknown master key(KeyId: %s). Set the key to %s",
+			keyId, PREVIOUS_MASTER_KEY_ENV)
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", fmt.Errorf("invalid encrypted data: too short")
+	}
+
+	nonce, cipherText := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	plainText, err := gcm.Open(nil, nonce, cipherText, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt: wrong master key or corrupted data")
+	}
+	return string(plainText), nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// decryptLegacy decrypts the legacy AES-CTR ciphertext.
+func decryptLegacy(key, contents []byte) (string, error) {
+
+	ciphertext, err := base64.StdEncoding.DecodeString(string(contents))
+	if err != nil {
+		return "", err
+	}
+	if len(ciphertext) < aes.BlockSize {
+		return "", fmt.Errorf("invalid encrypted data: too short")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", err
+	}
+
+	iv := ciphertext[:aes.BlockSize]
+	ciphertext = ciphertext[aes.BlockSize:]
+
+	stream := cipher.NewCTR(block, iv)
+	plaintext := make([]byte, len(ciphertext))
+	stream.XORKeyStream(plaintext, ciphertext)
+
+	return string(plaintext), nil
+}
@@ -0,0 +1,322 @@
+// Cloud Credential Info. Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Secret Provider for the credential values kept out of Spider MetaDB.
+//
+// by CB-Spider Team, 2026.10.
+
+package credentialinfomanager
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	icdrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// ====================================================================
+// Secret Reference
+//
+// A credential value can be a reference to a secret in an external secret backend,
+// instead of the value itself: "<scheme>://<path>#<key>"
+//
+// ex) vault://secret/data/cb-spider/aws#ClientSecret
+//     env://AWS_SECRET_ACCESS_KEY
+//     file:///etc/cb-spider/aws.json#ClientSecret
+//     httpsecret://aws/prod#ClientSecret
+//
+// The reference is kept in Spider MetaDB, and the value is resolved when the credential is used.
+//
+// SPIDER_SECRET_PROVIDERS: the built-in providers to enable. (default: vault,httpsecret)
+//   env and file read the environment and the files of Spider server, so they are enabled only when listed.
+// SPIDER_SECRET_FILE_DIR: the base directory of the file provider. The files out of it are rejected.
+// SPIDER_SECRET_CACHE_TTL: how long the resolved secrets are cached. (default: 1m, 0: no cache)
+// SPIDER_SECRET_REFERENCE_ONLY: ON to reject the credential values that are not references.
+
+const SECRET_PROVIDERS_ENV = "SPIDER_SECRET_PROVIDERS"
+const SECRET_FILE_DIR_ENV = "SPIDER_SECRET_FILE_DIR"
+const SECRET_CACHE_TTL_ENV = "SPIDER_SECRET_CACHE_TTL"
+const SECRET_REFERENCE_ONLY_ENV = "SPIDER_SECRET_REFERENCE_ONLY"
+
+const defaultSecretProviders = "vault,httpsecret"
+const defaultSecretCacheTTL = time.Minute
+
+// SecretProvider gets secrets from an external secret backend.
+type SecretProvider interface {
+	// Scheme returns the scheme of the references handled by this provider. ex) "vault"
+	Scheme() string
+
+	// GetSecret returns the key-value pairs of the secret at the path.
+	// If the secret has a single value without key, the key is "".
+	GetSecret(path string) (map[string]string, error)
+}
+
+// SecretReference represents a parsed reference: "<Scheme>://<Path>#<Key>"
+type SecretReference struct {
+	Scheme string
+	Path   string
+	Key    string
+}
+
+func (ref SecretReference) String() string {
+	s := ref.Scheme + "://" + ref.Path
+	if ref.Key != "" {
+		s += "#" + ref.Key
+	}
+	return s
+}
+
+type secretCacheEntry struct {
+	secret   map[string]string
+	expireAt time.Time
+}
+
+var (
+	secretProviderLock sync.RWMutex
+	secretProviders    = map[string]SecretProvider{}
+
+	// built-in providers which are not in SPIDER_SECRET_PROVIDERS
+	disabledSecretSchemes = map[string]bool{}
+
+	secretCacheLock sync.Mutex
+	secretCache     = map[string]secretCacheEntry{} // "<scheme>://<path>" => secret
+	secretCacheTTL  = defaultSecretCacheTTL
+)
+
+//====================================================================
+
+func init() {
+	if ttl := strings.TrimSpace(os.Getenv(SECRET_CACHE_TTL_ENV)); ttl != "" {
+		duration, err := time.ParseDuration(ttl)
+		if err != nil || duration < 0 {
+			cblog.Errorf("invalid %s(%s), use the default value(%v)", SECRET_CACHE_TTL_ENV, ttl, defaultSecretCacheTTL)
+		} else {
+			secretCacheTTL = duration
+		}
+	}
+
+	enabled := strings.TrimSpace(os.Getenv(SECRET_PROVIDERS_ENV))
+	if enabled == "" {
+		enabled = defaultSecretProviders
+	}
+	registerBuiltinSecretProviders(enabled)
+}
+
+// registerBuiltinSecretProviders registers the built-in providers in the comma separated scheme list.
+func registerBuiltinSecretProviders(enabled string) {
+	enabledSchemes := map[string]bool{}
+	for _, scheme := range strings.Split(enabled, ",") {
+		if scheme = strings.ToLower(strings.TrimSpace(scheme)); scheme != "" {
+			enabledSchemes[scheme] = true
+		}
+	}
+
+	for _, provider := range []SecretProvider{
+		&EnvSecretProvider{},
+		NewFileSecretProvider(),
+		NewVaultSecretProvider(),
+		NewHTTPSecretProvider(),
+	} {
+		scheme := provider.Scheme()
+		if !enabledSchemes[scheme] {
+			disabledSecretSchemes[scheme] = true
+			continue
+		}
+		delete(enabledSchemes, scheme)
+		RegisterSecretProvider(provider)
+	}
+
+	for scheme := range enabledSchemes {
+		cblog.Errorf("unknown secret provider '%s' in %s", scheme, SECRET_PROVIDERS_ENV)
+	}
+}
+
+// RegisterSecretProvider adds or replaces the provider of the scheme.
+func RegisterSecretProvider(provider SecretProvider) {
+	secretProviderLock.Lock()
+	defer secretProviderLock.Unlock()
+
+	secretProviders[strings.ToLower(provider.Scheme())] = provider
+	ClearSecretCache()
+}
+
+// UnregisterSecretProvider removes the provider of the scheme.
+func UnregisterSecretProvider(scheme string) {
+	secretProviderLock.Lock()
+	defer secretProviderLock.Unlock()
+
+	delete(secretProviders, strings.ToLower(scheme))
+	ClearSecretCache()
+}
+
+func getSecretProvider(scheme string) (SecretProvider, bool) {
+	secretProviderLock.RLock()
+	defer secretProviderLock.RUnlock()
+
+	provider, ok := secretProviders[strings.ToLower(scheme)]
+	return provider, ok
+}
+
+// ParseSecretReference parses the value as a secret reference.
+// It returns false if the value is not a reference of a registered provider.
+func ParseSecretReference(value string) (SecretReference, bool) {
+	value = strings.TrimSpace(value)
+
+	idx := strings.Index(value, "://")
+	if idx <= 0 {
+		return SecretReference{}, false
+	}
+	scheme := strings.ToLower(value[:idx])
+	if _, ok := getSecretProvider(scheme); !ok {
+		return SecretReference{}, false
+	}
+
+	ref := SecretReference{Scheme: scheme, Path: value[idx+len("://"):]}
+	if hashIdx := strings.LastIndex(ref.Path, "#"); hashIdx >= 0 {
+		ref.Key = ref.Path[hashIdx+1:]
+		ref.Path = ref.Path[:hashIdx]
+	}
+	return ref, true
+}
+
+// IsSecretReference returns true if the value is a secret reference.
+func IsSecretReference(value string) bool {
+	_, ok := ParseSecretReference(value)
+	return ok
+}
+
+// ResolveSecretReference returns the secret value of the reference.
+func ResolveSecretReference(ref SecretReference) (string, error) {
+	if ref.Path == "" {
+		return "", fmt.Errorf("%s: secret path is empty", ref)
+	}
+
+	secret, err := getSecretWithCache(ref)
+	if err != nil {
+		return "", fmt.Errorf("%s: %v", ref, err)
+	}
+
+	if ref.Key == "" {
+		if value, ok := secret[""]; ok {
+			return value, nil
+		}
+		if len(secret) == 1 {
+			for _, value := range secret {
+				return value, nil
+			}
+		}
+		return "", fmt.Errorf("%s: the secret has %d keys, add '#<key>' to the reference", ref, len(secret))
+	}
+
+	value, ok := secret[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("%s: key '%s' does not exist in the secret", ref, ref.Key)
+	}
+	return value, nil
+}
+
+// resolveKeyValueList replaces the secret references in the list with the secret values.
+func resolveKeyValueList(keyValueInfoList []icdrs.KeyValue) error {
+	for i, kv := range keyValueInfoList {
+		ref, ok := ParseSecretReference(kv.Value)
+		if !ok {
+			continue
+		}
+		value, err := ResolveSecretReference(ref)
+		if err != nil {
+			cblog.Error(err)
+			return fmt.Errorf("failed to resolve the credential '%s': %v", kv.Key, err)
+		}
+		keyValueInfoList[i].Value = value
+	}
+	return nil
+}
+
+// checkSecretReferences checks the format of the secret references in the list.
+// With SPIDER_SECRET_REFERENCE_ONLY=ON, the values which are not references are rejected.
+func checkSecretReferences(keyValueInfoList []icdrs.KeyValue) error {
+	referenceOnly := strings.EqualFold(strings.TrimSpace(os.Getenv(SECRET_REFERENCE_ONLY_ENV)), "ON")
+
+	for _, kv := range keyValueInfoList {
+		ref, ok := ParseSecretReference(kv.Value)
+		if !ok {
+			if scheme := disabledSecretScheme(kv.Value); scheme != "" {
+				return fmt.Errorf("the value of '%s' refers to the secret provider '%s', which is not enabled in %s",
+					kv.Key, scheme, SECRET_PROVIDERS_ENV)
+			}
+			if referenceOnly && kv.Value != "" {
+				return fmt.Errorf("the value of '%s' must be a secret reference(ex: vault://path#key) with %s=ON",
+					kv.Key, SECRET_REFERENCE_ONLY_ENV)
+			}
+			continue
+		}
+		if ref.Path == "" {
+			return fmt.Errorf("the value of '%s' has an empty secret path: %s", kv.Key, ref)
+		}
+	}
+	return nil
+}
+
+// disabledSecretScheme returns the scheme if the value is a reference of a disabled built-in provider.
+func disabledSecretScheme(value string) string {
+	value = strings.TrimSpace(value)
+
+	idx := strings.Index(value, "://")
+	if idx <= 0 {
+		return ""
+	}
+	scheme := strings.ToLower(value[:idx])
+
+	secretProviderLock.RLock()
+	defer secretProviderLock.RUnlock()
+	if _, ok := secretProviders[scheme]; ok {
+		return ""
+	}
+	if disabledSecretSchemes[scheme] {
+		return scheme
+	}
+	return ""
+}
+
+func getSecretWithCache(ref SecretReference) (map[string]string, error) {
+	cacheKey := ref.Scheme + "://" + ref.Path
+
+	if secretCacheTTL > 0 {
+		secretCacheLock.Lock()
+		entry, ok := secretCache[cacheKey]
+		secretCacheLock.Unlock()
+		if ok && time.Now().Before(entry.expireAt) {
+			return entry.secret, nil
+		}
+	}
+
+	provider, ok := getSecretProvider(ref.Scheme)
+	if !ok {
+		return nil, fmt.Errorf("secret provider '%s' is not registered", ref.Scheme)
+	}
+	secret, err := provider.GetSecret(ref.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	if secretCacheTTL > 0 {
+		secretCacheLock.Lock()
+		secretCache[cacheKey] = secretCacheEntry{secret: secret, expireAt: time.Now().Add(secretCacheTTL)}
+		secretCacheLock.Unlock()
+	}
+	return secret, nil
+}
+
+// ClearSecretCache removes all cached secrets. ex) after the secrets are changed in the backend
+func ClearSecretCache() {
+	secretCacheLock.Lock()
+	defer secretCacheLock.Unlock()
+
+	secretCache = map[string]secretCacheEntry{}
+}
@@ -0,0 +1,302 @@
+// Cloud Credential Info. Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// Secret Providers: env, file, vault, httpsecret and local(for tests).
+//
+// by CB-Spider Team, 2026.10.
+
+package credentialinfomanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+//================ env://<ENV_NAME>
+
+// EnvSecretProvider gets a secret from an environment variable of Spider server.
+type EnvSecretProvider struct{}
+
+func (EnvSecretProvider) Scheme() string { return "env" }
+
+func (EnvSecretProvider) GetSecret(path string) (map[string]string, error) {
+	value, ok := os.LookupEnv(path)
+	if !ok {
+		return nil, fmt.Errorf("environment variable '%s' is not set", path)
+	}
+	return map[string]string{"": value}, nil
+}
+
+//================ file://<absolute path>#<key>
+
+// FileSecretProvider gets a secret from a file of Spider server in the base directory.
+// The file can be a JSON object or 'KEY=VALUE' lines. Otherwise, the whole content is the value.
+//
+//	SPIDER_SECRET_FILE_DIR: base directory of the secret files. ex) /etc/cb-spider/secrets
+type FileSecretProvider struct {
+	BaseDir string
+}
+
+func NewFileSecretProvider() *FileSecretProvider {
+	return &FileSecretProvider{BaseDir: os.Getenv(SECRET_FILE_DIR_ENV)}
+}
+
+func (*FileSecretProvider) Scheme() string { return "file" }
+
+func (p *FileSecretProvider) GetSecret(path string) (map[string]string, error) {
+	filePath, err := p.checkPath(path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return parseSecretData(data), nil
+}
+
+// checkPath returns the real path of the file, which must be in the base directory.
+func (p *FileSecretProvider) checkPath(path string) (string, error) {
+	if strings.TrimSpace(p.BaseDir) == "" {
+		return "", fmt.Errorf("%s must be set to use file secrets", SECRET_FILE_DIR_ENV)
+	}
+	if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("'%s' is not an absolute path", path)
+	}
+
+	baseDir, err := filepath.EvalSymlinks(filepath.Clean(p.BaseDir))
+	if err != nil {
+		return "", fmt.Errorf("invalid %s: %v", SECRET_FILE_DIR_ENV, err)
+	}
+	// resolve the symbolic links not to get out of the base directory
+	filePath, err := filepath.EvalSymlinks(filepath.Clean(path))
+	if err != nil {
+		return "", err
+	}
+
+	rel, err := filepath.Rel(baseDir, filePath)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("'%s' is out of %s(%s)", path, SECRET_FILE_DIR_ENV, p.BaseDir)
+	}
+	return filePath, nil
+}
+
+// parseSecretData parses a JSON object or 'KEY=VALUE' lines.
+func parseSecretData(data []byte) map[string]string {
+	secret := map[string]string{"": strings.TrimSpace(string(data))}
+
+	var jsonObject map[string]interface{}
+	if err := json.Unmarshal(data, &jsonObject); err == nil {
+		for key, value := range jsonObject {
+			secret[key] = secretValueString(value)
+		}
+		return secret
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		secret[strings.TrimSpace(kv[0])] = strings.Trim(strings.TrimSpace(kv[1]), `"'`)
+	}
+	return secret
+}
+
+func secretValueString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	default:
+		// ex) GCP service account JSON in a key
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
+
+//================ vault://<path>#<key>
+
+// VaultSecretProvider gets a secret from HashiCorp Vault KV secrets engine(v1 and v2).
+//
+//	VAULT_ADDR: ex) https://vault.example.com:8200
+//	VAULT_TOKEN: token to read the secrets
+//	VAULT_NAMESPACE: namespace of Vault Enterprise (optional)
+//
+// The path is the API path after '/v1/'. ex) vault://secret/data/cb-spider/aws#ClientSecret
+type VaultSecretProvider struct {
+	Address   string
+	Token     string
+	Namespace string
+	Client    *http.Client
+}
+
+func NewVaultSecretProvider() *VaultSecretProvider {
+	return &VaultSecretProvider{
+		Address:   os.Getenv("VAULT_ADDR"),
+		Token:     os.Getenv("VAULT_TOKEN"),
+		Namespace: os.Getenv("VAULT_NAMESPACE"),
+		Client:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (*VaultSecretProvider) Scheme() string { return "vault" }
+
+func (p *VaultSecretProvider) GetSecret(path string) (map[string]string, error) {
+	if p.Address == "" || p.Token == "" {
+		return nil, fmt.Errorf("VAULT_ADDR and VAULT_TOKEN must be set to use Vault secrets")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, strings.TrimRight(p.Address, "/")+"/v1/"+strings.TrimLeft(path, "/"), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", p.Token)
+	if p.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.Namespace)
+	}
+
+	body, err := doSecretRequest(p.Client, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Vault secret: %v", err)
+	}
+
+	var vaultResponse struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &vaultResponse); err != nil {
+		return nil, fmt.Errorf("invalid Vault response: %v", err)
+	}
+
+	data := vaultResponse.Data
+	// KV v2: {"data": {"data": {...}, "metadata": {...}}}
+	if inner, ok := data["data"].(map[string]interface{}); ok {
+		if _, hasMetadata := data["metadata"]; hasMetadata {
+			data = inner
+		}
+	}
+
+	secret := map[string]string{}
+	for key, value := range data {
+		secret[key] = secretValueString(value)
+	}
+	return secret, nil
+}
+
+//================ httpsecret://<path>#<key>
+
+// HTTPSecretProvider gets a secret from a generic HTTP secret service.
+// It sends 'GET <SPIDER_SECRET_HTTP_ENDPOINT>/<path>' and the response is a JSON object or a plain value.
+//
+//	SPIDER_SECRET_HTTP_ENDPOINT: ex) https://secrets.example.com/api/secrets
+//	SPIDER_SECRET_HTTP_TOKEN: bearer token (optional)
+type HTTPSecretProvider struct {
+	Endpoint string
+	Token    string
+	Client   *http.Client
+}
+
+func NewHTTPSecretProvider() *HTTPSecretProvider {
+	return &HTTPSecretProvider{
+		Endpoint: os.Getenv("SPIDER_SECRET_HTTP_ENDPOINT"),
+		Token:    os.Getenv("SPIDER_SECRET_HTTP_TOKEN"),
+		Client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (*HTTPSecretProvider) Scheme() string { return "httpsecret" }
+
+func (p *HTTPSecretProvider) GetSecret(path string) (map[string]string, error) {
+	if p.Endpoint == "" {
+		return nil, fmt.Errorf("SPIDER_SECRET_HTTP_ENDPOINT must be set to use HTTP secrets")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, strings.TrimRight(p.Endpoint, "/")+"/"+strings.TrimLeft(path, "/"), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if p.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.Token)
+	}
+
+	body, err := doSecretRequest(p.Client, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read HTTP secret: %v", err)
+	}
+	return parseSecretData(body), nil
+}
+
+func doSecretRequest(client *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		// the body is not logged, it can include secrets
+		return nil, fmt.Errorf("%s %s: %s", req.Method, req.URL.Path, resp.Status)
+	}
+	return body, nil
+}
+
+//================ local://<path>#<key>
+
+// LocalSecretProvider keeps secrets in memory. It is a stand-in of the external secret backends for tests.
+//
+//	provider := NewLocalSecretProvider()
+//	provider.SetSecret("aws", map[string]string{"ClientId": "...", "ClientSecret": "..."})
+//	RegisterSecretProvider(provider) // then, use "local://aws#ClientId"
+type LocalSecretProvider struct {
+	lock    sync.RWMutex
+	secrets map[string]map[string]string
+}
+
+func NewLocalSecretProvider() *LocalSecretProvider {
+	return &LocalSecretProvider{secrets: map[string]map[string]string{}}
+}
+
+func (*LocalSecretProvider) Scheme() string { return "local" }
+
+func (p *LocalSecretProvider) SetSecret(path string, secret map[string]string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	copied := map[string]string{}
+	for key, value := range secret {
+		copied[key] = value
+	}
+	p.secrets[path] = copied
+	ClearSecretCache()
+}
+
+func (p *LocalSecretProvider) GetSecret(path string) (map[string]string, error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	secret, ok := p.secrets[path]
+	if !ok {
+		return nil, fmt.Errorf("secret '%s' does not exist", path)
+	}
+	return secret, nil
+}
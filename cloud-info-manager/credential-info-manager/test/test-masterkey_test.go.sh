@@ -0,0 +1,9 @@
+#!/bin/bash
+
+# run with a temporary MetaDB and master key file, not to touch the real ones
+export CBSPIDER_ROOT=$(mktemp -d)
+unset SPIDER_MASTER_KEY SPIDER_MASTER_KEY_FILE SPIDER_MASTER_KEY_PREVIOUS
+
+go test -v masterkey_test.go
+
+rm -rf $CBSPIDER_ROOT
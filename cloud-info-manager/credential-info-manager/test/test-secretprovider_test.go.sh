@@ -0,0 +1,11 @@
+#!/bin/bash
+
+# run with a temporary MetaDB and the default secret providers
+export CBSPIDER_ROOT=$(mktemp -d)
+mkdir -p $CBSPIDER_ROOT/cloud-driver-libs
+cp ../../../cloud-driver-libs/cloudos_meta.yaml $CBSPIDER_ROOT/cloud-driver-libs/
+unset SPIDER_SECRET_PROVIDERS SPIDER_SECRET_FILE_DIR SPIDER_SECRET_REFERENCE_ONLY
+
+go test -v secretprovider_test.go
+
+rm -rf $CBSPIDER_ROOT
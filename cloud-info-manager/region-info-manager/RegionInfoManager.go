@@ -16,8 +16,10 @@ import (
 	cblogger "github.com/cloud-barista/cb-log"
 	icdrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	cim "github.com/cloud-barista/cb-spider/cloud-info-manager"
+	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
 
 	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
 
 	infostore "github.com/cloud-barista/cb-spider/info-store"
 )
@@ -127,6 +129,74 @@ func GetRegion(regionName string) (*RegionInfo, error) {
 	return &regionInfo, err
 }
 
+// 1. check params
+// 2. update RegionInfo and the referencing connection configs in a transaction
+//
+// If the RegionName is changed, the referencing connection configs get the new name.
+func UpdateRegionInfo(regionName string, rgnInfo RegionInfo) (*RegionInfo, error) {
+	cblog.Info("call UpdateRegionInfo()")
+
+	regionName = strings.TrimSpace(regionName)
+	if regionName == "" {
+		return nil, fmt.Errorf("RegionName is empty!")
+	}
+
+	oldInfo, err := GetRegion(regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(rgnInfo.RegionName) == "" {
+		rgnInfo.RegionName = regionName
+	}
+	if strings.TrimSpace(rgnInfo.ProviderName) == "" {
+		rgnInfo.ProviderName = oldInfo.ProviderName
+	}
+
+	cblog.Debug("check params")
+	err = checkParams(rgnInfo.RegionName, rgnInfo.ProviderName, rgnInfo.KeyValueInfoList)
+	if err != nil {
+		return nil, err
+	}
+
+	// trim user inputs
+	rgnInfo.RegionName = strings.TrimSpace(rgnInfo.RegionName)
+	rgnInfo.ProviderName = strings.ToUpper(strings.TrimSpace(rgnInfo.ProviderName))
+
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	defer infostore.Close(db)
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if rgnInfo.RegionName != regionName {
+			var count int64
+			if err := tx.Model(&RegionInfo{}).Where(KEY_COLUMN_NAME+" = ?", rgnInfo.RegionName).Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return fmt.Errorf("%s: already exists!", rgnInfo.RegionName)
+			}
+			if err := tx.Delete(&RegionInfo{}, KEY_COLUMN_NAME+" = ?", regionName).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Save(&rgnInfo).Error; err != nil {
+			return err
+		}
+		_, err := ccim.UpdateReferences(tx, ccim.REGION_NAME_COLUMN, regionName, rgnInfo.RegionName, rgnInfo.ProviderName)
+		return err
+	})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &rgnInfo, nil
+}
+
 func UnRegisterRegion(regionName string) (bool, error) {
 	cblog.Info("call UnRegisterRegion()")
 
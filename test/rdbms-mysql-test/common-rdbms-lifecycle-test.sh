@@ -0,0 +1,121 @@
+#!/bin/bash
+
+# CB-Spider RDBMS Lifecycle Test Script
+# Flow: Get MetaInfo -> Stop/Start -> Change Spec -> Expand Storage -> Snapshot Create/List/Delete
+#       Each step runs only if the CSP supports it (Supports* fields of /spider/rdbmsmetainfo).
+# Author: CB-Spider Team
+#
+# Required env vars:
+#   CSP_NAME        - Display name (e.g., AWS)
+#   CONNECTION_NAME - Spider connection config name
+#   RDBMS_NAME      - Name of an available RDBMS instance (created by <csp>-rdbms-test.sh)
+#
+# Optional env vars:
+#   SPIDER_URL      - Spider REST API URL (default: http://localhost:1024)
+#   SPIDER_AUTH     - Basic auth credentials (default: admin:****)
+#   DB_ENGINE       - DB engine for the meta info (default: mysql)
+#   NEW_SPEC        - DBInstanceSpec to change to (default: skip Change Spec)
+#   STORAGE_STEP    - GB to add to the current StorageSize (default: 10)
+#   MAX_WAIT_SEC    - Max seconds to wait for a status (default: 3600)
+#   POLL_INTERVAL   - Polling interval in seconds (default: 30)
+
+SPIDER_URL="${SPIDER_URL:-http://localhost:1024}"
+SPIDER_AUTH="${SPIDER_AUTH:-admin:****}"
+DB_ENGINE="${DB_ENGINE:-mysql}"
+STORAGE_STEP="${STORAGE_STEP:-10}"
+MAX_WAIT_SEC="${MAX_WAIT_SEC:-3600}"
+POLL_INTERVAL="${POLL_INTERVAL:-30}"
+
+# call <method> <path> [json body]: prints the response, exits on an API error
+call() {
+    local resp
+    if [[ -n "$3" ]]; then
+        resp=$(curl -u "${SPIDER_AUTH}" -sX "$1" "${SPIDER_URL}/spider$2" -H 'Content-Type: application/json' -d "$3")
+    else
+        resp=$(curl -u "${SPIDER_AUTH}" -sX "$1" "${SPIDER_URL}/spider$2")
+    fi
+    local err_msg
+    err_msg=$(echo "${resp}" | jq -r '.message // empty' 2>/dev/null)
+    if [[ -n "${err_msg}" ]]; then
+        echo "[${CSP_NAME}] ERROR on $1 $2: ${err_msg}" >&2
+        exit 1
+    fi
+    echo "${resp}"
+}
+
+# wait_status <Available|Stopped>
+wait_status() {
+    local elapsed=0
+    while true; do
+        sleep "${POLL_INTERVAL}"
+        elapsed=$((elapsed + POLL_INTERVAL))
+        cur_status=$(call GET "/rdbms/${RDBMS_NAME}?ConnectionName=${CONNECTION_NAME}" | jq -r '.Status')
+        echo "[${CSP_NAME}] Status: ${cur_status} (elapsed: ${elapsed}s)"
+        [[ "${cur_status}" == "$1" ]] && return
+        if [[ ${elapsed} -ge ${MAX_WAIT_SEC} ]]; then
+            echo "[${CSP_NAME}] TIMEOUT: RDBMS did not become $1 within ${MAX_WAIT_SEC}s"
+            exit 1
+        fi
+    done
+}
+
+CONN_JSON="{\"ConnectionName\": \"${CONNECTION_NAME}\"}"
+
+# ── Meta Info ─────────────────────────────────────────────────────────────────
+meta=$(call GET "/rdbmsmetainfo?ConnectionName=${CONNECTION_NAME}&DBEngine=${DB_ENGINE}") || exit 1
+echo "${meta}" | jq '{SupportsChangeSpec, SupportsChangeStorageSize, SupportsStartStop, SupportsSnapshot}'
+
+# ── Stop / Start ──────────────────────────────────────────────────────────────
+if [[ $(echo "${meta}" | jq -r '.SupportsStartStop') == "true" ]]; then
+    echo "[${CSP_NAME}] Stopping RDBMS '${RDBMS_NAME}'..."
+    call PUT "/rdbms/${RDBMS_NAME}/stop" "${CONN_JSON}" > /dev/null
+    wait_status Stopped
+    echo "[${CSP_NAME}] Starting RDBMS '${RDBMS_NAME}'..."
+    call PUT "/rdbms/${RDBMS_NAME}/start" "${CONN_JSON}" > /dev/null
+    wait_status Available
+fi
+
+# ── Change Spec ───────────────────────────────────────────────────────────────
+if [[ $(echo "${meta}" | jq -r '.SupportsChangeSpec') == "true" && -n "${NEW_SPEC}" ]]; then
+    echo "[${CSP_NAME}] Changing DBInstanceSpec to '${NEW_SPEC}'..."
+    call PUT "/rdbms/${RDBMS_NAME}/spec" \
+      "{\"ConnectionName\": \"${CONNECTION_NAME}\", \"ReqInfo\": {\"DBInstanceSpec\": \"${NEW_SPEC}\"}}" | jq -r '.DBInstanceSpec'
+    wait_status Available
+fi
+
+# ── Expand Storage ────────────────────────────────────────────────────────────
+if [[ $(echo "${meta}" | jq -r '.SupportsChangeStorageSize') == "true" ]]; then
+    cur_size=$(call GET "/rdbms/${RDBMS_NAME}?ConnectionName=${CONNECTION_NAME}" | jq -r '.StorageSize') || exit 1
+    new_size=$((cur_size + STORAGE_STEP))
+    echo "[${CSP_NAME}] Expanding StorageSize ${cur_size}GB -> ${new_size}GB..."
+    call PUT "/rdbms/${RDBMS_NAME}/storage" \
+      "{\"ConnectionName\": \"${CONNECTION_NAME}\", \"ReqInfo\": {\"StorageSize\": \"${new_size}\"}}" | jq -r '.StorageSize'
+    wait_status Available
+fi
+
+# ── Snapshot ──────────────────────────────────────────────────────────────────
+if [[ $(echo "${meta}" | jq -r '.SupportsSnapshot') == "true" ]]; then
+    snapshot_name="${RDBMS_NAME}-snap-$(date +%s)"
+    echo "[${CSP_NAME}] Creating snapshot '${snapshot_name}'..."
+    call POST "/rdbms/${RDBMS_NAME}/snapshot" \
+      "{\"ConnectionName\": \"${CONNECTION_NAME}\", \"ReqInfo\": {\"Name\": \"${snapshot_name}\"}}" | jq -r '.Status'
+
+    elapsed=0
+    while true; do
+        sleep "${POLL_INTERVAL}"
+        elapsed=$((elapsed + POLL_INTERVAL))
+        snap_status=$(call GET "/rdbms/${RDBMS_NAME}/snapshot?ConnectionName=${CONNECTION_NAME}" \
+          | jq -r --arg n "${snapshot_name}" '.snapshot[] | select(.IId.NameId == $n) | .Status')
+        echo "[${CSP_NAME}] Snapshot Status: ${snap_status} (elapsed: ${elapsed}s)"
+        [[ "${snap_status}" == "Available" ]] && break
+        if [[ ${elapsed} -ge ${MAX_WAIT_SEC} ]]; then
+            echo "[${CSP_NAME}] TIMEOUT: snapshot did not become Available within ${MAX_WAIT_SEC}s"
+            exit 1
+        fi
+    done
+
+    echo "[${CSP_NAME}] Deleting snapshot '${snapshot_name}'..."
+    call DELETE "/rdbms/${RDBMS_NAME}/snapshot/${snapshot_name}" "${CONN_JSON}" | jq -r '.Result'
+fi
+
+echo "[${CSP_NAME}] Done."
@@ -4,7 +4,7 @@ SPIDER_PASSWORD=$SPIDER_PASSWORD
 
 
 # CB-Spider S3 Full API Test Script
-# Test all 30 S3 APIs including PreSigned URL functionality
+# Test all 43 S3 APIs including PreSigned URL functionality
 # Author: CB-Spider Team
 # Date: $(date '+%Y-%m-%d %H:%M:%S')
 
@@ -220,11 +220,12 @@ print_summary() {
     echo
     
     # 2. Object Management Tests
-    echo "2. OBJECT MANAGEMENT (6 tests)"
+    echo "2. OBJECT MANAGEMENT (7 tests)"
     printf "%-50s | %-10s\n" "  Upload Object (File)" "${tr_upload_object_file:-SKIP}"
     printf "%-50s | %-10s\n" "  Upload Object (Form)" "${tr_upload_object_form:-SKIP}"
     printf "%-50s | %-10s\n" "  Download Object" "${tr_download_object:-SKIP}"
     printf "%-50s | %-10s\n" "  Get Object Info (HEAD)" "${tr_head_object:-SKIP}"
+    printf "%-50s | %-10s\n" "  Copy Object" "${tr_copy_object:-SKIP}"
     printf "%-50s | %-10s\n" "  Delete Object" "${tr_delete_object:-SKIP}"
     printf "%-50s | %-10s\n" "  Delete Multiple Objects" "${tr_delete_multiple_objects:-SKIP}"
     echo
@@ -255,8 +256,24 @@ print_summary() {
     printf "%-50s | %-10s\n" "  Delete CORS Configuration" "${tr_delete_bucket_cors:-SKIP}"
     echo
     
-    # 6. CB-Spider Special Features Tests
-    echo "6. CB-SPIDER SPECIAL FEATURES (6 tests)"
+    # 6. Lifecycle, Tagging & Encryption Management Tests
+    echo "6. LIFECYCLE, TAGGING & ENCRYPTION MANAGEMENT (12 tests)"
+    printf "%-50s | %-10s\n" "  Set Bucket Lifecycle" "${tr_set_bucket_lifecycle:-SKIP}"
+    printf "%-50s | %-10s\n" "  Get Bucket Lifecycle" "${tr_get_bucket_lifecycle:-SKIP}"
+    printf "%-50s | %-10s\n" "  Delete Bucket Lifecycle" "${tr_delete_bucket_lifecycle:-SKIP}"
+    printf "%-50s | %-10s\n" "  Set Bucket Tagging" "${tr_set_bucket_tagging:-SKIP}"
+    printf "%-50s | %-10s\n" "  Get Bucket Tagging" "${tr_get_bucket_tagging:-SKIP}"
+    printf "%-50s | %-10s\n" "  Delete Bucket Tagging" "${tr_delete_bucket_tagging:-SKIP}"
+    printf "%-50s | %-10s\n" "  Set Object Tagging" "${tr_put_object_tagging:-SKIP}"
+    printf "%-50s | %-10s\n" "  Get Object Tagging" "${tr_get_object_tagging:-SKIP}"
+    printf "%-50s | %-10s\n" "  Delete Object Tagging" "${tr_delete_object_tagging:-SKIP}"
+    printf "%-50s | %-10s\n" "  Set Bucket Encryption" "${tr_set_bucket_encryption:-SKIP}"
+    printf "%-50s | %-10s\n" "  Get Bucket Encryption" "${tr_get_bucket_encryption:-SKIP}"
+    printf "%-50s | %-10s\n" "  Delete Bucket Encryption" "${tr_delete_bucket_encryption:-SKIP}"
+    echo
+    
+    # 7. CB-Spider Special Features Tests
+    echo "7. CB-SPIDER SPECIAL FEATURES (6 tests)"
     printf "%-50s | %-10s\n" "  Generate PreSigned URL (Download)" "${tr_generate_presigned_download:-SKIP}"
     printf "%-50s | %-10s\n" "  PreSigned URL Download Test" "${tr_test_presigned_download:-SKIP}"
     printf "%-50s | %-10s\n" "  Generate PreSigned URL (Upload)" "${tr_generate_presigned_upload:-SKIP}"
@@ -369,6 +386,11 @@ main() {
         "200" \
         "Get object info"
     
+    run_test "copy_object" \
+        "curl -u $SPIDER_USERNAME:$SPIDER_PASSWORD -s -X PUT '$SPIDER_URL/$TEST_BUCKET/copied-$TEST_OBJECT?ConnectionName=$CONNECTION_NAME' -H 'x-amz-copy-source: /$TEST_BUCKET/$TEST_OBJECT'" \
+        "CopyObjectResult" \
+        "Copy object (x-amz-copy-source)"
+    
     run_test "delete_object" \
         "curl -u $SPIDER_USERNAME:$SPIDER_PASSWORD -s -w '%{http_code}' -X DELETE '$SPIDER_URL/$TEST_BUCKET/form-upload.txt?ConnectionName=$CONNECTION_NAME'" \
         "204" \
@@ -503,9 +525,77 @@ main() {
         "Delete CORS configuration"
     
     # ========================================
-    # 6. CB-SPIDER SPECIAL FEATURES (4/4)
+    # 6. LIFECYCLE, TAGGING & ENCRYPTION MANAGEMENT TESTS (12/12)
+    # ========================================
+    log_info "=== 6. LIFECYCLE, TAGGING & ENCRYPTION MANAGEMENT TESTS ==="
+    
+    run_test "set_bucket_lifecycle" \
+        "curl -u $SPIDER_USERNAME:$SPIDER_PASSWORD -s -w '%{http_code}' -X PUT '$SPIDER_URL/$TEST_BUCKET?lifecycle&ConnectionName=$CONNECTION_NAME' -d '<LifecycleConfiguration><Rule><ID>expire-logs</ID><Status>Enabled</Status><Filter><Prefix>logs/</Prefix></Filter><Expiration><Days>30</Days></Expiration></Rule></LifecycleConfiguration>'" \
+        "200" \
+        "Set bucket lifecycle configuration"
+    
+    run_test "get_bucket_lifecycle" \
+        "curl -u $SPIDER_USERNAME:$SPIDER_PASSWORD -s -X GET '$SPIDER_URL/$TEST_BUCKET?lifecycle&ConnectionName=$CONNECTION_NAME'" \
+        "<Days>30</Days>" \
+        "Get bucket lifecycle configuration"
+    
+    run_test "delete_bucket_lifecycle" \
+        "curl -u $SPIDER_USERNAME:$SPIDER_PASSWORD -s -w '%{http_code}' -X DELETE '$SPIDER_URL/$TEST_BUCKET?lifecycle&ConnectionName=$CONNECTION_NAME'" \
+        "204" \
+        "Delete bucket lifecycle configuration"
+    
+    run_test "set_bucket_tagging" \
+        "curl -u $SPIDER_USERNAME:$SPIDER_PASSWORD -s -w '%{http_code}' -X PUT '$SPIDER_URL/$TEST_BUCKET?tagging&ConnectionName=$CONNECTION_NAME' -d '<Tagging><TagSet><Tag><Key>env</Key><Value>test</Value></Tag></TagSet></Tagging>'" \
+        "204" \
+        "Set bucket tagging"
+    
+    run_test "get_bucket_tagging" \
+        "curl -u $SPIDER_USERNAME:$SPIDER_PASSWORD -s -X GET '$SPIDER_URL/$TEST_BUCKET?tagging&ConnectionName=$CONNECTION_NAME'" \
+        "<Key>env</Key>" \
+        "Get bucket tagging"
+    
+    run_test "delete_bucket_tagging" \
+        "curl -u $SPIDER_USERNAME:$SPIDER_PASSWORD -s -w '%{http_code}' -X DELETE '$SPIDER_URL/$TEST_BUCKET?tagging&ConnectionName=$CONNECTION_NAME'" \
+        "204" \
+        "Delete bucket tagging"
+    
+    # Upload a test file for object tagging tests
+    curl -u $SPIDER_USERNAME:$SPIDER_PASSWORD -s -X PUT "$SPIDER_URL/$TEST_BUCKET/tagging-test.txt?ConnectionName=$CONNECTION_NAME" --data-binary "@$TEMP_DIR/$TEST_OBJECT" >/dev/null
+    
+    run_test "put_object_tagging" \
+        "curl -u $SPIDER_USERNAME:$SPIDER_PASSWORD -s -w '%{http_code}' -X PUT '$SPIDER_URL/$TEST_BUCKET/tagging-test.txt?tagging&ConnectionName=$CONNECTION_NAME' -d '<Tagging><TagSet><Tag><Key>retention</Key><Value>30d</Value></Tag></TagSet></Tagging>'" \
+        "200" \
+        "Set object tagging"
+    
+    run_test "get_object_tagging" \
+        "curl -u $SPIDER_USERNAME:$SPIDER_PASSWORD -s -X GET '$SPIDER_URL/$TEST_BUCKET/tagging-test.txt?tagging&ConnectionName=$CONNECTION_NAME'" \
+        "<Key>retention</Key>" \
+        "Get object tagging"
+    
+    run_test "delete_object_tagging" \
+        "curl -u $SPIDER_USERNAME:$SPIDER_PASSWORD -s -w '%{http_code}' -X DELETE '$SPIDER_URL/$TEST_BUCKET/tagging-test.txt?tagging&ConnectionName=$CONNECTION_NAME'" \
+        "204" \
+        "Delete object tagging"
+    
+    run_test "set_bucket_encryption" \
+        "curl -u $SPIDER_USERNAME:$SPIDER_PASSWORD -s -w '%{http_code}' -X PUT '$SPIDER_URL/$TEST_BUCKET?encryption&ConnectionName=$CONNECTION_NAME' -d '<ServerSideEncryptionConfiguration><Rule><ApplyServerSideEncryptionByDefault><SSEAlgorithm>AES256</SSEAlgorithm></ApplyServerSideEncryptionByDefault></Rule></ServerSideEncryptionConfiguration>'" \
+        "200" \
+        "Set bucket default encryption"
+    
+    run_test "get_bucket_encryption" \
+        "curl -u $SPIDER_USERNAME:$SPIDER_PASSWORD -s -X GET '$SPIDER_URL/$TEST_BUCKET?encryption&ConnectionName=$CONNECTION_NAME'" \
+        "<SSEAlgorithm>AES256</SSEAlgorithm>" \
+        "Get bucket default encryption"
+    
+    run_test "delete_bucket_encryption" \
+        "curl -u $SPIDER_USERNAME:$SPIDER_PASSWORD -s -w '%{http_code}' -X DELETE '$SPIDER_URL/$TEST_BUCKET?encryption&ConnectionName=$CONNECTION_NAME'" \
+        "204" \
+        "Delete bucket default encryption"
+    
+    # ========================================
+    # 7. CB-SPIDER SPECIAL FEATURES (4/4)
     # ========================================
-    log_info "=== 6. CB-SPIDER SPECIAL FEATURES ==="
+    log_info "=== 7. CB-SPIDER SPECIAL FEATURES ==="
     
     # Upload a test file for presigned URL tests
     curl -u $SPIDER_USERNAME:$SPIDER_PASSWORD -s -X PUT "$SPIDER_URL/$TEST_BUCKET/presigned-test.txt?ConnectionName=$CONNECTION_NAME" --data-binary "@$TEMP_DIR/$TEST_OBJECT" >/dev/null